@@ -26,6 +26,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type NetworkEvent_Action int32
+
+const (
+	NetworkEvent_NEW NetworkEvent_Action = 0
+	NetworkEvent_DEL NetworkEvent_Action = 1
+)
+
+// Enum value maps for NetworkEvent_Action.
+var (
+	NetworkEvent_Action_name = map[int32]string{
+		0: "NEW",
+		1: "DEL",
+	}
+	NetworkEvent_Action_value = map[string]int32{
+		"NEW": 0,
+		"DEL": 1,
+	}
+)
+
+func (x NetworkEvent_Action) Enum() *NetworkEvent_Action {
+	p := new(NetworkEvent_Action)
+	*p = x
+	return p
+}
+
+func (x NetworkEvent_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NetworkEvent_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_agent_v2_agent_proto_enumTypes[0].Descriptor()
+}
+
+func (NetworkEvent_Action) Type() protoreflect.EnumType {
+	return &file_services_agent_v2_agent_proto_enumTypes[0]
+}
+
+func (x NetworkEvent_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NetworkEvent_Action.Descriptor instead.
+func (NetworkEvent_Action) EnumDescriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{24, 0}
+}
+
 type GetInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -79,6 +125,7 @@ type GetRouteListRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Family v2.InetFamily `protobuf:"varint,1,opt,name=family,proto3,enum=pga.api.types.v2.InetFamily" json:"family,omitempty"`
+	Table  int32         `protobuf:"varint,2,opt,name=table,proto3" json:"table,omitempty"`
 }
 
 func (x *GetRouteListRequest) Reset() {
@@ -120,6 +167,13 @@ func (x *GetRouteListRequest) GetFamily() v2.InetFamily {
 	return v2.InetFamily(0)
 }
 
+func (x *GetRouteListRequest) GetTable() int32 {
+	if x != nil {
+		return x.Table
+	}
+	return 0
+}
+
 type GetRouteListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -172,12 +226,19 @@ type RouteRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LinkName string        `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
-	Scope    v2.RouteScope `protobuf:"varint,2,opt,name=scope,proto3,enum=pga.api.types.v2.RouteScope" json:"scope,omitempty"`
-	Dst      string        `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
-	Src      string        `protobuf:"bytes,4,opt,name=src,proto3" json:"src,omitempty"`
-	Gw       string        `protobuf:"bytes,5,opt,name=gw,proto3" json:"gw,omitempty"`
-	Table    int32         `protobuf:"varint,6,opt,name=table,proto3" json:"table,omitempty"`
+	LinkName  string             `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Scope     v2.RouteScope      `protobuf:"varint,2,opt,name=scope,proto3,enum=pga.api.types.v2.RouteScope" json:"scope,omitempty"`
+	Dst       string             `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	Src       string             `protobuf:"bytes,4,opt,name=src,proto3" json:"src,omitempty"`
+	Gw        string             `protobuf:"bytes,5,opt,name=gw,proto3" json:"gw,omitempty"`
+	Table     int32              `protobuf:"varint,6,opt,name=table,proto3" json:"table,omitempty"`
+	Priority  int32              `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Protocol  uint32             `protobuf:"varint,8,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Type      v2.RouteType       `protobuf:"varint,9,opt,name=type,proto3,enum=pga.api.types.v2.RouteType" json:"type,omitempty"`
+	MTU       int32              `protobuf:"varint,10,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Onlink    bool               `protobuf:"varint,11,opt,name=onlink,proto3" json:"onlink,omitempty"`
+	Multipath []*v2.RouteNextHop `protobuf:"bytes,12,rep,name=multipath,proto3" json:"multipath,omitempty"`
+	Persist   bool               `protobuf:"varint,13,opt,name=persist,proto3" json:"persist,omitempty"`
 }
 
 func (x *RouteRequest) Reset() {
@@ -254,6 +315,55 @@ func (x *RouteRequest) GetTable() int32 {
 	return 0
 }
 
+func (x *RouteRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RouteRequest) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *RouteRequest) GetType() v2.RouteType {
+	if x != nil {
+		return x.Type
+	}
+	return v2.RouteType(0)
+}
+
+func (x *RouteRequest) GetMTU() int32 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
+}
+
+func (x *RouteRequest) GetOnlink() bool {
+	if x != nil {
+		return x.Onlink
+	}
+	return false
+}
+
+func (x *RouteRequest) GetMultipath() []*v2.RouteNextHop {
+	if x != nil {
+		return x.Multipath
+	}
+	return nil
+}
+
+func (x *RouteRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
 type AddRouteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -348,16 +458,16 @@ func (x *DelRouteResponse) GetRoute() *v2.RouteInfo {
 	return nil
 }
 
-type GetInterfacesResponse struct {
+type ReplaceRouteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Interfaces []*v2.InterfaceInfo `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Route *v2.RouteInfo `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
 }
 
-func (x *GetInterfacesResponse) Reset() {
-	*x = GetInterfacesResponse{}
+func (x *ReplaceRouteResponse) Reset() {
+	*x = ReplaceRouteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,13 +475,13 @@ func (x *GetInterfacesResponse) Reset() {
 	}
 }
 
-func (x *GetInterfacesResponse) String() string {
+func (x *ReplaceRouteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInterfacesResponse) ProtoMessage() {}
+func (*ReplaceRouteResponse) ProtoMessage() {}
 
-func (x *GetInterfacesResponse) ProtoReflect() protoreflect.Message {
+func (x *ReplaceRouteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,28 +493,28 @@ func (x *GetInterfacesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInterfacesResponse.ProtoReflect.Descriptor instead.
-func (*GetInterfacesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplaceRouteResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceRouteResponse) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetInterfacesResponse) GetInterfaces() []*v2.InterfaceInfo {
+func (x *ReplaceRouteResponse) GetRoute() *v2.RouteInfo {
 	if x != nil {
-		return x.Interfaces
+		return x.Route
 	}
 	return nil
 }
 
-type SetInterfaceLinkStateRequest struct {
+type ListRulesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Family v2.InetFamily `protobuf:"varint,1,opt,name=family,proto3,enum=pga.api.types.v2.InetFamily" json:"family,omitempty"`
 }
 
-func (x *SetInterfaceLinkStateRequest) Reset() {
-	*x = SetInterfaceLinkStateRequest{}
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -412,13 +522,13 @@ func (x *SetInterfaceLinkStateRequest) Reset() {
 	}
 }
 
-func (x *SetInterfaceLinkStateRequest) String() string {
+func (x *ListRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetInterfaceLinkStateRequest) ProtoMessage() {}
+func (*ListRulesRequest) ProtoMessage() {}
 
-func (x *SetInterfaceLinkStateRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -430,29 +540,28 @@ func (x *SetInterfaceLinkStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetInterfaceLinkStateRequest.ProtoReflect.Descriptor instead.
-func (*SetInterfaceLinkStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SetInterfaceLinkStateRequest) GetLinkName() string {
+func (x *ListRulesRequest) GetFamily() v2.InetFamily {
 	if x != nil {
-		return x.LinkName
+		return x.Family
 	}
-	return ""
+	return v2.InetFamily(0)
 }
 
-type IPAddrRequest struct {
+type ListRulesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
-	Addr     string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Rules []*v2.RuleInfo `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (x *IPAddrRequest) Reset() {
-	*x = IPAddrRequest{}
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,13 +569,13 @@ func (x *IPAddrRequest) Reset() {
 	}
 }
 
-func (x *IPAddrRequest) String() string {
+func (x *ListRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPAddrRequest) ProtoMessage() {}
+func (*ListRulesResponse) ProtoMessage() {}
 
-func (x *IPAddrRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,35 +587,37 @@ func (x *IPAddrRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPAddrRequest.ProtoReflect.Descriptor instead.
-func (*IPAddrRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *IPAddrRequest) GetLinkName() string {
-	if x != nil {
-		return x.LinkName
-	}
-	return ""
-}
-
-func (x *IPAddrRequest) GetAddr() string {
+func (x *ListRulesResponse) GetRules() []*v2.RuleInfo {
 	if x != nil {
-		return x.Addr
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-type GetFileMD5HashRequest struct {
+type RuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Priority int32         `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Family   v2.InetFamily `protobuf:"varint,2,opt,name=family,proto3,enum=pga.api.types.v2.InetFamily" json:"family,omitempty"`
+	Src      string        `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Dst      string        `protobuf:"bytes,4,opt,name=dst,proto3" json:"dst,omitempty"`
+	Iif      string        `protobuf:"bytes,5,opt,name=iif,proto3" json:"iif,omitempty"`
+	Oif      string        `protobuf:"bytes,6,opt,name=oif,proto3" json:"oif,omitempty"`
+	Fwmark   uint32        `protobuf:"varint,7,opt,name=fwmark,proto3" json:"fwmark,omitempty"`
+	Fwmask   uint32        `protobuf:"varint,8,opt,name=fwmask,proto3" json:"fwmask,omitempty"`
+	Table    int32         `protobuf:"varint,9,opt,name=table,proto3" json:"table,omitempty"`
+	Invert   bool          `protobuf:"varint,10,opt,name=invert,proto3" json:"invert,omitempty"`
 }
 
-func (x *GetFileMD5HashRequest) Reset() {
-	*x = GetFileMD5HashRequest{}
+func (x *RuleRequest) Reset() {
+	*x = RuleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,13 +625,13 @@ func (x *GetFileMD5HashRequest) Reset() {
 	}
 }
 
-func (x *GetFileMD5HashRequest) String() string {
+func (x *RuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileMD5HashRequest) ProtoMessage() {}
+func (*RuleRequest) ProtoMessage() {}
 
-func (x *GetFileMD5HashRequest) ProtoReflect() protoreflect.Message {
+func (x *RuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,28 +643,92 @@ func (x *GetFileMD5HashRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileMD5HashRequest.ProtoReflect.Descriptor instead.
-func (*GetFileMD5HashRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RuleRequest.ProtoReflect.Descriptor instead.
+func (*RuleRequest) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetFileMD5HashRequest) GetPath() string {
+func (x *RuleRequest) GetPriority() int32 {
 	if x != nil {
-		return x.Path
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RuleRequest) GetFamily() v2.InetFamily {
+	if x != nil {
+		return x.Family
+	}
+	return v2.InetFamily(0)
+}
+
+func (x *RuleRequest) GetSrc() string {
+	if x != nil {
+		return x.Src
 	}
 	return ""
 }
 
-type GetFileMD5HashResponse struct {
+func (x *RuleRequest) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *RuleRequest) GetIif() string {
+	if x != nil {
+		return x.Iif
+	}
+	return ""
+}
+
+func (x *RuleRequest) GetOif() string {
+	if x != nil {
+		return x.Oif
+	}
+	return ""
+}
+
+func (x *RuleRequest) GetFwmark() uint32 {
+	if x != nil {
+		return x.Fwmark
+	}
+	return 0
+}
+
+func (x *RuleRequest) GetFwmask() uint32 {
+	if x != nil {
+		return x.Fwmask
+	}
+	return 0
+}
+
+func (x *RuleRequest) GetTable() int32 {
+	if x != nil {
+		return x.Table
+	}
+	return 0
+}
+
+func (x *RuleRequest) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
+type ListNeighborsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Family   v2.InetFamily `protobuf:"varint,1,opt,name=family,proto3,enum=pga.api.types.v2.InetFamily" json:"family,omitempty"`
+	LinkName string        `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
 }
 
-func (x *GetFileMD5HashResponse) Reset() {
-	*x = GetFileMD5HashResponse{}
+func (x *ListNeighborsRequest) Reset() {
+	*x = ListNeighborsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -561,13 +736,13 @@ func (x *GetFileMD5HashResponse) Reset() {
 	}
 }
 
-func (x *GetFileMD5HashResponse) String() string {
+func (x *ListNeighborsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileMD5HashResponse) ProtoMessage() {}
+func (*ListNeighborsRequest) ProtoMessage() {}
 
-func (x *GetFileMD5HashResponse) ProtoReflect() protoreflect.Message {
+func (x *ListNeighborsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -579,29 +754,35 @@ func (x *GetFileMD5HashResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileMD5HashResponse.ProtoReflect.Descriptor instead.
-func (*GetFileMD5HashResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*ListNeighborsRequest) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetFileMD5HashResponse) GetHash() string {
+func (x *ListNeighborsRequest) GetFamily() v2.InetFamily {
 	if x != nil {
-		return x.Hash
+		return x.Family
+	}
+	return v2.InetFamily(0)
+}
+
+func (x *ListNeighborsRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
 	}
 	return ""
 }
 
-type GetFileStatRequest struct {
+type ListNeighborsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path           string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	WithDirContent bool   `protobuf:"varint,2,opt,name=with_dir_content,json=withDirContent,proto3" json:"with_dir_content,omitempty"`
+	Neighbors []*v2.NeighborInfo `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
 }
 
-func (x *GetFileStatRequest) Reset() {
-	*x = GetFileStatRequest{}
+func (x *ListNeighborsResponse) Reset() {
+	*x = ListNeighborsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -609,13 +790,13 @@ func (x *GetFileStatRequest) Reset() {
 	}
 }
 
-func (x *GetFileStatRequest) String() string {
+func (x *ListNeighborsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileStatRequest) ProtoMessage() {}
+func (*ListNeighborsResponse) ProtoMessage() {}
 
-func (x *GetFileStatRequest) ProtoReflect() protoreflect.Message {
+func (x *ListNeighborsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -627,35 +808,30 @@ func (x *GetFileStatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileStatRequest.ProtoReflect.Descriptor instead.
-func (*GetFileStatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*ListNeighborsResponse) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetFileStatRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *GetFileStatRequest) GetWithDirContent() bool {
+func (x *ListNeighborsResponse) GetNeighbors() []*v2.NeighborInfo {
 	if x != nil {
-		return x.WithDirContent
+		return x.Neighbors
 	}
-	return false
+	return nil
 }
 
-type GetFileStatResponse struct {
+type NeighborRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Files []*v2.FileStat `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Ip       string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	HwAddr   string `protobuf:"bytes,3,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
 }
 
-func (x *GetFileStatResponse) Reset() {
-	*x = GetFileStatResponse{}
+func (x *NeighborRequest) Reset() {
+	*x = NeighborRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,13 +839,13 @@ func (x *GetFileStatResponse) Reset() {
 	}
 }
 
-func (x *GetFileStatResponse) String() string {
+func (x *NeighborRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileStatResponse) ProtoMessage() {}
+func (*NeighborRequest) ProtoMessage() {}
 
-func (x *GetFileStatResponse) ProtoReflect() protoreflect.Message {
+func (x *NeighborRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,30 +857,43 @@ func (x *GetFileStatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileStatResponse.ProtoReflect.Descriptor instead.
-func (*GetFileStatResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NeighborRequest.ProtoReflect.Descriptor instead.
+func (*NeighborRequest) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetFileStatResponse) GetFiles() []*v2.FileStat {
+func (x *NeighborRequest) GetLinkName() string {
 	if x != nil {
-		return x.Files
+		return x.LinkName
 	}
-	return nil
+	return ""
 }
 
-type SetFileOwnerRequest struct {
+func (x *NeighborRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *NeighborRequest) GetHwAddr() string {
+	if x != nil {
+		return x.HwAddr
+	}
+	return ""
+}
+
+type FlushNeighborsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Family   v2.InetFamily `protobuf:"varint,1,opt,name=family,proto3,enum=pga.api.types.v2.InetFamily" json:"family,omitempty"`
+	LinkName string        `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
 }
 
-func (x *SetFileOwnerRequest) Reset() {
-	*x = SetFileOwnerRequest{}
+func (x *FlushNeighborsRequest) Reset() {
+	*x = FlushNeighborsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -712,13 +901,13 @@ func (x *SetFileOwnerRequest) Reset() {
 	}
 }
 
-func (x *SetFileOwnerRequest) String() string {
+func (x *FlushNeighborsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetFileOwnerRequest) ProtoMessage() {}
+func (*FlushNeighborsRequest) ProtoMessage() {}
 
-func (x *SetFileOwnerRequest) ProtoReflect() protoreflect.Message {
+func (x *FlushNeighborsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -730,43 +919,35 @@ func (x *SetFileOwnerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFileOwnerRequest.ProtoReflect.Descriptor instead.
-func (*SetFileOwnerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FlushNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*FlushNeighborsRequest) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SetFileOwnerRequest) GetPath() string {
+func (x *FlushNeighborsRequest) GetFamily() v2.InetFamily {
 	if x != nil {
-		return x.Path
+		return x.Family
 	}
-	return ""
+	return v2.InetFamily(0)
 }
 
-func (x *SetFileOwnerRequest) GetOwner() string {
+func (x *FlushNeighborsRequest) GetLinkName() string {
 	if x != nil {
-		return x.Owner
+		return x.LinkName
 	}
 	return ""
 }
 
-func (x *SetFileOwnerRequest) GetGroup() string {
-	if x != nil {
-		return x.Group
-	}
-	return ""
-}
-
-type SetFileModeRequest struct {
+type FlushNeighborsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *SetFileModeRequest) Reset() {
-	*x = SetFileModeRequest{}
+func (x *FlushNeighborsResponse) Reset() {
+	*x = FlushNeighborsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -774,13 +955,13 @@ func (x *SetFileModeRequest) Reset() {
 	}
 }
 
-func (x *SetFileModeRequest) String() string {
+func (x *FlushNeighborsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetFileModeRequest) ProtoMessage() {}
+func (*FlushNeighborsResponse) ProtoMessage() {}
 
-func (x *SetFileModeRequest) ProtoReflect() protoreflect.Message {
+func (x *FlushNeighborsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -792,38 +973,28 @@ func (x *SetFileModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFileModeRequest.ProtoReflect.Descriptor instead.
-func (*SetFileModeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FlushNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*FlushNeighborsResponse) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SetFileModeRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *SetFileModeRequest) GetMode() uint32 {
+func (x *FlushNeighborsResponse) GetCount() int32 {
 	if x != nil {
-		return x.Mode
+		return x.Count
 	}
 	return 0
 }
 
-type CreateDirRequest struct {
+type GetInterfacesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
-	Mode  uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Interfaces []*v2.InterfaceInfo `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
 }
 
-func (x *CreateDirRequest) Reset() {
-	*x = CreateDirRequest{}
+func (x *GetInterfacesResponse) Reset() {
+	*x = GetInterfacesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,13 +1002,13 @@ func (x *CreateDirRequest) Reset() {
 	}
 }
 
-func (x *CreateDirRequest) String() string {
+func (x *GetInterfacesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDirRequest) ProtoMessage() {}
+func (*GetInterfacesResponse) ProtoMessage() {}
 
-func (x *CreateDirRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInterfacesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -849,53 +1020,28 @@ func (x *CreateDirRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDirRequest.ProtoReflect.Descriptor instead.
-func (*CreateDirRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInterfacesResponse.ProtoReflect.Descriptor instead.
+func (*GetInterfacesResponse) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateDirRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *CreateDirRequest) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
-}
-
-func (x *CreateDirRequest) GetGroup() string {
-	if x != nil {
-		return x.Group
-	}
-	return ""
-}
-
-func (x *CreateDirRequest) GetMode() uint32 {
+func (x *GetInterfacesResponse) GetInterfaces() []*v2.InterfaceInfo {
 	if x != nil {
-		return x.Mode
+		return x.Interfaces
 	}
-	return 0
+	return nil
 }
 
-type UploadFileRequest struct {
+type SetInterfaceLinkStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//
-	//	*UploadFileRequest_Info
-	//	*UploadFileRequest_ChunkData
-	Data isUploadFileRequest_Data `protobuf_oneof:"data"`
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
 }
 
-func (x *UploadFileRequest) Reset() {
-	*x = UploadFileRequest{}
+func (x *SetInterfaceLinkStateRequest) Reset() {
+	*x = SetInterfaceLinkStateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,13 +1049,13 @@ func (x *UploadFileRequest) Reset() {
 	}
 }
 
-func (x *UploadFileRequest) String() string {
+func (x *SetInterfaceLinkStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFileRequest) ProtoMessage() {}
+func (*SetInterfaceLinkStateRequest) ProtoMessage() {}
 
-func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+func (x *SetInterfaceLinkStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,58 +1067,34 @@ func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
-func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetInterfaceLinkStateRequest.ProtoReflect.Descriptor instead.
+func (*SetInterfaceLinkStateRequest) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{16}
 }
 
-func (m *UploadFileRequest) GetData() isUploadFileRequest_Data {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
-
-func (x *UploadFileRequest) GetInfo() *UploadFileRequest_FileInfo {
-	if x, ok := x.GetData().(*UploadFileRequest_Info); ok {
-		return x.Info
-	}
-	return nil
-}
-
-func (x *UploadFileRequest) GetChunkData() []byte {
-	if x, ok := x.GetData().(*UploadFileRequest_ChunkData); ok {
-		return x.ChunkData
+func (x *SetInterfaceLinkStateRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
 	}
-	return nil
-}
-
-type isUploadFileRequest_Data interface {
-	isUploadFileRequest_Data()
-}
-
-type UploadFileRequest_Info struct {
-	Info *UploadFileRequest_FileInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
-}
-
-type UploadFileRequest_ChunkData struct {
-	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3,oneof"`
+	return ""
 }
 
-func (*UploadFileRequest_Info) isUploadFileRequest_Data() {}
-
-func (*UploadFileRequest_ChunkData) isUploadFileRequest_Data() {}
-
-type DownloadFileRequest struct {
+type SetLinkAttributesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	LinkName   string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	MTU        int32  `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	HwAddr     string `protobuf:"bytes,3,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
+	NewName    string `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	Txqueuelen int32  `protobuf:"varint,5,opt,name=txqueuelen,proto3" json:"txqueuelen,omitempty"`
+	Alias      string `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
+	ClearAlias bool   `protobuf:"varint,7,opt,name=clear_alias,json=clearAlias,proto3" json:"clear_alias,omitempty"`
 }
 
-func (x *DownloadFileRequest) Reset() {
-	*x = DownloadFileRequest{}
+func (x *SetLinkAttributesRequest) Reset() {
+	*x = SetLinkAttributesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,13 +1102,13 @@ func (x *DownloadFileRequest) Reset() {
 	}
 }
 
-func (x *DownloadFileRequest) String() string {
+func (x *SetLinkAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadFileRequest) ProtoMessage() {}
+func (*SetLinkAttributesRequest) ProtoMessage() {}
 
-func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
+func (x *SetLinkAttributesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,28 +1120,70 @@ func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
-func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLinkAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetLinkAttributesRequest) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DownloadFileRequest) GetPath() string {
+func (x *SetLinkAttributesRequest) GetLinkName() string {
 	if x != nil {
-		return x.Path
+		return x.LinkName
 	}
 	return ""
 }
 
-type FileContent struct {
+func (x *SetLinkAttributesRequest) GetMTU() int32 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
+}
+
+func (x *SetLinkAttributesRequest) GetHwAddr() string {
+	if x != nil {
+		return x.HwAddr
+	}
+	return ""
+}
+
+func (x *SetLinkAttributesRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *SetLinkAttributesRequest) GetTxqueuelen() int32 {
+	if x != nil {
+		return x.Txqueuelen
+	}
+	return 0
+}
+
+func (x *SetLinkAttributesRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SetLinkAttributesRequest) GetClearAlias() bool {
+	if x != nil {
+		return x.ClearAlias
+	}
+	return false
+}
+
+type SetLinkAttributesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChunkData []byte `protobuf:"bytes,1,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"`
+	Interface *v2.InterfaceInfo `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
 }
 
-func (x *FileContent) Reset() {
-	*x = FileContent{}
+func (x *SetLinkAttributesResponse) Reset() {
+	*x = SetLinkAttributesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1027,13 +1191,13 @@ func (x *FileContent) Reset() {
 	}
 }
 
-func (x *FileContent) String() string {
+func (x *SetLinkAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileContent) ProtoMessage() {}
+func (*SetLinkAttributesResponse) ProtoMessage() {}
 
-func (x *FileContent) ProtoReflect() protoreflect.Message {
+func (x *SetLinkAttributesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1045,28 +1209,40 @@ func (x *FileContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
-func (*FileContent) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLinkAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetLinkAttributesResponse) Descriptor() ([]byte, []int) {
 	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *FileContent) GetChunkData() []byte {
+func (x *SetLinkAttributesResponse) GetInterface() *v2.InterfaceInfo {
 	if x != nil {
-		return x.ChunkData
+		return x.Interface
 	}
 	return nil
 }
 
-type UploadFileRequest_FileInfo struct {
+type CreateLinkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	MTU      int32  `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	HwAddr   string `protobuf:"bytes,3,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
+	Up       bool   `protobuf:"varint,4,opt,name=up,proto3" json:"up,omitempty"`
+	// Types that are assignable to Options:
+	//
+	//	*CreateLinkRequest_Vlan_
+	//	*CreateLinkRequest_Bridge_
+	//	*CreateLinkRequest_Bond_
+	//	*CreateLinkRequest_Macvlan_
+	//	*CreateLinkRequest_Dummy_
+	//	*CreateLinkRequest_Wireguard_
+	Options isCreateLinkRequest_Options `protobuf_oneof:"options"`
 }
 
-func (x *UploadFileRequest_FileInfo) Reset() {
-	*x = UploadFileRequest_FileInfo{}
+func (x *CreateLinkRequest) Reset() {
+	*x = CreateLinkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_agent_v2_agent_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1074,13 +1250,13 @@ func (x *UploadFileRequest_FileInfo) Reset() {
 	}
 }
 
-func (x *UploadFileRequest_FileInfo) String() string {
+func (x *CreateLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFileRequest_FileInfo) ProtoMessage() {}
+func (*CreateLinkRequest) ProtoMessage() {}
 
-func (x *UploadFileRequest_FileInfo) ProtoReflect() protoreflect.Message {
+func (x *CreateLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_agent_v2_agent_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1092,969 +1268,5818 @@ func (x *UploadFileRequest_FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFileRequest_FileInfo.ProtoReflect.Descriptor instead.
-func (*UploadFileRequest_FileInfo) Descriptor() ([]byte, []int) {
-	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use CreateLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UploadFileRequest_FileInfo) GetPath() string {
+func (x *CreateLinkRequest) GetLinkName() string {
 	if x != nil {
-		return x.Path
+		return x.LinkName
 	}
 	return ""
 }
 
-var File_services_agent_v2_agent_proto protoreflect.FileDescriptor
+func (x *CreateLinkRequest) GetMTU() int32 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
+}
 
-var file_services_agent_v2_agent_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2f, 0x76, 0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x19, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76,
-	0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2f, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
-	0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x65, 0x74,
-	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x4b,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0c,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x64, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72,
-	0x63, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67,
-	0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x45, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x45,
-	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x58, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
-	0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22,
-	0x3b, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x0d,
-	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x2b,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x72, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77,
-	0x69, 0x74, 0x68, 0x44, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52,
-	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3c, 0x0a,
-	0x12, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x66, 0x0a, 0x10, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
-	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x1e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x29, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x0b, 0x46, 0x69,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x32, 0x5f, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x70, 0x67,
-	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa3, 0x06, 0x0a, 0x13, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x12, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x67, 0x61, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
-	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x30, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x65, 0x74,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x12,
-	0x37, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x69, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x37, 0x2e, 0x70, 0x67, 0x61,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a,
-	0x09, 0x41, 0x64, 0x64, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x2e, 0x70, 0x67, 0x61,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f,
-	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x2e, 0x70, 0x67,
-	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x32,
-	0xff, 0x06, 0x0a, 0x16, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x53, 0x79,
-	0x6e, 0x63, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x3c, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x77,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x30, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x56, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x09, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x12, 0x2b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x70, 0x67,
-	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x6a, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30,
-	0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x70, 0x68, 0x6f, 0x65, 0x6e, 0x69, 0x78, 0x2d, 0x67,
-	0x75, 0x65, 0x73, 0x74, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x32,
-	0x3b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CreateLinkRequest) GetHwAddr() string {
+	if x != nil {
+		return x.HwAddr
+	}
+	return ""
 }
 
-var (
-	file_services_agent_v2_agent_proto_rawDescOnce sync.Once
-	file_services_agent_v2_agent_proto_rawDescData = file_services_agent_v2_agent_proto_rawDesc
-)
+func (x *CreateLinkRequest) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
 
-func file_services_agent_v2_agent_proto_rawDescGZIP() []byte {
-	file_services_agent_v2_agent_proto_rawDescOnce.Do(func() {
-		file_services_agent_v2_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_agent_v2_agent_proto_rawDescData)
-	})
-	return file_services_agent_v2_agent_proto_rawDescData
+func (m *CreateLinkRequest) GetOptions() isCreateLinkRequest_Options {
+	if m != nil {
+		return m.Options
+	}
+	return nil
 }
 
-var file_services_agent_v2_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_services_agent_v2_agent_proto_goTypes = []interface{}{
-	(*GetInfoResponse)(nil),              // 0: pga.api.services.agent.v2.GetInfoResponse
-	(*GetRouteListRequest)(nil),          // 1: pga.api.services.agent.v2.GetRouteListRequest
-	(*GetRouteListResponse)(nil),         // 2: pga.api.services.agent.v2.GetRouteListResponse
-	(*RouteRequest)(nil),                 // 3: pga.api.services.agent.v2.RouteRequest
-	(*AddRouteResponse)(nil),             // 4: pga.api.services.agent.v2.AddRouteResponse
-	(*DelRouteResponse)(nil),             // 5: pga.api.services.agent.v2.DelRouteResponse
-	(*GetInterfacesResponse)(nil),        // 6: pga.api.services.agent.v2.GetInterfacesResponse
-	(*SetInterfaceLinkStateRequest)(nil), // 7: pga.api.services.agent.v2.SetInterfaceLinkStateRequest
-	(*IPAddrRequest)(nil),                // 8: pga.api.services.agent.v2.IPAddrRequest
-	(*GetFileMD5HashRequest)(nil),        // 9: pga.api.services.agent.v2.GetFileMD5HashRequest
-	(*GetFileMD5HashResponse)(nil),       // 10: pga.api.services.agent.v2.GetFileMD5HashResponse
-	(*GetFileStatRequest)(nil),           // 11: pga.api.services.agent.v2.GetFileStatRequest
-	(*GetFileStatResponse)(nil),          // 12: pga.api.services.agent.v2.GetFileStatResponse
-	(*SetFileOwnerRequest)(nil),          // 13: pga.api.services.agent.v2.SetFileOwnerRequest
-	(*SetFileModeRequest)(nil),           // 14: pga.api.services.agent.v2.SetFileModeRequest
-	(*CreateDirRequest)(nil),             // 15: pga.api.services.agent.v2.CreateDirRequest
-	(*UploadFileRequest)(nil),            // 16: pga.api.services.agent.v2.UploadFileRequest
-	(*DownloadFileRequest)(nil),          // 17: pga.api.services.agent.v2.DownloadFileRequest
-	(*FileContent)(nil),                  // 18: pga.api.services.agent.v2.FileContent
-	(*UploadFileRequest_FileInfo)(nil),   // 19: pga.api.services.agent.v2.UploadFileRequest.FileInfo
-	(*v2.GuestInfo)(nil),                 // 20: pga.api.types.v2.GuestInfo
-	(v2.InetFamily)(0),                   // 21: pga.api.types.v2.InetFamily
-	(*v2.RouteInfo)(nil),                 // 22: pga.api.types.v2.RouteInfo
-	(v2.RouteScope)(0),                   // 23: pga.api.types.v2.RouteScope
-	(*v2.InterfaceInfo)(nil),             // 24: pga.api.types.v2.InterfaceInfo
-	(*v2.FileStat)(nil),                  // 25: pga.api.types.v2.FileStat
-	(*emptypb.Empty)(nil),                // 26: google.protobuf.Empty
+func (x *CreateLinkRequest) GetVlan() *CreateLinkRequest_Vlan {
+	if x, ok := x.GetOptions().(*CreateLinkRequest_Vlan_); ok {
+		return x.Vlan
+	}
+	return nil
 }
-var file_services_agent_v2_agent_proto_depIdxs = []int32{
-	20, // 0: pga.api.services.agent.v2.GetInfoResponse.info:type_name -> pga.api.types.v2.GuestInfo
-	21, // 1: pga.api.services.agent.v2.GetRouteListRequest.family:type_name -> pga.api.types.v2.InetFamily
-	22, // 2: pga.api.services.agent.v2.GetRouteListResponse.routes:type_name -> pga.api.types.v2.RouteInfo
-	23, // 3: pga.api.services.agent.v2.RouteRequest.scope:type_name -> pga.api.types.v2.RouteScope
-	22, // 4: pga.api.services.agent.v2.AddRouteResponse.route:type_name -> pga.api.types.v2.RouteInfo
-	22, // 5: pga.api.services.agent.v2.DelRouteResponse.route:type_name -> pga.api.types.v2.RouteInfo
-	24, // 6: pga.api.services.agent.v2.GetInterfacesResponse.interfaces:type_name -> pga.api.types.v2.InterfaceInfo
-	25, // 7: pga.api.services.agent.v2.GetFileStatResponse.files:type_name -> pga.api.types.v2.FileStat
-	19, // 8: pga.api.services.agent.v2.UploadFileRequest.info:type_name -> pga.api.services.agent.v2.UploadFileRequest.FileInfo
-	26, // 9: pga.api.services.agent.v2.AgentService.GetInfo:input_type -> google.protobuf.Empty
-	1,  // 10: pga.api.services.agent.v2.AgentNetworkService.GetRouteList:input_type -> pga.api.services.agent.v2.GetRouteListRequest
-	3,  // 11: pga.api.services.agent.v2.AgentNetworkService.AddRoute:input_type -> pga.api.services.agent.v2.RouteRequest
-	3,  // 12: pga.api.services.agent.v2.AgentNetworkService.DelRoute:input_type -> pga.api.services.agent.v2.RouteRequest
-	26, // 13: pga.api.services.agent.v2.AgentNetworkService.GetInterfaces:input_type -> google.protobuf.Empty
-	7,  // 14: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkUp:input_type -> pga.api.services.agent.v2.SetInterfaceLinkStateRequest
-	7,  // 15: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkDown:input_type -> pga.api.services.agent.v2.SetInterfaceLinkStateRequest
-	8,  // 16: pga.api.services.agent.v2.AgentNetworkService.AddIPAddr:input_type -> pga.api.services.agent.v2.IPAddrRequest
-	8,  // 17: pga.api.services.agent.v2.AgentNetworkService.DelIPAddr:input_type -> pga.api.services.agent.v2.IPAddrRequest
-	26, // 18: pga.api.services.agent.v2.AgentFileSystemService.Sync:input_type -> google.protobuf.Empty
-	26, // 19: pga.api.services.agent.v2.AgentFileSystemService.Freeze:input_type -> google.protobuf.Empty
-	26, // 20: pga.api.services.agent.v2.AgentFileSystemService.Unfreeze:input_type -> google.protobuf.Empty
-	9,  // 21: pga.api.services.agent.v2.AgentFileSystemService.GetFileMD5Hash:input_type -> pga.api.services.agent.v2.GetFileMD5HashRequest
-	11, // 22: pga.api.services.agent.v2.AgentFileSystemService.GetFileStat:input_type -> pga.api.services.agent.v2.GetFileStatRequest
-	13, // 23: pga.api.services.agent.v2.AgentFileSystemService.SetFileOwner:input_type -> pga.api.services.agent.v2.SetFileOwnerRequest
-	14, // 24: pga.api.services.agent.v2.AgentFileSystemService.SetFileMode:input_type -> pga.api.services.agent.v2.SetFileModeRequest
-	15, // 25: pga.api.services.agent.v2.AgentFileSystemService.CreateDir:input_type -> pga.api.services.agent.v2.CreateDirRequest
-	16, // 26: pga.api.services.agent.v2.AgentFileSystemService.UploadFile:input_type -> pga.api.services.agent.v2.UploadFileRequest
-	17, // 27: pga.api.services.agent.v2.AgentFileSystemService.DownloadFile:input_type -> pga.api.services.agent.v2.DownloadFileRequest
-	0,  // 28: pga.api.services.agent.v2.AgentService.GetInfo:output_type -> pga.api.services.agent.v2.GetInfoResponse
-	2,  // 29: pga.api.services.agent.v2.AgentNetworkService.GetRouteList:output_type -> pga.api.services.agent.v2.GetRouteListResponse
-	4,  // 30: pga.api.services.agent.v2.AgentNetworkService.AddRoute:output_type -> pga.api.services.agent.v2.AddRouteResponse
-	5,  // 31: pga.api.services.agent.v2.AgentNetworkService.DelRoute:output_type -> pga.api.services.agent.v2.DelRouteResponse
-	6,  // 32: pga.api.services.agent.v2.AgentNetworkService.GetInterfaces:output_type -> pga.api.services.agent.v2.GetInterfacesResponse
-	26, // 33: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkUp:output_type -> google.protobuf.Empty
-	26, // 34: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkDown:output_type -> google.protobuf.Empty
-	26, // 35: pga.api.services.agent.v2.AgentNetworkService.AddIPAddr:output_type -> google.protobuf.Empty
-	26, // 36: pga.api.services.agent.v2.AgentNetworkService.DelIPAddr:output_type -> google.protobuf.Empty
-	26, // 37: pga.api.services.agent.v2.AgentFileSystemService.Sync:output_type -> google.protobuf.Empty
-	26, // 38: pga.api.services.agent.v2.AgentFileSystemService.Freeze:output_type -> google.protobuf.Empty
-	26, // 39: pga.api.services.agent.v2.AgentFileSystemService.Unfreeze:output_type -> google.protobuf.Empty
-	10, // 40: pga.api.services.agent.v2.AgentFileSystemService.GetFileMD5Hash:output_type -> pga.api.services.agent.v2.GetFileMD5HashResponse
-	12, // 41: pga.api.services.agent.v2.AgentFileSystemService.GetFileStat:output_type -> pga.api.services.agent.v2.GetFileStatResponse
-	26, // 42: pga.api.services.agent.v2.AgentFileSystemService.SetFileOwner:output_type -> google.protobuf.Empty
-	26, // 43: pga.api.services.agent.v2.AgentFileSystemService.SetFileMode:output_type -> google.protobuf.Empty
-	26, // 44: pga.api.services.agent.v2.AgentFileSystemService.CreateDir:output_type -> google.protobuf.Empty
-	26, // 45: pga.api.services.agent.v2.AgentFileSystemService.UploadFile:output_type -> google.protobuf.Empty
-	18, // 46: pga.api.services.agent.v2.AgentFileSystemService.DownloadFile:output_type -> pga.api.services.agent.v2.FileContent
-	28, // [28:47] is the sub-list for method output_type
-	9,  // [9:28] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+func (x *CreateLinkRequest) GetBridge() *CreateLinkRequest_Bridge {
+	if x, ok := x.GetOptions().(*CreateLinkRequest_Bridge_); ok {
+		return x.Bridge
+	}
+	return nil
 }
 
-func init() { file_services_agent_v2_agent_proto_init() }
-func file_services_agent_v2_agent_proto_init() {
-	if File_services_agent_v2_agent_proto != nil {
-		return
+func (x *CreateLinkRequest) GetBond() *CreateLinkRequest_Bond {
+	if x, ok := x.GetOptions().(*CreateLinkRequest_Bond_); ok {
+		return x.Bond
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_services_agent_v2_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *CreateLinkRequest) GetMacvlan() *CreateLinkRequest_Macvlan {
+	if x, ok := x.GetOptions().(*CreateLinkRequest_Macvlan_); ok {
+		return x.Macvlan
+	}
+	return nil
+}
+
+func (x *CreateLinkRequest) GetDummy() *CreateLinkRequest_Dummy {
+	if x, ok := x.GetOptions().(*CreateLinkRequest_Dummy_); ok {
+		return x.Dummy
+	}
+	return nil
+}
+
+func (x *CreateLinkRequest) GetWireguard() *CreateLinkRequest_Wireguard {
+	if x, ok := x.GetOptions().(*CreateLinkRequest_Wireguard_); ok {
+		return x.Wireguard
+	}
+	return nil
+}
+
+type isCreateLinkRequest_Options interface {
+	isCreateLinkRequest_Options()
+}
+
+type CreateLinkRequest_Vlan_ struct {
+	Vlan *CreateLinkRequest_Vlan `protobuf:"bytes,10,opt,name=vlan,proto3,oneof"`
+}
+
+type CreateLinkRequest_Bridge_ struct {
+	Bridge *CreateLinkRequest_Bridge `protobuf:"bytes,11,opt,name=bridge,proto3,oneof"`
+}
+
+type CreateLinkRequest_Bond_ struct {
+	Bond *CreateLinkRequest_Bond `protobuf:"bytes,12,opt,name=bond,proto3,oneof"`
+}
+
+type CreateLinkRequest_Macvlan_ struct {
+	Macvlan *CreateLinkRequest_Macvlan `protobuf:"bytes,13,opt,name=macvlan,proto3,oneof"`
+}
+
+type CreateLinkRequest_Dummy_ struct {
+	Dummy *CreateLinkRequest_Dummy `protobuf:"bytes,14,opt,name=dummy,proto3,oneof"`
+}
+
+type CreateLinkRequest_Wireguard_ struct {
+	Wireguard *CreateLinkRequest_Wireguard `protobuf:"bytes,15,opt,name=wireguard,proto3,oneof"`
+}
+
+func (*CreateLinkRequest_Vlan_) isCreateLinkRequest_Options() {}
+
+func (*CreateLinkRequest_Bridge_) isCreateLinkRequest_Options() {}
+
+func (*CreateLinkRequest_Bond_) isCreateLinkRequest_Options() {}
+
+func (*CreateLinkRequest_Macvlan_) isCreateLinkRequest_Options() {}
+
+func (*CreateLinkRequest_Dummy_) isCreateLinkRequest_Options() {}
+
+func (*CreateLinkRequest_Wireguard_) isCreateLinkRequest_Options() {}
+
+type CreateLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface *v2.InterfaceInfo `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+}
+
+func (x *CreateLinkResponse) Reset() {
+	*x = CreateLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkResponse) ProtoMessage() {}
+
+func (x *CreateLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRouteListRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateLinkResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateLinkResponse) GetInterface() *v2.InterfaceInfo {
+	if x != nil {
+		return x.Interface
+	}
+	return nil
+}
+
+type DeleteLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+}
+
+func (x *DeleteLinkRequest) Reset() {
+	*x = DeleteLinkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLinkRequest) ProtoMessage() {}
+
+func (x *DeleteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRouteListResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteLinkRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+type IPAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Addr     string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Persist  bool   `protobuf:"varint,3,opt,name=persist,proto3" json:"persist,omitempty"`
+}
+
+func (x *IPAddrRequest) Reset() {
+	*x = IPAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPAddrRequest) ProtoMessage() {}
+
+func (x *IPAddrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPAddrRequest.ProtoReflect.Descriptor instead.
+func (*IPAddrRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IPAddrRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *IPAddrRequest) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *IPAddrRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
+type WatchNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links     bool `protobuf:"varint,1,opt,name=links,proto3" json:"links,omitempty"`
+	Addrs     bool `protobuf:"varint,2,opt,name=addrs,proto3" json:"addrs,omitempty"`
+	Routes    bool `protobuf:"varint,3,opt,name=routes,proto3" json:"routes,omitempty"`
+	Neighbors bool `protobuf:"varint,4,opt,name=neighbors,proto3" json:"neighbors,omitempty"`
+}
+
+func (x *WatchNetworkRequest) Reset() {
+	*x = WatchNetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNetworkRequest) ProtoMessage() {}
+
+func (x *WatchNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRouteResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNetworkRequest.ProtoReflect.Descriptor instead.
+func (*WatchNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchNetworkRequest) GetLinks() bool {
+	if x != nil {
+		return x.Links
+	}
+	return false
+}
+
+func (x *WatchNetworkRequest) GetAddrs() bool {
+	if x != nil {
+		return x.Addrs
+	}
+	return false
+}
+
+func (x *WatchNetworkRequest) GetRoutes() bool {
+	if x != nil {
+		return x.Routes
+	}
+	return false
+}
+
+func (x *WatchNetworkRequest) GetNeighbors() bool {
+	if x != nil {
+		return x.Neighbors
+	}
+	return false
+}
+
+type NetworkEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action NetworkEvent_Action `protobuf:"varint,1,opt,name=action,proto3,enum=pga.api.services.agent.v2.NetworkEvent_Action" json:"action,omitempty"`
+	// Types that are assignable to Event:
+	//
+	//	*NetworkEvent_Link
+	//	*NetworkEvent_Addr_
+	//	*NetworkEvent_Route
+	//	*NetworkEvent_Neighbor
+	Event isNetworkEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *NetworkEvent) Reset() {
+	*x = NetworkEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkEvent) ProtoMessage() {}
+
+func (x *NetworkEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelRouteResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkEvent.ProtoReflect.Descriptor instead.
+func (*NetworkEvent) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NetworkEvent) GetAction() NetworkEvent_Action {
+	if x != nil {
+		return x.Action
+	}
+	return NetworkEvent_NEW
+}
+
+func (m *NetworkEvent) GetEvent() isNetworkEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *NetworkEvent) GetLink() *v2.InterfaceInfo {
+	if x, ok := x.GetEvent().(*NetworkEvent_Link); ok {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *NetworkEvent) GetAddr() *NetworkEvent_Addr {
+	if x, ok := x.GetEvent().(*NetworkEvent_Addr_); ok {
+		return x.Addr
+	}
+	return nil
+}
+
+func (x *NetworkEvent) GetRoute() *v2.RouteInfo {
+	if x, ok := x.GetEvent().(*NetworkEvent_Route); ok {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *NetworkEvent) GetNeighbor() *v2.NeighborInfo {
+	if x, ok := x.GetEvent().(*NetworkEvent_Neighbor); ok {
+		return x.Neighbor
+	}
+	return nil
+}
+
+type isNetworkEvent_Event interface {
+	isNetworkEvent_Event()
+}
+
+type NetworkEvent_Link struct {
+	Link *v2.InterfaceInfo `protobuf:"bytes,2,opt,name=link,proto3,oneof"`
+}
+
+type NetworkEvent_Addr_ struct {
+	Addr *NetworkEvent_Addr `protobuf:"bytes,3,opt,name=addr,proto3,oneof"`
+}
+
+type NetworkEvent_Route struct {
+	Route *v2.RouteInfo `protobuf:"bytes,4,opt,name=route,proto3,oneof"`
+}
+
+type NetworkEvent_Neighbor struct {
+	Neighbor *v2.NeighborInfo `protobuf:"bytes,5,opt,name=neighbor,proto3,oneof"`
+}
+
+func (*NetworkEvent_Link) isNetworkEvent_Event() {}
+
+func (*NetworkEvent_Addr_) isNetworkEvent_Event() {}
+
+func (*NetworkEvent_Route) isNetworkEvent_Event() {}
+
+func (*NetworkEvent_Neighbor) isNetworkEvent_Event() {}
+
+type ApplyNetworkConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links   []*ApplyNetworkConfigRequest_Link `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	Routes  []*RouteRequest                   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	Rules   []*RuleRequest                    `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Timeout uint32                            `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *ApplyNetworkConfigRequest) Reset() {
+	*x = ApplyNetworkConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyNetworkConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyNetworkConfigRequest) ProtoMessage() {}
+
+func (x *ApplyNetworkConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInterfacesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyNetworkConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyNetworkConfigRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApplyNetworkConfigRequest) GetLinks() []*ApplyNetworkConfigRequest_Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ApplyNetworkConfigRequest) GetRoutes() []*RouteRequest {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *ApplyNetworkConfigRequest) GetRules() []*RuleRequest {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ApplyNetworkConfigRequest) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ApplyNetworkConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timeout uint32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *ApplyNetworkConfigResponse) Reset() {
+	*x = ApplyNetworkConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyNetworkConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyNetworkConfigResponse) ProtoMessage() {}
+
+func (x *ApplyNetworkConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetInterfaceLinkStateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_services_agent_v2_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPAddrRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_services_agent_v2_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileMD5HashRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyNetworkConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyNetworkConfigResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApplyNetworkConfigResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplyNetworkConfigResponse) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ConfirmNetworkConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ConfirmNetworkConfigRequest) Reset() {
+	*x = ConfirmNetworkConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmNetworkConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmNetworkConfigRequest) ProtoMessage() {}
+
+func (x *ConfirmNetworkConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileMD5HashResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmNetworkConfigRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmNetworkConfigRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ConfirmNetworkConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFirewallRulesetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ruleset string `protobuf:"bytes,1,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+}
+
+func (x *GetFirewallRulesetResponse) Reset() {
+	*x = GetFirewallRulesetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFirewallRulesetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirewallRulesetResponse) ProtoMessage() {}
+
+func (x *GetFirewallRulesetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileStatRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirewallRulesetResponse.ProtoReflect.Descriptor instead.
+func (*GetFirewallRulesetResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetFirewallRulesetResponse) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+type ApplyFirewallRulesetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ruleset string `protobuf:"bytes,1,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+	Timeout uint32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *ApplyFirewallRulesetRequest) Reset() {
+	*x = ApplyFirewallRulesetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyFirewallRulesetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyFirewallRulesetRequest) ProtoMessage() {}
+
+func (x *ApplyFirewallRulesetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileStatResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyFirewallRulesetRequest.ProtoReflect.Descriptor instead.
+func (*ApplyFirewallRulesetRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApplyFirewallRulesetRequest) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *ApplyFirewallRulesetRequest) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ApplyFirewallRulesetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timeout uint32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *ApplyFirewallRulesetResponse) Reset() {
+	*x = ApplyFirewallRulesetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyFirewallRulesetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyFirewallRulesetResponse) ProtoMessage() {}
+
+func (x *ApplyFirewallRulesetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFileOwnerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyFirewallRulesetResponse.ProtoReflect.Descriptor instead.
+func (*ApplyFirewallRulesetResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ApplyFirewallRulesetResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplyFirewallRulesetResponse) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ConfirmFirewallRulesetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ConfirmFirewallRulesetRequest) Reset() {
+	*x = ConfirmFirewallRulesetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmFirewallRulesetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmFirewallRulesetRequest) ProtoMessage() {}
+
+func (x *ConfirmFirewallRulesetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFileModeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_services_agent_v2_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDirRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_services_agent_v2_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadFileRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmFirewallRulesetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmFirewallRulesetRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConfirmFirewallRulesetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WireguardPeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey           string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	HasPresharedKey     bool     `protobuf:"varint,2,opt,name=has_preshared_key,json=hasPresharedKey,proto3" json:"has_preshared_key,omitempty"`
+	Endpoint            string   `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	PersistentKeepalive uint32   `protobuf:"varint,4,opt,name=persistent_keepalive,json=persistentKeepalive,proto3" json:"persistent_keepalive,omitempty"`
+	LastHandshake       int64    `protobuf:"varint,5,opt,name=last_handshake,json=lastHandshake,proto3" json:"last_handshake,omitempty"`
+	RxBytes             uint64   `protobuf:"varint,6,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes             uint64   `protobuf:"varint,7,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	AllowedIps          []string `protobuf:"bytes,8,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	ProtocolVersion     int32    `protobuf:"varint,9,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+}
+
+func (x *WireguardPeer) Reset() {
+	*x = WireguardPeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WireguardPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireguardPeer) ProtoMessage() {}
+
+func (x *WireguardPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadFileRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireguardPeer.ProtoReflect.Descriptor instead.
+func (*WireguardPeer) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WireguardPeer) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *WireguardPeer) GetHasPresharedKey() bool {
+	if x != nil {
+		return x.HasPresharedKey
+	}
+	return false
+}
+
+func (x *WireguardPeer) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WireguardPeer) GetPersistentKeepalive() uint32 {
+	if x != nil {
+		return x.PersistentKeepalive
+	}
+	return 0
+}
+
+func (x *WireguardPeer) GetLastHandshake() int64 {
+	if x != nil {
+		return x.LastHandshake
+	}
+	return 0
+}
+
+func (x *WireguardPeer) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *WireguardPeer) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *WireguardPeer) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+func (x *WireguardPeer) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+type WireguardDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName   string           `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	PublicKey  string           `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ListenPort uint32           `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	Fwmark     uint32           `protobuf:"varint,4,opt,name=fwmark,proto3" json:"fwmark,omitempty"`
+	Peers      []*WireguardPeer `protobuf:"bytes,5,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *WireguardDevice) Reset() {
+	*x = WireguardDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WireguardDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireguardDevice) ProtoMessage() {}
+
+func (x *WireguardDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileContent); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireguardDevice.ProtoReflect.Descriptor instead.
+func (*WireguardDevice) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WireguardDevice) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *WireguardDevice) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *WireguardDevice) GetListenPort() uint32 {
+	if x != nil {
+		return x.ListenPort
+	}
+	return 0
+}
+
+func (x *WireguardDevice) GetFwmark() uint32 {
+	if x != nil {
+		return x.Fwmark
+	}
+	return 0
+}
+
+func (x *WireguardDevice) GetPeers() []*WireguardPeer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type GetWireguardDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+}
+
+func (x *GetWireguardDeviceRequest) Reset() {
+	*x = GetWireguardDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWireguardDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWireguardDeviceRequest) ProtoMessage() {}
+
+func (x *GetWireguardDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_services_agent_v2_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadFileRequest_FileInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWireguardDeviceRequest.ProtoReflect.Descriptor instead.
+func (*GetWireguardDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetWireguardDeviceRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+type GetWireguardDeviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device *WireguardDevice `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *GetWireguardDeviceResponse) Reset() {
+	*x = GetWireguardDeviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWireguardDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWireguardDeviceResponse) ProtoMessage() {}
+
+func (x *GetWireguardDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWireguardDeviceResponse.ProtoReflect.Descriptor instead.
+func (*GetWireguardDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetWireguardDeviceResponse) GetDevice() *WireguardDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type ConfigureWireguardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName     string                            `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	PrivateKey   string                            `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	ListenPort   uint32                            `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	Fwmark       uint32                            `protobuf:"varint,4,opt,name=fwmark,proto3" json:"fwmark,omitempty"`
+	ReplacePeers bool                              `protobuf:"varint,5,opt,name=replace_peers,json=replacePeers,proto3" json:"replace_peers,omitempty"`
+	Peers        []*ConfigureWireguardRequest_Peer `protobuf:"bytes,6,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *ConfigureWireguardRequest) Reset() {
+	*x = ConfigureWireguardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureWireguardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureWireguardRequest) ProtoMessage() {}
+
+func (x *ConfigureWireguardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureWireguardRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureWireguardRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConfigureWireguardRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *ConfigureWireguardRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *ConfigureWireguardRequest) GetListenPort() uint32 {
+	if x != nil {
+		return x.ListenPort
+	}
+	return 0
+}
+
+func (x *ConfigureWireguardRequest) GetFwmark() uint32 {
+	if x != nil {
+		return x.Fwmark
+	}
+	return 0
+}
+
+func (x *ConfigureWireguardRequest) GetReplacePeers() bool {
+	if x != nil {
+		return x.ReplacePeers
+	}
+	return false
+}
+
+func (x *ConfigureWireguardRequest) GetPeers() []*ConfigureWireguardRequest_Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type TrafficControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+}
+
+func (x *TrafficControlRequest) Reset() {
+	*x = TrafficControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficControlRequest) ProtoMessage() {}
+
+func (x *TrafficControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficControlRequest.ProtoReflect.Descriptor instead.
+func (*TrafficControlRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TrafficControlRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+type ListQdiscsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Qdiscs []*v2.QdiscInfo `protobuf:"bytes,1,rep,name=qdiscs,proto3" json:"qdiscs,omitempty"`
+}
+
+func (x *ListQdiscsResponse) Reset() {
+	*x = ListQdiscsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQdiscsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQdiscsResponse) ProtoMessage() {}
+
+func (x *ListQdiscsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQdiscsResponse.ProtoReflect.Descriptor instead.
+func (*ListQdiscsResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListQdiscsResponse) GetQdiscs() []*v2.QdiscInfo {
+	if x != nil {
+		return x.Qdiscs
+	}
+	return nil
+}
+
+type ListTrafficClassesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Classes []*v2.TrafficClassInfo `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+}
+
+func (x *ListTrafficClassesResponse) Reset() {
+	*x = ListTrafficClassesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrafficClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrafficClassesResponse) ProtoMessage() {}
+
+func (x *ListTrafficClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrafficClassesResponse.ProtoReflect.Descriptor instead.
+func (*ListTrafficClassesResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListTrafficClassesResponse) GetClasses() []*v2.TrafficClassInfo {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+type ListTrafficFiltersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters []*v2.TrafficFilterInfo `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *ListTrafficFiltersResponse) Reset() {
+	*x = ListTrafficFiltersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrafficFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrafficFiltersResponse) ProtoMessage() {}
+
+func (x *ListTrafficFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrafficFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListTrafficFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTrafficFiltersResponse) GetFilters() []*v2.TrafficFilterInfo {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type SetRootQdiscRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	// Types that are assignable to Qdisc:
+	//
+	//	*SetRootQdiscRequest_Tbf
+	//	*SetRootQdiscRequest_Htb
+	//	*SetRootQdiscRequest_FqCodel
+	//	*SetRootQdiscRequest_Netem
+	Qdisc isSetRootQdiscRequest_Qdisc `protobuf_oneof:"qdisc"`
+}
+
+func (x *SetRootQdiscRequest) Reset() {
+	*x = SetRootQdiscRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRootQdiscRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRootQdiscRequest) ProtoMessage() {}
+
+func (x *SetRootQdiscRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRootQdiscRequest.ProtoReflect.Descriptor instead.
+func (*SetRootQdiscRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetRootQdiscRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (m *SetRootQdiscRequest) GetQdisc() isSetRootQdiscRequest_Qdisc {
+	if m != nil {
+		return m.Qdisc
+	}
+	return nil
+}
+
+func (x *SetRootQdiscRequest) GetTbf() *v2.TbfOptions {
+	if x, ok := x.GetQdisc().(*SetRootQdiscRequest_Tbf); ok {
+		return x.Tbf
+	}
+	return nil
+}
+
+func (x *SetRootQdiscRequest) GetHtb() *v2.HtbOptions {
+	if x, ok := x.GetQdisc().(*SetRootQdiscRequest_Htb); ok {
+		return x.Htb
+	}
+	return nil
+}
+
+func (x *SetRootQdiscRequest) GetFqCodel() *v2.FqCodelOptions {
+	if x, ok := x.GetQdisc().(*SetRootQdiscRequest_FqCodel); ok {
+		return x.FqCodel
+	}
+	return nil
+}
+
+func (x *SetRootQdiscRequest) GetNetem() *v2.NetemOptions {
+	if x, ok := x.GetQdisc().(*SetRootQdiscRequest_Netem); ok {
+		return x.Netem
+	}
+	return nil
+}
+
+type isSetRootQdiscRequest_Qdisc interface {
+	isSetRootQdiscRequest_Qdisc()
+}
+
+type SetRootQdiscRequest_Tbf struct {
+	Tbf *v2.TbfOptions `protobuf:"bytes,10,opt,name=tbf,proto3,oneof"`
+}
+
+type SetRootQdiscRequest_Htb struct {
+	Htb *v2.HtbOptions `protobuf:"bytes,11,opt,name=htb,proto3,oneof"`
+}
+
+type SetRootQdiscRequest_FqCodel struct {
+	FqCodel *v2.FqCodelOptions `protobuf:"bytes,12,opt,name=fq_codel,json=fqCodel,proto3,oneof"`
+}
+
+type SetRootQdiscRequest_Netem struct {
+	Netem *v2.NetemOptions `protobuf:"bytes,13,opt,name=netem,proto3,oneof"`
+}
+
+func (*SetRootQdiscRequest_Tbf) isSetRootQdiscRequest_Qdisc() {}
+
+func (*SetRootQdiscRequest_Htb) isSetRootQdiscRequest_Qdisc() {}
+
+func (*SetRootQdiscRequest_FqCodel) isSetRootQdiscRequest_Qdisc() {}
+
+func (*SetRootQdiscRequest_Netem) isSetRootQdiscRequest_Qdisc() {}
+
+type ListSocketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tcp           bool `protobuf:"varint,1,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	Udp           bool `protobuf:"varint,2,opt,name=udp,proto3" json:"udp,omitempty"`
+	Unix          bool `protobuf:"varint,3,opt,name=unix,proto3" json:"unix,omitempty"`
+	ListeningOnly bool `protobuf:"varint,4,opt,name=listening_only,json=listeningOnly,proto3" json:"listening_only,omitempty"`
+}
+
+func (x *ListSocketsRequest) Reset() {
+	*x = ListSocketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSocketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSocketsRequest) ProtoMessage() {}
+
+func (x *ListSocketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSocketsRequest.ProtoReflect.Descriptor instead.
+func (*ListSocketsRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListSocketsRequest) GetTcp() bool {
+	if x != nil {
+		return x.Tcp
+	}
+	return false
+}
+
+func (x *ListSocketsRequest) GetUdp() bool {
+	if x != nil {
+		return x.Udp
+	}
+	return false
+}
+
+func (x *ListSocketsRequest) GetUnix() bool {
+	if x != nil {
+		return x.Unix
+	}
+	return false
+}
+
+func (x *ListSocketsRequest) GetListeningOnly() bool {
+	if x != nil {
+		return x.ListeningOnly
+	}
+	return false
+}
+
+type ListSocketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sockets []*v2.Socket `protobuf:"bytes,1,rep,name=sockets,proto3" json:"sockets,omitempty"`
+}
+
+func (x *ListSocketsResponse) Reset() {
+	*x = ListSocketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSocketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSocketsResponse) ProtoMessage() {}
+
+func (x *ListSocketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSocketsResponse.ProtoReflect.Descriptor instead.
+func (*ListSocketsResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListSocketsResponse) GetSockets() []*v2.Socket {
+	if x != nil {
+		return x.Sockets
+	}
+	return nil
+}
+
+type GetFileMD5HashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetFileMD5HashRequest) Reset() {
+	*x = GetFileMD5HashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileMD5HashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileMD5HashRequest) ProtoMessage() {}
+
+func (x *GetFileMD5HashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileMD5HashRequest.ProtoReflect.Descriptor instead.
+func (*GetFileMD5HashRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetFileMD5HashRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileMD5HashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *GetFileMD5HashResponse) Reset() {
+	*x = GetFileMD5HashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileMD5HashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileMD5HashResponse) ProtoMessage() {}
+
+func (x *GetFileMD5HashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileMD5HashResponse.ProtoReflect.Descriptor instead.
+func (*GetFileMD5HashResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetFileMD5HashResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetFileStatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path           string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	WithDirContent bool   `protobuf:"varint,2,opt,name=with_dir_content,json=withDirContent,proto3" json:"with_dir_content,omitempty"`
+}
+
+func (x *GetFileStatRequest) Reset() {
+	*x = GetFileStatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileStatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileStatRequest) ProtoMessage() {}
+
+func (x *GetFileStatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileStatRequest.ProtoReflect.Descriptor instead.
+func (*GetFileStatRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetFileStatRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetFileStatRequest) GetWithDirContent() bool {
+	if x != nil {
+		return x.WithDirContent
+	}
+	return false
+}
+
+type GetFileStatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*v2.FileStat `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *GetFileStatResponse) Reset() {
+	*x = GetFileStatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileStatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileStatResponse) ProtoMessage() {}
+
+func (x *GetFileStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileStatResponse.ProtoReflect.Descriptor instead.
+func (*GetFileStatResponse) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetFileStatResponse) GetFiles() []*v2.FileStat {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type SetFileOwnerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *SetFileOwnerRequest) Reset() {
+	*x = SetFileOwnerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFileOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFileOwnerRequest) ProtoMessage() {}
+
+func (x *SetFileOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFileOwnerRequest.ProtoReflect.Descriptor instead.
+func (*SetFileOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetFileOwnerRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetFileOwnerRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SetFileOwnerRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type SetFileModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *SetFileModeRequest) Reset() {
+	*x = SetFileModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFileModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFileModeRequest) ProtoMessage() {}
+
+func (x *SetFileModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFileModeRequest.ProtoReflect.Descriptor instead.
+func (*SetFileModeRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetFileModeRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetFileModeRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type CreateDirRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Mode  uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *CreateDirRequest) Reset() {
+	*x = CreateDirRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDirRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDirRequest) ProtoMessage() {}
+
+func (x *CreateDirRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDirRequest.ProtoReflect.Descriptor instead.
+func (*CreateDirRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateDirRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateDirRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *CreateDirRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *CreateDirRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type UploadFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//
+	//	*UploadFileRequest_Info
+	//	*UploadFileRequest_ChunkData
+	Data isUploadFileRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadFileRequest) Reset() {
+	*x = UploadFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileRequest) ProtoMessage() {}
+
+func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{51}
+}
+
+func (m *UploadFileRequest) GetData() isUploadFileRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetInfo() *UploadFileRequest_FileInfo {
+	if x, ok := x.GetData().(*UploadFileRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetChunkData() []byte {
+	if x, ok := x.GetData().(*UploadFileRequest_ChunkData); ok {
+		return x.ChunkData
+	}
+	return nil
+}
+
+type isUploadFileRequest_Data interface {
+	isUploadFileRequest_Data()
+}
+
+type UploadFileRequest_Info struct {
+	Info *UploadFileRequest_FileInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadFileRequest_ChunkData struct {
+	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3,oneof"`
+}
+
+func (*UploadFileRequest_Info) isUploadFileRequest_Data() {}
+
+func (*UploadFileRequest_ChunkData) isUploadFileRequest_Data() {}
+
+type DownloadFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *DownloadFileRequest) Reset() {
+	*x = DownloadFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileRequest) ProtoMessage() {}
+
+func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
+func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DownloadFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FileContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkData []byte `protobuf:"bytes,1,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"`
+}
+
+func (x *FileContent) Reset() {
+	*x = FileContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileContent) ProtoMessage() {}
+
+func (x *FileContent) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
+func (*FileContent) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FileContent) GetChunkData() []byte {
+	if x != nil {
+		return x.ChunkData
+	}
+	return nil
+}
+
+type CreateLinkRequest_Vlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parent   string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	VlanId   int32  `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+}
+
+func (x *CreateLinkRequest_Vlan) Reset() {
+	*x = CreateLinkRequest_Vlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkRequest_Vlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkRequest_Vlan) ProtoMessage() {}
+
+func (x *CreateLinkRequest_Vlan) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkRequest_Vlan.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest_Vlan) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *CreateLinkRequest_Vlan) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest_Vlan) GetVlanId() int32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *CreateLinkRequest_Vlan) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type CreateLinkRequest_Bridge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ports []string `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+}
+
+func (x *CreateLinkRequest_Bridge) Reset() {
+	*x = CreateLinkRequest_Bridge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkRequest_Bridge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkRequest_Bridge) ProtoMessage() {}
+
+func (x *CreateLinkRequest_Bridge) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkRequest_Bridge.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest_Bridge) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *CreateLinkRequest_Bridge) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type CreateLinkRequest_Bond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode    string   `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Miimon  int32    `protobuf:"varint,3,opt,name=miimon,proto3" json:"miimon,omitempty"`
+}
+
+func (x *CreateLinkRequest_Bond) Reset() {
+	*x = CreateLinkRequest_Bond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkRequest_Bond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkRequest_Bond) ProtoMessage() {}
+
+func (x *CreateLinkRequest_Bond) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkRequest_Bond.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest_Bond) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *CreateLinkRequest_Bond) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest_Bond) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *CreateLinkRequest_Bond) GetMiimon() int32 {
+	if x != nil {
+		return x.Miimon
+	}
+	return 0
+}
+
+type CreateLinkRequest_Macvlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Mode   string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *CreateLinkRequest_Macvlan) Reset() {
+	*x = CreateLinkRequest_Macvlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkRequest_Macvlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkRequest_Macvlan) ProtoMessage() {}
+
+func (x *CreateLinkRequest_Macvlan) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkRequest_Macvlan.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest_Macvlan) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{19, 3}
+}
+
+func (x *CreateLinkRequest_Macvlan) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateLinkRequest_Macvlan) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type CreateLinkRequest_Dummy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateLinkRequest_Dummy) Reset() {
+	*x = CreateLinkRequest_Dummy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkRequest_Dummy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkRequest_Dummy) ProtoMessage() {}
+
+func (x *CreateLinkRequest_Dummy) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkRequest_Dummy.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest_Dummy) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{19, 4}
+}
+
+type CreateLinkRequest_Wireguard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateLinkRequest_Wireguard) Reset() {
+	*x = CreateLinkRequest_Wireguard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLinkRequest_Wireguard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkRequest_Wireguard) ProtoMessage() {}
+
+func (x *CreateLinkRequest_Wireguard) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkRequest_Wireguard.ProtoReflect.Descriptor instead.
+func (*CreateLinkRequest_Wireguard) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{19, 5}
+}
+
+type NetworkEvent_Addr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkIndex int32  `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	LinkName  string `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Addr      string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Scope     int32  `protobuf:"varint,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Flags     uint32 `protobuf:"varint,5,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *NetworkEvent_Addr) Reset() {
+	*x = NetworkEvent_Addr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkEvent_Addr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkEvent_Addr) ProtoMessage() {}
+
+func (x *NetworkEvent_Addr) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkEvent_Addr.ProtoReflect.Descriptor instead.
+func (*NetworkEvent_Addr) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *NetworkEvent_Addr) GetLinkIndex() int32 {
+	if x != nil {
+		return x.LinkIndex
+	}
+	return 0
+}
+
+func (x *NetworkEvent_Addr) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *NetworkEvent_Addr) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *NetworkEvent_Addr) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *NetworkEvent_Addr) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ApplyNetworkConfigRequest_Link struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName string   `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	MTU      int32    `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	State    string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Addrs    []string `protobuf:"bytes,4,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (x *ApplyNetworkConfigRequest_Link) Reset() {
+	*x = ApplyNetworkConfigRequest_Link{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyNetworkConfigRequest_Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyNetworkConfigRequest_Link) ProtoMessage() {}
+
+func (x *ApplyNetworkConfigRequest_Link) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyNetworkConfigRequest_Link.ProtoReflect.Descriptor instead.
+func (*ApplyNetworkConfigRequest_Link) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *ApplyNetworkConfigRequest_Link) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *ApplyNetworkConfigRequest_Link) GetMTU() int32 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
+}
+
+func (x *ApplyNetworkConfigRequest_Link) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ApplyNetworkConfigRequest_Link) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+type ConfigureWireguardRequest_Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey           string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Remove              bool     `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+	UpdateOnly          bool     `protobuf:"varint,3,opt,name=update_only,json=updateOnly,proto3" json:"update_only,omitempty"`
+	PresharedKey        string   `protobuf:"bytes,4,opt,name=preshared_key,json=presharedKey,proto3" json:"preshared_key,omitempty"`
+	Endpoint            string   `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	PersistentKeepalive uint32   `protobuf:"varint,6,opt,name=persistent_keepalive,json=persistentKeepalive,proto3" json:"persistent_keepalive,omitempty"`
+	ReplaceAllowedIps   bool     `protobuf:"varint,7,opt,name=replace_allowed_ips,json=replaceAllowedIps,proto3" json:"replace_allowed_ips,omitempty"`
+	AllowedIps          []string `protobuf:"bytes,8,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+}
+
+func (x *ConfigureWireguardRequest_Peer) Reset() {
+	*x = ConfigureWireguardRequest_Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureWireguardRequest_Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureWireguardRequest_Peer) ProtoMessage() {}
+
+func (x *ConfigureWireguardRequest_Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureWireguardRequest_Peer.ProtoReflect.Descriptor instead.
+func (*ConfigureWireguardRequest_Peer) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetUpdateOnly() bool {
+	if x != nil {
+		return x.UpdateOnly
+	}
+	return false
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetPresharedKey() string {
+	if x != nil {
+		return x.PresharedKey
+	}
+	return ""
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetPersistentKeepalive() uint32 {
+	if x != nil {
+		return x.PersistentKeepalive
+	}
+	return 0
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetReplaceAllowedIps() bool {
+	if x != nil {
+		return x.ReplaceAllowedIps
+	}
+	return false
+}
+
+func (x *ConfigureWireguardRequest_Peer) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+type UploadFileRequest_FileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *UploadFileRequest_FileInfo) Reset() {
+	*x = UploadFileRequest_FileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_agent_v2_agent_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileRequest_FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileRequest_FileInfo) ProtoMessage() {}
+
+func (x *UploadFileRequest_FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_agent_v2_agent_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileRequest_FileInfo.ProtoReflect.Descriptor instead.
+func (*UploadFileRequest_FileInfo) Descriptor() ([]byte, []int) {
+	return file_services_agent_v2_agent_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *UploadFileRequest_FileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+var File_services_agent_v2_agent_proto protoreflect.FileDescriptor
+
+var file_services_agent_v2_agent_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x19, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76,
+	0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0x61, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x65, 0x74,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x94, 0x03, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x77, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x12, 0x3c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x78, 0x74,
+	0x48, 0x6f, 0x70, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22,
+	0x45, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x22, 0x48, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x65, 0x74, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x45, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0b, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34,
+	0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x49, 0x6e, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x69, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x69, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69,
+	0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x69, 0x66, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x22, 0x69, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x22, 0x57, 0x0a, 0x0f,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07,
+	0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x77, 0x41, 0x64, 0x64, 0x72, 0x22, 0x6a, 0x0a, 0x15, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x49, 0x6e, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x2e, 0x0a, 0x16, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x58, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x1c, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6d, 0x74, 0x75, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x78, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x6c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x78,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x6c, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22,
+	0x5a, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xdd, 0x06, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75,
+	0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x75, 0x70, 0x12, 0x47, 0x0a, 0x04, 0x76, 0x6c, 0x61,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x76, 0x6c,
+	0x61, 0x6e, 0x12, 0x4d, 0x0a, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x12, 0x47, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x6f,
+	0x6e, 0x64, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x07, 0x6d, 0x61,
+	0x63, 0x76, 0x6c, 0x61, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x63, 0x76, 0x6c, 0x61,
+	0x6e, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x4a, 0x0a, 0x05,
+	0x64, 0x75, 0x6d, 0x6d, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x75, 0x6d, 0x6d, 0x79, 0x48,
+	0x00, 0x52, 0x05, 0x64, 0x75, 0x6d, 0x6d, 0x79, 0x12, 0x56, 0x0a, 0x09, 0x77, 0x69, 0x72, 0x65,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x1a, 0x53, 0x0a, 0x04, 0x56, 0x6c, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x1a, 0x1e, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x4c, 0x0a, 0x04, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x69, 0x69, 0x6d, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x69,
+	0x6d, 0x6f, 0x6e, 0x1a, 0x35, 0x0a, 0x07, 0x4d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x1a, 0x07, 0x0a, 0x05, 0x44, 0x75,
+	0x6d, 0x6d, 0x79, 0x1a, 0x0b, 0x0a, 0x09, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x53, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x22, 0x30, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x0d, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x22, 0x77,
+	0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x64, 0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x22, 0xee, 0x03, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x35, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x42, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x48, 0x00, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x3c, 0x0a, 0x08, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x1a, 0x82,
+	0x01, 0x0a, 0x04, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e,
+	0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x22, 0x1a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a,
+	0x03, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x4c, 0x10, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xe8, 0x02, 0x0a, 0x19, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x1a, 0x61, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64,
+	0x64, 0x72, 0x73, 0x22, 0x46, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x65, 0x74, 0x22, 0x51, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x69, 0x72, 0x65, 0x77,
+	0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x48, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x69,
+	0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
+	0x2f, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61,
+	0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xd2, 0x02, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61,
+	0x73, 0x50, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68,
+	0x61, 0x6b, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69,
+	0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x3e,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x38,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57,
+	0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xae, 0x04, 0x0a, 0x19, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0xa3, 0x02, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x70,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x49, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x64, 0x69, 0x73, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x71, 0x64, 0x69, 0x73, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x64, 0x69, 0x73, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x71, 0x64, 0x69, 0x73, 0x63, 0x73, 0x22, 0x5a, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f,
+	0x74, 0x51, 0x64, 0x69, 0x73, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x74, 0x62,
+	0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x62, 0x66, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x30, 0x0a, 0x03,
+	0x68, 0x74, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x74, 0x62,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x03, 0x68, 0x74, 0x62, 0x12, 0x3d,
+	0x0a, 0x08, 0x66, 0x71, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x46, 0x71, 0x43, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x71, 0x43, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x36, 0x0a,
+	0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x4e, 0x65, 0x74, 0x65, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x05,
+	0x6e, 0x65, 0x74, 0x65, 0x6d, 0x42, 0x07, 0x0a, 0x05, 0x71, 0x64, 0x69, 0x73, 0x63, 0x22, 0x73,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x4f,
+	0x6e, 0x6c, 0x79, 0x22, 0x49, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x2b,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77,
+	0x69, 0x74, 0x68, 0x44, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3c, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x66, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x1e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x29, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x0b, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x32, 0x5f, 0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xb8, 0x1b, 0x0a, 0x13, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0c,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x26, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x12, 0x2a, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x2a, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x0e,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x30,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x6c, 0x75,
+	0x73, 0x68, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x30,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x70, 0x12, 0x37, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x14, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x44,
+	0x6f, 0x77, 0x6e, 0x12, 0x37, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2e, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x83, 0x01, 0x0a, 0x12, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x68, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x09, 0x44, 0x65,
+	0x6c, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x69, 0x72, 0x65,
+	0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x36, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x69, 0x72,
+	0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c,
+	0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x38, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x69, 0x72, 0x65,
+	0x77, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x57,
+	0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x57, 0x69,
+	0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x51, 0x64, 0x69, 0x73, 0x63, 0x73, 0x12, 0x30, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x64, 0x69, 0x73, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x30, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x30, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0c, 0x53,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x51, 0x64, 0x69, 0x73, 0x63, 0x12, 0x2e, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x51,
+	0x64, 0x69, 0x73, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x51, 0x64, 0x69, 0x73, 0x63, 0x12, 0x30, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x32, 0xff, 0x06, 0x0a, 0x16, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x38, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x46, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44,
+	0x35, 0x48, 0x61, 0x73, 0x68, 0x12, 0x30, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x44, 0x35, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x2d, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0c,
+	0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x12, 0x2b, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x56, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x6a, 0x0a, 0x0c, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x70, 0x68, 0x6f, 0x65,
+	0x6e, 0x69, 0x78, 0x2d, 0x67, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2f, 0x76, 0x32, 0x3b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_services_agent_v2_agent_proto_rawDescOnce sync.Once
+	file_services_agent_v2_agent_proto_rawDescData = file_services_agent_v2_agent_proto_rawDesc
+)
+
+func file_services_agent_v2_agent_proto_rawDescGZIP() []byte {
+	file_services_agent_v2_agent_proto_rawDescOnce.Do(func() {
+		file_services_agent_v2_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_agent_v2_agent_proto_rawDescData)
+	})
+	return file_services_agent_v2_agent_proto_rawDescData
+}
+
+var file_services_agent_v2_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_services_agent_v2_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_services_agent_v2_agent_proto_goTypes = []interface{}{
+	(NetworkEvent_Action)(0),               // 0: pga.api.services.agent.v2.NetworkEvent.Action
+	(*GetInfoResponse)(nil),                // 1: pga.api.services.agent.v2.GetInfoResponse
+	(*GetRouteListRequest)(nil),            // 2: pga.api.services.agent.v2.GetRouteListRequest
+	(*GetRouteListResponse)(nil),           // 3: pga.api.services.agent.v2.GetRouteListResponse
+	(*RouteRequest)(nil),                   // 4: pga.api.services.agent.v2.RouteRequest
+	(*AddRouteResponse)(nil),               // 5: pga.api.services.agent.v2.AddRouteResponse
+	(*DelRouteResponse)(nil),               // 6: pga.api.services.agent.v2.DelRouteResponse
+	(*ReplaceRouteResponse)(nil),           // 7: pga.api.services.agent.v2.ReplaceRouteResponse
+	(*ListRulesRequest)(nil),               // 8: pga.api.services.agent.v2.ListRulesRequest
+	(*ListRulesResponse)(nil),              // 9: pga.api.services.agent.v2.ListRulesResponse
+	(*RuleRequest)(nil),                    // 10: pga.api.services.agent.v2.RuleRequest
+	(*ListNeighborsRequest)(nil),           // 11: pga.api.services.agent.v2.ListNeighborsRequest
+	(*ListNeighborsResponse)(nil),          // 12: pga.api.services.agent.v2.ListNeighborsResponse
+	(*NeighborRequest)(nil),                // 13: pga.api.services.agent.v2.NeighborRequest
+	(*FlushNeighborsRequest)(nil),          // 14: pga.api.services.agent.v2.FlushNeighborsRequest
+	(*FlushNeighborsResponse)(nil),         // 15: pga.api.services.agent.v2.FlushNeighborsResponse
+	(*GetInterfacesResponse)(nil),          // 16: pga.api.services.agent.v2.GetInterfacesResponse
+	(*SetInterfaceLinkStateRequest)(nil),   // 17: pga.api.services.agent.v2.SetInterfaceLinkStateRequest
+	(*SetLinkAttributesRequest)(nil),       // 18: pga.api.services.agent.v2.SetLinkAttributesRequest
+	(*SetLinkAttributesResponse)(nil),      // 19: pga.api.services.agent.v2.SetLinkAttributesResponse
+	(*CreateLinkRequest)(nil),              // 20: pga.api.services.agent.v2.CreateLinkRequest
+	(*CreateLinkResponse)(nil),             // 21: pga.api.services.agent.v2.CreateLinkResponse
+	(*DeleteLinkRequest)(nil),              // 22: pga.api.services.agent.v2.DeleteLinkRequest
+	(*IPAddrRequest)(nil),                  // 23: pga.api.services.agent.v2.IPAddrRequest
+	(*WatchNetworkRequest)(nil),            // 24: pga.api.services.agent.v2.WatchNetworkRequest
+	(*NetworkEvent)(nil),                   // 25: pga.api.services.agent.v2.NetworkEvent
+	(*ApplyNetworkConfigRequest)(nil),      // 26: pga.api.services.agent.v2.ApplyNetworkConfigRequest
+	(*ApplyNetworkConfigResponse)(nil),     // 27: pga.api.services.agent.v2.ApplyNetworkConfigResponse
+	(*ConfirmNetworkConfigRequest)(nil),    // 28: pga.api.services.agent.v2.ConfirmNetworkConfigRequest
+	(*GetFirewallRulesetResponse)(nil),     // 29: pga.api.services.agent.v2.GetFirewallRulesetResponse
+	(*ApplyFirewallRulesetRequest)(nil),    // 30: pga.api.services.agent.v2.ApplyFirewallRulesetRequest
+	(*ApplyFirewallRulesetResponse)(nil),   // 31: pga.api.services.agent.v2.ApplyFirewallRulesetResponse
+	(*ConfirmFirewallRulesetRequest)(nil),  // 32: pga.api.services.agent.v2.ConfirmFirewallRulesetRequest
+	(*WireguardPeer)(nil),                  // 33: pga.api.services.agent.v2.WireguardPeer
+	(*WireguardDevice)(nil),                // 34: pga.api.services.agent.v2.WireguardDevice
+	(*GetWireguardDeviceRequest)(nil),      // 35: pga.api.services.agent.v2.GetWireguardDeviceRequest
+	(*GetWireguardDeviceResponse)(nil),     // 36: pga.api.services.agent.v2.GetWireguardDeviceResponse
+	(*ConfigureWireguardRequest)(nil),      // 37: pga.api.services.agent.v2.ConfigureWireguardRequest
+	(*TrafficControlRequest)(nil),          // 38: pga.api.services.agent.v2.TrafficControlRequest
+	(*ListQdiscsResponse)(nil),             // 39: pga.api.services.agent.v2.ListQdiscsResponse
+	(*ListTrafficClassesResponse)(nil),     // 40: pga.api.services.agent.v2.ListTrafficClassesResponse
+	(*ListTrafficFiltersResponse)(nil),     // 41: pga.api.services.agent.v2.ListTrafficFiltersResponse
+	(*SetRootQdiscRequest)(nil),            // 42: pga.api.services.agent.v2.SetRootQdiscRequest
+	(*ListSocketsRequest)(nil),             // 43: pga.api.services.agent.v2.ListSocketsRequest
+	(*ListSocketsResponse)(nil),            // 44: pga.api.services.agent.v2.ListSocketsResponse
+	(*GetFileMD5HashRequest)(nil),          // 45: pga.api.services.agent.v2.GetFileMD5HashRequest
+	(*GetFileMD5HashResponse)(nil),         // 46: pga.api.services.agent.v2.GetFileMD5HashResponse
+	(*GetFileStatRequest)(nil),             // 47: pga.api.services.agent.v2.GetFileStatRequest
+	(*GetFileStatResponse)(nil),            // 48: pga.api.services.agent.v2.GetFileStatResponse
+	(*SetFileOwnerRequest)(nil),            // 49: pga.api.services.agent.v2.SetFileOwnerRequest
+	(*SetFileModeRequest)(nil),             // 50: pga.api.services.agent.v2.SetFileModeRequest
+	(*CreateDirRequest)(nil),               // 51: pga.api.services.agent.v2.CreateDirRequest
+	(*UploadFileRequest)(nil),              // 52: pga.api.services.agent.v2.UploadFileRequest
+	(*DownloadFileRequest)(nil),            // 53: pga.api.services.agent.v2.DownloadFileRequest
+	(*FileContent)(nil),                    // 54: pga.api.services.agent.v2.FileContent
+	(*CreateLinkRequest_Vlan)(nil),         // 55: pga.api.services.agent.v2.CreateLinkRequest.Vlan
+	(*CreateLinkRequest_Bridge)(nil),       // 56: pga.api.services.agent.v2.CreateLinkRequest.Bridge
+	(*CreateLinkRequest_Bond)(nil),         // 57: pga.api.services.agent.v2.CreateLinkRequest.Bond
+	(*CreateLinkRequest_Macvlan)(nil),      // 58: pga.api.services.agent.v2.CreateLinkRequest.Macvlan
+	(*CreateLinkRequest_Dummy)(nil),        // 59: pga.api.services.agent.v2.CreateLinkRequest.Dummy
+	(*CreateLinkRequest_Wireguard)(nil),    // 60: pga.api.services.agent.v2.CreateLinkRequest.Wireguard
+	(*NetworkEvent_Addr)(nil),              // 61: pga.api.services.agent.v2.NetworkEvent.Addr
+	(*ApplyNetworkConfigRequest_Link)(nil), // 62: pga.api.services.agent.v2.ApplyNetworkConfigRequest.Link
+	(*ConfigureWireguardRequest_Peer)(nil), // 63: pga.api.services.agent.v2.ConfigureWireguardRequest.Peer
+	(*UploadFileRequest_FileInfo)(nil),     // 64: pga.api.services.agent.v2.UploadFileRequest.FileInfo
+	(*v2.GuestInfo)(nil),                   // 65: pga.api.types.v2.GuestInfo
+	(v2.InetFamily)(0),                     // 66: pga.api.types.v2.InetFamily
+	(*v2.RouteInfo)(nil),                   // 67: pga.api.types.v2.RouteInfo
+	(v2.RouteScope)(0),                     // 68: pga.api.types.v2.RouteScope
+	(v2.RouteType)(0),                      // 69: pga.api.types.v2.RouteType
+	(*v2.RouteNextHop)(nil),                // 70: pga.api.types.v2.RouteNextHop
+	(*v2.RuleInfo)(nil),                    // 71: pga.api.types.v2.RuleInfo
+	(*v2.NeighborInfo)(nil),                // 72: pga.api.types.v2.NeighborInfo
+	(*v2.InterfaceInfo)(nil),               // 73: pga.api.types.v2.InterfaceInfo
+	(*v2.QdiscInfo)(nil),                   // 74: pga.api.types.v2.QdiscInfo
+	(*v2.TrafficClassInfo)(nil),            // 75: pga.api.types.v2.TrafficClassInfo
+	(*v2.TrafficFilterInfo)(nil),           // 76: pga.api.types.v2.TrafficFilterInfo
+	(*v2.TbfOptions)(nil),                  // 77: pga.api.types.v2.TbfOptions
+	(*v2.HtbOptions)(nil),                  // 78: pga.api.types.v2.HtbOptions
+	(*v2.FqCodelOptions)(nil),              // 79: pga.api.types.v2.FqCodelOptions
+	(*v2.NetemOptions)(nil),                // 80: pga.api.types.v2.NetemOptions
+	(*v2.Socket)(nil),                      // 81: pga.api.types.v2.Socket
+	(*v2.FileStat)(nil),                    // 82: pga.api.types.v2.FileStat
+	(*emptypb.Empty)(nil),                  // 83: google.protobuf.Empty
+}
+var file_services_agent_v2_agent_proto_depIdxs = []int32{
+	65, // 0: pga.api.services.agent.v2.GetInfoResponse.info:type_name -> pga.api.types.v2.GuestInfo
+	66, // 1: pga.api.services.agent.v2.GetRouteListRequest.family:type_name -> pga.api.types.v2.InetFamily
+	67, // 2: pga.api.services.agent.v2.GetRouteListResponse.routes:type_name -> pga.api.types.v2.RouteInfo
+	68, // 3: pga.api.services.agent.v2.RouteRequest.scope:type_name -> pga.api.types.v2.RouteScope
+	69, // 4: pga.api.services.agent.v2.RouteRequest.type:type_name -> pga.api.types.v2.RouteType
+	70, // 5: pga.api.services.agent.v2.RouteRequest.multipath:type_name -> pga.api.types.v2.RouteNextHop
+	67, // 6: pga.api.services.agent.v2.AddRouteResponse.route:type_name -> pga.api.types.v2.RouteInfo
+	67, // 7: pga.api.services.agent.v2.DelRouteResponse.route:type_name -> pga.api.types.v2.RouteInfo
+	67, // 8: pga.api.services.agent.v2.ReplaceRouteResponse.route:type_name -> pga.api.types.v2.RouteInfo
+	66, // 9: pga.api.services.agent.v2.ListRulesRequest.family:type_name -> pga.api.types.v2.InetFamily
+	71, // 10: pga.api.services.agent.v2.ListRulesResponse.rules:type_name -> pga.api.types.v2.RuleInfo
+	66, // 11: pga.api.services.agent.v2.RuleRequest.family:type_name -> pga.api.types.v2.InetFamily
+	66, // 12: pga.api.services.agent.v2.ListNeighborsRequest.family:type_name -> pga.api.types.v2.InetFamily
+	72, // 13: pga.api.services.agent.v2.ListNeighborsResponse.neighbors:type_name -> pga.api.types.v2.NeighborInfo
+	66, // 14: pga.api.services.agent.v2.FlushNeighborsRequest.family:type_name -> pga.api.types.v2.InetFamily
+	73, // 15: pga.api.services.agent.v2.GetInterfacesResponse.interfaces:type_name -> pga.api.types.v2.InterfaceInfo
+	73, // 16: pga.api.services.agent.v2.SetLinkAttributesResponse.interface:type_name -> pga.api.types.v2.InterfaceInfo
+	55, // 17: pga.api.services.agent.v2.CreateLinkRequest.vlan:type_name -> pga.api.services.agent.v2.CreateLinkRequest.Vlan
+	56, // 18: pga.api.services.agent.v2.CreateLinkRequest.bridge:type_name -> pga.api.services.agent.v2.CreateLinkRequest.Bridge
+	57, // 19: pga.api.services.agent.v2.CreateLinkRequest.bond:type_name -> pga.api.services.agent.v2.CreateLinkRequest.Bond
+	58, // 20: pga.api.services.agent.v2.CreateLinkRequest.macvlan:type_name -> pga.api.services.agent.v2.CreateLinkRequest.Macvlan
+	59, // 21: pga.api.services.agent.v2.CreateLinkRequest.dummy:type_name -> pga.api.services.agent.v2.CreateLinkRequest.Dummy
+	60, // 22: pga.api.services.agent.v2.CreateLinkRequest.wireguard:type_name -> pga.api.services.agent.v2.CreateLinkRequest.Wireguard
+	73, // 23: pga.api.services.agent.v2.CreateLinkResponse.interface:type_name -> pga.api.types.v2.InterfaceInfo
+	0,  // 24: pga.api.services.agent.v2.NetworkEvent.action:type_name -> pga.api.services.agent.v2.NetworkEvent.Action
+	73, // 25: pga.api.services.agent.v2.NetworkEvent.link:type_name -> pga.api.types.v2.InterfaceInfo
+	61, // 26: pga.api.services.agent.v2.NetworkEvent.addr:type_name -> pga.api.services.agent.v2.NetworkEvent.Addr
+	67, // 27: pga.api.services.agent.v2.NetworkEvent.route:type_name -> pga.api.types.v2.RouteInfo
+	72, // 28: pga.api.services.agent.v2.NetworkEvent.neighbor:type_name -> pga.api.types.v2.NeighborInfo
+	62, // 29: pga.api.services.agent.v2.ApplyNetworkConfigRequest.links:type_name -> pga.api.services.agent.v2.ApplyNetworkConfigRequest.Link
+	4,  // 30: pga.api.services.agent.v2.ApplyNetworkConfigRequest.routes:type_name -> pga.api.services.agent.v2.RouteRequest
+	10, // 31: pga.api.services.agent.v2.ApplyNetworkConfigRequest.rules:type_name -> pga.api.services.agent.v2.RuleRequest
+	33, // 32: pga.api.services.agent.v2.WireguardDevice.peers:type_name -> pga.api.services.agent.v2.WireguardPeer
+	34, // 33: pga.api.services.agent.v2.GetWireguardDeviceResponse.device:type_name -> pga.api.services.agent.v2.WireguardDevice
+	63, // 34: pga.api.services.agent.v2.ConfigureWireguardRequest.peers:type_name -> pga.api.services.agent.v2.ConfigureWireguardRequest.Peer
+	74, // 35: pga.api.services.agent.v2.ListQdiscsResponse.qdiscs:type_name -> pga.api.types.v2.QdiscInfo
+	75, // 36: pga.api.services.agent.v2.ListTrafficClassesResponse.classes:type_name -> pga.api.types.v2.TrafficClassInfo
+	76, // 37: pga.api.services.agent.v2.ListTrafficFiltersResponse.filters:type_name -> pga.api.types.v2.TrafficFilterInfo
+	77, // 38: pga.api.services.agent.v2.SetRootQdiscRequest.tbf:type_name -> pga.api.types.v2.TbfOptions
+	78, // 39: pga.api.services.agent.v2.SetRootQdiscRequest.htb:type_name -> pga.api.types.v2.HtbOptions
+	79, // 40: pga.api.services.agent.v2.SetRootQdiscRequest.fq_codel:type_name -> pga.api.types.v2.FqCodelOptions
+	80, // 41: pga.api.services.agent.v2.SetRootQdiscRequest.netem:type_name -> pga.api.types.v2.NetemOptions
+	81, // 42: pga.api.services.agent.v2.ListSocketsResponse.sockets:type_name -> pga.api.types.v2.Socket
+	82, // 43: pga.api.services.agent.v2.GetFileStatResponse.files:type_name -> pga.api.types.v2.FileStat
+	64, // 44: pga.api.services.agent.v2.UploadFileRequest.info:type_name -> pga.api.services.agent.v2.UploadFileRequest.FileInfo
+	83, // 45: pga.api.services.agent.v2.AgentService.GetInfo:input_type -> google.protobuf.Empty
+	2,  // 46: pga.api.services.agent.v2.AgentNetworkService.GetRouteList:input_type -> pga.api.services.agent.v2.GetRouteListRequest
+	4,  // 47: pga.api.services.agent.v2.AgentNetworkService.AddRoute:input_type -> pga.api.services.agent.v2.RouteRequest
+	4,  // 48: pga.api.services.agent.v2.AgentNetworkService.DelRoute:input_type -> pga.api.services.agent.v2.RouteRequest
+	4,  // 49: pga.api.services.agent.v2.AgentNetworkService.ReplaceRoute:input_type -> pga.api.services.agent.v2.RouteRequest
+	8,  // 50: pga.api.services.agent.v2.AgentNetworkService.ListRules:input_type -> pga.api.services.agent.v2.ListRulesRequest
+	10, // 51: pga.api.services.agent.v2.AgentNetworkService.AddRule:input_type -> pga.api.services.agent.v2.RuleRequest
+	10, // 52: pga.api.services.agent.v2.AgentNetworkService.DelRule:input_type -> pga.api.services.agent.v2.RuleRequest
+	11, // 53: pga.api.services.agent.v2.AgentNetworkService.ListNeighbors:input_type -> pga.api.services.agent.v2.ListNeighborsRequest
+	13, // 54: pga.api.services.agent.v2.AgentNetworkService.AddNeighbor:input_type -> pga.api.services.agent.v2.NeighborRequest
+	13, // 55: pga.api.services.agent.v2.AgentNetworkService.DelNeighbor:input_type -> pga.api.services.agent.v2.NeighborRequest
+	14, // 56: pga.api.services.agent.v2.AgentNetworkService.FlushNeighbors:input_type -> pga.api.services.agent.v2.FlushNeighborsRequest
+	83, // 57: pga.api.services.agent.v2.AgentNetworkService.GetInterfaces:input_type -> google.protobuf.Empty
+	17, // 58: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkUp:input_type -> pga.api.services.agent.v2.SetInterfaceLinkStateRequest
+	17, // 59: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkDown:input_type -> pga.api.services.agent.v2.SetInterfaceLinkStateRequest
+	18, // 60: pga.api.services.agent.v2.AgentNetworkService.SetLinkAttributes:input_type -> pga.api.services.agent.v2.SetLinkAttributesRequest
+	20, // 61: pga.api.services.agent.v2.AgentNetworkService.CreateLink:input_type -> pga.api.services.agent.v2.CreateLinkRequest
+	22, // 62: pga.api.services.agent.v2.AgentNetworkService.DeleteLink:input_type -> pga.api.services.agent.v2.DeleteLinkRequest
+	24, // 63: pga.api.services.agent.v2.AgentNetworkService.WatchNetwork:input_type -> pga.api.services.agent.v2.WatchNetworkRequest
+	26, // 64: pga.api.services.agent.v2.AgentNetworkService.ApplyNetworkConfig:input_type -> pga.api.services.agent.v2.ApplyNetworkConfigRequest
+	28, // 65: pga.api.services.agent.v2.AgentNetworkService.ConfirmNetworkConfig:input_type -> pga.api.services.agent.v2.ConfirmNetworkConfigRequest
+	23, // 66: pga.api.services.agent.v2.AgentNetworkService.AddIPAddr:input_type -> pga.api.services.agent.v2.IPAddrRequest
+	23, // 67: pga.api.services.agent.v2.AgentNetworkService.DelIPAddr:input_type -> pga.api.services.agent.v2.IPAddrRequest
+	83, // 68: pga.api.services.agent.v2.AgentNetworkService.GetFirewallRuleset:input_type -> google.protobuf.Empty
+	30, // 69: pga.api.services.agent.v2.AgentNetworkService.ApplyFirewallRuleset:input_type -> pga.api.services.agent.v2.ApplyFirewallRulesetRequest
+	32, // 70: pga.api.services.agent.v2.AgentNetworkService.ConfirmFirewallRuleset:input_type -> pga.api.services.agent.v2.ConfirmFirewallRulesetRequest
+	35, // 71: pga.api.services.agent.v2.AgentNetworkService.GetWireguardDevice:input_type -> pga.api.services.agent.v2.GetWireguardDeviceRequest
+	37, // 72: pga.api.services.agent.v2.AgentNetworkService.ConfigureWireguard:input_type -> pga.api.services.agent.v2.ConfigureWireguardRequest
+	38, // 73: pga.api.services.agent.v2.AgentNetworkService.ListQdiscs:input_type -> pga.api.services.agent.v2.TrafficControlRequest
+	38, // 74: pga.api.services.agent.v2.AgentNetworkService.ListTrafficClasses:input_type -> pga.api.services.agent.v2.TrafficControlRequest
+	38, // 75: pga.api.services.agent.v2.AgentNetworkService.ListTrafficFilters:input_type -> pga.api.services.agent.v2.TrafficControlRequest
+	42, // 76: pga.api.services.agent.v2.AgentNetworkService.SetRootQdisc:input_type -> pga.api.services.agent.v2.SetRootQdiscRequest
+	38, // 77: pga.api.services.agent.v2.AgentNetworkService.DeleteRootQdisc:input_type -> pga.api.services.agent.v2.TrafficControlRequest
+	43, // 78: pga.api.services.agent.v2.AgentNetworkService.ListSockets:input_type -> pga.api.services.agent.v2.ListSocketsRequest
+	83, // 79: pga.api.services.agent.v2.AgentFileSystemService.Sync:input_type -> google.protobuf.Empty
+	83, // 80: pga.api.services.agent.v2.AgentFileSystemService.Freeze:input_type -> google.protobuf.Empty
+	83, // 81: pga.api.services.agent.v2.AgentFileSystemService.Unfreeze:input_type -> google.protobuf.Empty
+	45, // 82: pga.api.services.agent.v2.AgentFileSystemService.GetFileMD5Hash:input_type -> pga.api.services.agent.v2.GetFileMD5HashRequest
+	47, // 83: pga.api.services.agent.v2.AgentFileSystemService.GetFileStat:input_type -> pga.api.services.agent.v2.GetFileStatRequest
+	49, // 84: pga.api.services.agent.v2.AgentFileSystemService.SetFileOwner:input_type -> pga.api.services.agent.v2.SetFileOwnerRequest
+	50, // 85: pga.api.services.agent.v2.AgentFileSystemService.SetFileMode:input_type -> pga.api.services.agent.v2.SetFileModeRequest
+	51, // 86: pga.api.services.agent.v2.AgentFileSystemService.CreateDir:input_type -> pga.api.services.agent.v2.CreateDirRequest
+	52, // 87: pga.api.services.agent.v2.AgentFileSystemService.UploadFile:input_type -> pga.api.services.agent.v2.UploadFileRequest
+	53, // 88: pga.api.services.agent.v2.AgentFileSystemService.DownloadFile:input_type -> pga.api.services.agent.v2.DownloadFileRequest
+	1,  // 89: pga.api.services.agent.v2.AgentService.GetInfo:output_type -> pga.api.services.agent.v2.GetInfoResponse
+	3,  // 90: pga.api.services.agent.v2.AgentNetworkService.GetRouteList:output_type -> pga.api.services.agent.v2.GetRouteListResponse
+	5,  // 91: pga.api.services.agent.v2.AgentNetworkService.AddRoute:output_type -> pga.api.services.agent.v2.AddRouteResponse
+	6,  // 92: pga.api.services.agent.v2.AgentNetworkService.DelRoute:output_type -> pga.api.services.agent.v2.DelRouteResponse
+	7,  // 93: pga.api.services.agent.v2.AgentNetworkService.ReplaceRoute:output_type -> pga.api.services.agent.v2.ReplaceRouteResponse
+	9,  // 94: pga.api.services.agent.v2.AgentNetworkService.ListRules:output_type -> pga.api.services.agent.v2.ListRulesResponse
+	83, // 95: pga.api.services.agent.v2.AgentNetworkService.AddRule:output_type -> google.protobuf.Empty
+	83, // 96: pga.api.services.agent.v2.AgentNetworkService.DelRule:output_type -> google.protobuf.Empty
+	12, // 97: pga.api.services.agent.v2.AgentNetworkService.ListNeighbors:output_type -> pga.api.services.agent.v2.ListNeighborsResponse
+	83, // 98: pga.api.services.agent.v2.AgentNetworkService.AddNeighbor:output_type -> google.protobuf.Empty
+	83, // 99: pga.api.services.agent.v2.AgentNetworkService.DelNeighbor:output_type -> google.protobuf.Empty
+	15, // 100: pga.api.services.agent.v2.AgentNetworkService.FlushNeighbors:output_type -> pga.api.services.agent.v2.FlushNeighborsResponse
+	16, // 101: pga.api.services.agent.v2.AgentNetworkService.GetInterfaces:output_type -> pga.api.services.agent.v2.GetInterfacesResponse
+	83, // 102: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkUp:output_type -> google.protobuf.Empty
+	83, // 103: pga.api.services.agent.v2.AgentNetworkService.SetInterfaceLinkDown:output_type -> google.protobuf.Empty
+	19, // 104: pga.api.services.agent.v2.AgentNetworkService.SetLinkAttributes:output_type -> pga.api.services.agent.v2.SetLinkAttributesResponse
+	21, // 105: pga.api.services.agent.v2.AgentNetworkService.CreateLink:output_type -> pga.api.services.agent.v2.CreateLinkResponse
+	83, // 106: pga.api.services.agent.v2.AgentNetworkService.DeleteLink:output_type -> google.protobuf.Empty
+	25, // 107: pga.api.services.agent.v2.AgentNetworkService.WatchNetwork:output_type -> pga.api.services.agent.v2.NetworkEvent
+	27, // 108: pga.api.services.agent.v2.AgentNetworkService.ApplyNetworkConfig:output_type -> pga.api.services.agent.v2.ApplyNetworkConfigResponse
+	83, // 109: pga.api.services.agent.v2.AgentNetworkService.ConfirmNetworkConfig:output_type -> google.protobuf.Empty
+	83, // 110: pga.api.services.agent.v2.AgentNetworkService.AddIPAddr:output_type -> google.protobuf.Empty
+	83, // 111: pga.api.services.agent.v2.AgentNetworkService.DelIPAddr:output_type -> google.protobuf.Empty
+	29, // 112: pga.api.services.agent.v2.AgentNetworkService.GetFirewallRuleset:output_type -> pga.api.services.agent.v2.GetFirewallRulesetResponse
+	31, // 113: pga.api.services.agent.v2.AgentNetworkService.ApplyFirewallRuleset:output_type -> pga.api.services.agent.v2.ApplyFirewallRulesetResponse
+	83, // 114: pga.api.services.agent.v2.AgentNetworkService.ConfirmFirewallRuleset:output_type -> google.protobuf.Empty
+	36, // 115: pga.api.services.agent.v2.AgentNetworkService.GetWireguardDevice:output_type -> pga.api.services.agent.v2.GetWireguardDeviceResponse
+	83, // 116: pga.api.services.agent.v2.AgentNetworkService.ConfigureWireguard:output_type -> google.protobuf.Empty
+	39, // 117: pga.api.services.agent.v2.AgentNetworkService.ListQdiscs:output_type -> pga.api.services.agent.v2.ListQdiscsResponse
+	40, // 118: pga.api.services.agent.v2.AgentNetworkService.ListTrafficClasses:output_type -> pga.api.services.agent.v2.ListTrafficClassesResponse
+	41, // 119: pga.api.services.agent.v2.AgentNetworkService.ListTrafficFilters:output_type -> pga.api.services.agent.v2.ListTrafficFiltersResponse
+	83, // 120: pga.api.services.agent.v2.AgentNetworkService.SetRootQdisc:output_type -> google.protobuf.Empty
+	83, // 121: pga.api.services.agent.v2.AgentNetworkService.DeleteRootQdisc:output_type -> google.protobuf.Empty
+	44, // 122: pga.api.services.agent.v2.AgentNetworkService.ListSockets:output_type -> pga.api.services.agent.v2.ListSocketsResponse
+	83, // 123: pga.api.services.agent.v2.AgentFileSystemService.Sync:output_type -> google.protobuf.Empty
+	83, // 124: pga.api.services.agent.v2.AgentFileSystemService.Freeze:output_type -> google.protobuf.Empty
+	83, // 125: pga.api.services.agent.v2.AgentFileSystemService.Unfreeze:output_type -> google.protobuf.Empty
+	46, // 126: pga.api.services.agent.v2.AgentFileSystemService.GetFileMD5Hash:output_type -> pga.api.services.agent.v2.GetFileMD5HashResponse
+	48, // 127: pga.api.services.agent.v2.AgentFileSystemService.GetFileStat:output_type -> pga.api.services.agent.v2.GetFileStatResponse
+	83, // 128: pga.api.services.agent.v2.AgentFileSystemService.SetFileOwner:output_type -> google.protobuf.Empty
+	83, // 129: pga.api.services.agent.v2.AgentFileSystemService.SetFileMode:output_type -> google.protobuf.Empty
+	83, // 130: pga.api.services.agent.v2.AgentFileSystemService.CreateDir:output_type -> google.protobuf.Empty
+	83, // 131: pga.api.services.agent.v2.AgentFileSystemService.UploadFile:output_type -> google.protobuf.Empty
+	54, // 132: pga.api.services.agent.v2.AgentFileSystemService.DownloadFile:output_type -> pga.api.services.agent.v2.FileContent
+	89, // [89:133] is the sub-list for method output_type
+	45, // [45:89] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_services_agent_v2_agent_proto_init() }
+func file_services_agent_v2_agent_proto_init() {
+	if File_services_agent_v2_agent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_services_agent_v2_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRouteListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRouteListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNeighborsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNeighborsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushNeighborsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushNeighborsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInterfacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInterfaceLinkStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLinkAttributesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLinkAttributesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPAddrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchNetworkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyNetworkConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyNetworkConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmNetworkConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFirewallRulesetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyFirewallRulesetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyFirewallRulesetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmFirewallRulesetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WireguardPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WireguardDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWireguardDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWireguardDeviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureWireguardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQdiscsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrafficClassesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrafficFiltersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRootQdiscRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSocketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSocketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileMD5HashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileMD5HashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileStatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileStatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFileOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFileModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDirRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkRequest_Vlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkRequest_Bridge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkRequest_Bond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkRequest_Macvlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkRequest_Dummy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLinkRequest_Wireguard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkEvent_Addr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyNetworkConfigRequest_Link); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureWireguardRequest_Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_agent_v2_agent_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFileRequest_FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_services_agent_v2_agent_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*CreateLinkRequest_Vlan_)(nil),
+		(*CreateLinkRequest_Bridge_)(nil),
+		(*CreateLinkRequest_Bond_)(nil),
+		(*CreateLinkRequest_Macvlan_)(nil),
+		(*CreateLinkRequest_Dummy_)(nil),
+		(*CreateLinkRequest_Wireguard_)(nil),
+	}
+	file_services_agent_v2_agent_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*NetworkEvent_Link)(nil),
+		(*NetworkEvent_Addr_)(nil),
+		(*NetworkEvent_Route)(nil),
+		(*NetworkEvent_Neighbor)(nil),
+	}
+	file_services_agent_v2_agent_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*SetRootQdiscRequest_Tbf)(nil),
+		(*SetRootQdiscRequest_Htb)(nil),
+		(*SetRootQdiscRequest_FqCodel)(nil),
+		(*SetRootQdiscRequest_Netem)(nil),
+	}
+	file_services_agent_v2_agent_proto_msgTypes[51].OneofWrappers = []interface{}{
+		(*UploadFileRequest_Info)(nil),
+		(*UploadFileRequest_ChunkData)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_services_agent_v2_agent_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   64,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_services_agent_v2_agent_proto_goTypes,
+		DependencyIndexes: file_services_agent_v2_agent_proto_depIdxs,
+		EnumInfos:         file_services_agent_v2_agent_proto_enumTypes,
+		MessageInfos:      file_services_agent_v2_agent_proto_msgTypes,
+	}.Build()
+	File_services_agent_v2_agent_proto = out.File
+	file_services_agent_v2_agent_proto_rawDesc = nil
+	file_services_agent_v2_agent_proto_goTypes = nil
+	file_services_agent_v2_agent_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AgentServiceClient is the client API for AgentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentServiceClient interface {
+	GetInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInfoResponse, error)
+}
+
+type agentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentServiceClient(cc grpc.ClientConnInterface) AgentServiceClient {
+	return &agentServiceClient{cc}
+}
+
+func (c *agentServiceClient) GetInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentService/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentServiceServer is the server API for AgentService service.
+type AgentServiceServer interface {
+	GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error)
+}
+
+// UnimplementedAgentServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAgentServiceServer struct {
+}
+
+func (*UnimplementedAgentServiceServer) GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+
+func RegisterAgentServiceServer(s *grpc.Server, srv AgentServiceServer) {
+	s.RegisterService(&_AgentService_serviceDesc, srv)
+}
+
+func _AgentService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentService/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).GetInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AgentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pga.api.services.agent.v2.AgentService",
+	HandlerType: (*AgentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInfo",
+			Handler:    _AgentService_GetInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/agent/v2/agent.proto",
+}
+
+// AgentNetworkServiceClient is the client API for AgentNetworkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentNetworkServiceClient interface {
+	GetRouteList(ctx context.Context, in *GetRouteListRequest, opts ...grpc.CallOption) (*GetRouteListResponse, error)
+	AddRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*AddRouteResponse, error)
+	DelRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*DelRouteResponse, error)
+	ReplaceRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*ReplaceRouteResponse, error)
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	AddRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DelRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListNeighbors(ctx context.Context, in *ListNeighborsRequest, opts ...grpc.CallOption) (*ListNeighborsResponse, error)
+	AddNeighbor(ctx context.Context, in *NeighborRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DelNeighbor(ctx context.Context, in *NeighborRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FlushNeighbors(ctx context.Context, in *FlushNeighborsRequest, opts ...grpc.CallOption) (*FlushNeighborsResponse, error)
+	GetInterfaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInterfacesResponse, error)
+	SetInterfaceLinkUp(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetInterfaceLinkDown(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetLinkAttributes(ctx context.Context, in *SetLinkAttributesRequest, opts ...grpc.CallOption) (*SetLinkAttributesResponse, error)
+	CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*CreateLinkResponse, error)
+	DeleteLink(ctx context.Context, in *DeleteLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	WatchNetwork(ctx context.Context, in *WatchNetworkRequest, opts ...grpc.CallOption) (AgentNetworkService_WatchNetworkClient, error)
+	ApplyNetworkConfig(ctx context.Context, in *ApplyNetworkConfigRequest, opts ...grpc.CallOption) (*ApplyNetworkConfigResponse, error)
+	ConfirmNetworkConfig(ctx context.Context, in *ConfirmNetworkConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DelIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFirewallRuleset(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetFirewallRulesetResponse, error)
+	ApplyFirewallRuleset(ctx context.Context, in *ApplyFirewallRulesetRequest, opts ...grpc.CallOption) (*ApplyFirewallRulesetResponse, error)
+	ConfirmFirewallRuleset(ctx context.Context, in *ConfirmFirewallRulesetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetWireguardDevice(ctx context.Context, in *GetWireguardDeviceRequest, opts ...grpc.CallOption) (*GetWireguardDeviceResponse, error)
+	ConfigureWireguard(ctx context.Context, in *ConfigureWireguardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListQdiscs(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*ListQdiscsResponse, error)
+	ListTrafficClasses(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*ListTrafficClassesResponse, error)
+	ListTrafficFilters(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*ListTrafficFiltersResponse, error)
+	SetRootQdisc(ctx context.Context, in *SetRootQdiscRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteRootQdisc(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSockets(ctx context.Context, in *ListSocketsRequest, opts ...grpc.CallOption) (*ListSocketsResponse, error)
+}
+
+type agentNetworkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentNetworkServiceClient(cc grpc.ClientConnInterface) AgentNetworkServiceClient {
+	return &agentNetworkServiceClient{cc}
+}
+
+func (c *agentNetworkServiceClient) GetRouteList(ctx context.Context, in *GetRouteListRequest, opts ...grpc.CallOption) (*GetRouteListResponse, error) {
+	out := new(GetRouteListResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/GetRouteList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) AddRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*AddRouteResponse, error) {
+	out := new(AddRouteResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/AddRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) DelRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*DelRouteResponse, error) {
+	out := new(DelRouteResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DelRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ReplaceRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*ReplaceRouteResponse, error) {
+	out := new(ReplaceRouteResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ReplaceRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ListRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) AddRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/AddRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) DelRule(ctx context.Context, in *RuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DelRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ListNeighbors(ctx context.Context, in *ListNeighborsRequest, opts ...grpc.CallOption) (*ListNeighborsResponse, error) {
+	out := new(ListNeighborsResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ListNeighbors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) AddNeighbor(ctx context.Context, in *NeighborRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/AddNeighbor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) DelNeighbor(ctx context.Context, in *NeighborRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DelNeighbor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) FlushNeighbors(ctx context.Context, in *FlushNeighborsRequest, opts ...grpc.CallOption) (*FlushNeighborsResponse, error) {
+	out := new(FlushNeighborsResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/FlushNeighbors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) GetInterfaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInterfacesResponse, error) {
+	out := new(GetInterfacesResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/GetInterfaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) SetInterfaceLinkUp(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) SetInterfaceLinkDown(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkDown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) SetLinkAttributes(ctx context.Context, in *SetLinkAttributesRequest, opts ...grpc.CallOption) (*SetLinkAttributesResponse, error) {
+	out := new(SetLinkAttributesResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/SetLinkAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*CreateLinkResponse, error) {
+	out := new(CreateLinkResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/CreateLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) DeleteLink(ctx context.Context, in *DeleteLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DeleteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) WatchNetwork(ctx context.Context, in *WatchNetworkRequest, opts ...grpc.CallOption) (AgentNetworkService_WatchNetworkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentNetworkService_serviceDesc.Streams[0], "/pga.api.services.agent.v2.AgentNetworkService/WatchNetwork", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentNetworkServiceWatchNetworkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentNetworkService_WatchNetworkClient interface {
+	Recv() (*NetworkEvent, error)
+	grpc.ClientStream
+}
+
+type agentNetworkServiceWatchNetworkClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentNetworkServiceWatchNetworkClient) Recv() (*NetworkEvent, error) {
+	m := new(NetworkEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentNetworkServiceClient) ApplyNetworkConfig(ctx context.Context, in *ApplyNetworkConfigRequest, opts ...grpc.CallOption) (*ApplyNetworkConfigResponse, error) {
+	out := new(ApplyNetworkConfigResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ApplyNetworkConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ConfirmNetworkConfig(ctx context.Context, in *ConfirmNetworkConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ConfirmNetworkConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) AddIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/AddIPAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) DelIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DelIPAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) GetFirewallRuleset(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetFirewallRulesetResponse, error) {
+	out := new(GetFirewallRulesetResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/GetFirewallRuleset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ApplyFirewallRuleset(ctx context.Context, in *ApplyFirewallRulesetRequest, opts ...grpc.CallOption) (*ApplyFirewallRulesetResponse, error) {
+	out := new(ApplyFirewallRulesetResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ApplyFirewallRuleset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ConfirmFirewallRuleset(ctx context.Context, in *ConfirmFirewallRulesetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ConfirmFirewallRuleset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) GetWireguardDevice(ctx context.Context, in *GetWireguardDeviceRequest, opts ...grpc.CallOption) (*GetWireguardDeviceResponse, error) {
+	out := new(GetWireguardDeviceResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/GetWireguardDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ConfigureWireguard(ctx context.Context, in *ConfigureWireguardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ConfigureWireguard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ListQdiscs(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*ListQdiscsResponse, error) {
+	out := new(ListQdiscsResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ListQdiscs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ListTrafficClasses(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*ListTrafficClassesResponse, error) {
+	out := new(ListTrafficClassesResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ListTrafficClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ListTrafficFilters(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*ListTrafficFiltersResponse, error) {
+	out := new(ListTrafficFiltersResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ListTrafficFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) SetRootQdisc(ctx context.Context, in *SetRootQdiscRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/SetRootQdisc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) DeleteRootQdisc(ctx context.Context, in *TrafficControlRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DeleteRootQdisc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentNetworkServiceClient) ListSockets(ctx context.Context, in *ListSocketsRequest, opts ...grpc.CallOption) (*ListSocketsResponse, error) {
+	out := new(ListSocketsResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/ListSockets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentNetworkServiceServer is the server API for AgentNetworkService service.
+type AgentNetworkServiceServer interface {
+	GetRouteList(context.Context, *GetRouteListRequest) (*GetRouteListResponse, error)
+	AddRoute(context.Context, *RouteRequest) (*AddRouteResponse, error)
+	DelRoute(context.Context, *RouteRequest) (*DelRouteResponse, error)
+	ReplaceRoute(context.Context, *RouteRequest) (*ReplaceRouteResponse, error)
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	AddRule(context.Context, *RuleRequest) (*emptypb.Empty, error)
+	DelRule(context.Context, *RuleRequest) (*emptypb.Empty, error)
+	ListNeighbors(context.Context, *ListNeighborsRequest) (*ListNeighborsResponse, error)
+	AddNeighbor(context.Context, *NeighborRequest) (*emptypb.Empty, error)
+	DelNeighbor(context.Context, *NeighborRequest) (*emptypb.Empty, error)
+	FlushNeighbors(context.Context, *FlushNeighborsRequest) (*FlushNeighborsResponse, error)
+	GetInterfaces(context.Context, *emptypb.Empty) (*GetInterfacesResponse, error)
+	SetInterfaceLinkUp(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error)
+	SetInterfaceLinkDown(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error)
+	SetLinkAttributes(context.Context, *SetLinkAttributesRequest) (*SetLinkAttributesResponse, error)
+	CreateLink(context.Context, *CreateLinkRequest) (*CreateLinkResponse, error)
+	DeleteLink(context.Context, *DeleteLinkRequest) (*emptypb.Empty, error)
+	WatchNetwork(*WatchNetworkRequest, AgentNetworkService_WatchNetworkServer) error
+	ApplyNetworkConfig(context.Context, *ApplyNetworkConfigRequest) (*ApplyNetworkConfigResponse, error)
+	ConfirmNetworkConfig(context.Context, *ConfirmNetworkConfigRequest) (*emptypb.Empty, error)
+	AddIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error)
+	DelIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error)
+	GetFirewallRuleset(context.Context, *emptypb.Empty) (*GetFirewallRulesetResponse, error)
+	ApplyFirewallRuleset(context.Context, *ApplyFirewallRulesetRequest) (*ApplyFirewallRulesetResponse, error)
+	ConfirmFirewallRuleset(context.Context, *ConfirmFirewallRulesetRequest) (*emptypb.Empty, error)
+	GetWireguardDevice(context.Context, *GetWireguardDeviceRequest) (*GetWireguardDeviceResponse, error)
+	ConfigureWireguard(context.Context, *ConfigureWireguardRequest) (*emptypb.Empty, error)
+	ListQdiscs(context.Context, *TrafficControlRequest) (*ListQdiscsResponse, error)
+	ListTrafficClasses(context.Context, *TrafficControlRequest) (*ListTrafficClassesResponse, error)
+	ListTrafficFilters(context.Context, *TrafficControlRequest) (*ListTrafficFiltersResponse, error)
+	SetRootQdisc(context.Context, *SetRootQdiscRequest) (*emptypb.Empty, error)
+	DeleteRootQdisc(context.Context, *TrafficControlRequest) (*emptypb.Empty, error)
+	ListSockets(context.Context, *ListSocketsRequest) (*ListSocketsResponse, error)
+}
+
+// UnimplementedAgentNetworkServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAgentNetworkServiceServer struct {
+}
+
+func (*UnimplementedAgentNetworkServiceServer) GetRouteList(context.Context, *GetRouteListRequest) (*GetRouteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteList not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) AddRoute(context.Context, *RouteRequest) (*AddRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoute not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) DelRoute(context.Context, *RouteRequest) (*DelRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelRoute not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ReplaceRoute(context.Context, *RouteRequest) (*ReplaceRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceRoute not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) AddRule(context.Context, *RuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRule not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) DelRule(context.Context, *RuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelRule not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ListNeighbors(context.Context, *ListNeighborsRequest) (*ListNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNeighbors not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) AddNeighbor(context.Context, *NeighborRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNeighbor not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) DelNeighbor(context.Context, *NeighborRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelNeighbor not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) FlushNeighbors(context.Context, *FlushNeighborsRequest) (*FlushNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushNeighbors not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) GetInterfaces(context.Context, *emptypb.Empty) (*GetInterfacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterfaces not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) SetInterfaceLinkUp(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceLinkUp not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) SetInterfaceLinkDown(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceLinkDown not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) SetLinkAttributes(context.Context, *SetLinkAttributesRequest) (*SetLinkAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkAttributes not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) CreateLink(context.Context, *CreateLinkRequest) (*CreateLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLink not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) DeleteLink(context.Context, *DeleteLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLink not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) WatchNetwork(*WatchNetworkRequest, AgentNetworkService_WatchNetworkServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNetwork not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ApplyNetworkConfig(context.Context, *ApplyNetworkConfigRequest) (*ApplyNetworkConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyNetworkConfig not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ConfirmNetworkConfig(context.Context, *ConfirmNetworkConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmNetworkConfig not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) AddIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIPAddr not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) DelIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelIPAddr not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) GetFirewallRuleset(context.Context, *emptypb.Empty) (*GetFirewallRulesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFirewallRuleset not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ApplyFirewallRuleset(context.Context, *ApplyFirewallRulesetRequest) (*ApplyFirewallRulesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyFirewallRuleset not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ConfirmFirewallRuleset(context.Context, *ConfirmFirewallRulesetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmFirewallRuleset not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) GetWireguardDevice(context.Context, *GetWireguardDeviceRequest) (*GetWireguardDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWireguardDevice not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ConfigureWireguard(context.Context, *ConfigureWireguardRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureWireguard not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ListQdiscs(context.Context, *TrafficControlRequest) (*ListQdiscsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQdiscs not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ListTrafficClasses(context.Context, *TrafficControlRequest) (*ListTrafficClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrafficClasses not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ListTrafficFilters(context.Context, *TrafficControlRequest) (*ListTrafficFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrafficFilters not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) SetRootQdisc(context.Context, *SetRootQdiscRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRootQdisc not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) DeleteRootQdisc(context.Context, *TrafficControlRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRootQdisc not implemented")
+}
+func (*UnimplementedAgentNetworkServiceServer) ListSockets(context.Context, *ListSocketsRequest) (*ListSocketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSockets not implemented")
+}
+
+func RegisterAgentNetworkServiceServer(s *grpc.Server, srv AgentNetworkServiceServer) {
+	s.RegisterService(&_AgentNetworkService_serviceDesc, srv)
+}
+
+func _AgentNetworkService_GetRouteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).GetRouteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/GetRouteList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).GetRouteList(ctx, req.(*GetRouteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentNetworkService_AddRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).AddRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/AddRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).AddRoute(ctx, req.(*RouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentNetworkService_DelRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).DelRoute(ctx, in)
 	}
-	file_services_agent_v2_agent_proto_msgTypes[16].OneofWrappers = []interface{}{
-		(*UploadFileRequest_Info)(nil),
-		(*UploadFileRequest_ChunkData)(nil),
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DelRoute",
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_services_agent_v2_agent_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   20,
-			NumExtensions: 0,
-			NumServices:   3,
-		},
-		GoTypes:           file_services_agent_v2_agent_proto_goTypes,
-		DependencyIndexes: file_services_agent_v2_agent_proto_depIdxs,
-		MessageInfos:      file_services_agent_v2_agent_proto_msgTypes,
-	}.Build()
-	File_services_agent_v2_agent_proto = out.File
-	file_services_agent_v2_agent_proto_rawDesc = nil
-	file_services_agent_v2_agent_proto_goTypes = nil
-	file_services_agent_v2_agent_proto_depIdxs = nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).DelRoute(ctx, req.(*RouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _AgentNetworkService_ReplaceRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).ReplaceRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ReplaceRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).ReplaceRoute(ctx, req.(*RouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _AgentNetworkService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ListRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// AgentServiceClient is the client API for AgentService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AgentServiceClient interface {
-	GetInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInfoResponse, error)
+func _AgentNetworkService_AddRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).AddRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/AddRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).AddRule(ctx, req.(*RuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type agentServiceClient struct {
-	cc grpc.ClientConnInterface
+func _AgentNetworkService_DelRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).DelRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DelRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).DelRule(ctx, req.(*RuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewAgentServiceClient(cc grpc.ClientConnInterface) AgentServiceClient {
-	return &agentServiceClient{cc}
+func _AgentNetworkService_ListNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).ListNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ListNeighbors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).ListNeighbors(ctx, req.(*ListNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *agentServiceClient) GetInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentService/GetInfo", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_AddNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NeighborRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).AddNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/AddNeighbor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).AddNeighbor(ctx, req.(*NeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// AgentServiceServer is the server API for AgentService service.
-type AgentServiceServer interface {
-	GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error)
+func _AgentNetworkService_DelNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NeighborRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).DelNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DelNeighbor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).DelNeighbor(ctx, req.(*NeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAgentServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAgentServiceServer struct {
+func _AgentNetworkService_FlushNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).FlushNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/FlushNeighbors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).FlushNeighbors(ctx, req.(*FlushNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedAgentServiceServer) GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+func _AgentNetworkService_GetInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).GetInterfaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/GetInterfaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).GetInterfaces(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAgentServiceServer(s *grpc.Server, srv AgentServiceServer) {
-	s.RegisterService(&_AgentService_serviceDesc, srv)
+func _AgentNetworkService_SetInterfaceLinkUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterfaceLinkStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).SetInterfaceLinkUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).SetInterfaceLinkUp(ctx, req.(*SetInterfaceLinkStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _AgentNetworkService_SetInterfaceLinkDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInterfaceLinkStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentServiceServer).GetInfo(ctx, in)
+		return srv.(AgentNetworkServiceServer).SetInterfaceLinkDown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentService/GetInfo",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkDown",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentServiceServer).GetInfo(ctx, req.(*emptypb.Empty))
+		return srv.(AgentNetworkServiceServer).SetInterfaceLinkDown(ctx, req.(*SetInterfaceLinkStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _AgentService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pga.api.services.agent.v2.AgentService",
-	HandlerType: (*AgentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetInfo",
-			Handler:    _AgentService_GetInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "services/agent/v2/agent.proto",
+func _AgentNetworkService_SetLinkAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinkAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).SetLinkAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/SetLinkAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).SetLinkAttributes(ctx, req.(*SetLinkAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// AgentNetworkServiceClient is the client API for AgentNetworkService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AgentNetworkServiceClient interface {
-	GetRouteList(ctx context.Context, in *GetRouteListRequest, opts ...grpc.CallOption) (*GetRouteListResponse, error)
-	AddRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*AddRouteResponse, error)
-	DelRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*DelRouteResponse, error)
-	GetInterfaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInterfacesResponse, error)
-	SetInterfaceLinkUp(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	SetInterfaceLinkDown(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	AddIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	DelIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+func _AgentNetworkService_CreateLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).CreateLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/CreateLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).CreateLink(ctx, req.(*CreateLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type agentNetworkServiceClient struct {
-	cc grpc.ClientConnInterface
+func _AgentNetworkService_DeleteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).DeleteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DeleteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).DeleteLink(ctx, req.(*DeleteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewAgentNetworkServiceClient(cc grpc.ClientConnInterface) AgentNetworkServiceClient {
-	return &agentNetworkServiceClient{cc}
+func _AgentNetworkService_WatchNetwork_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNetworkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentNetworkServiceServer).WatchNetwork(m, &agentNetworkServiceWatchNetworkServer{stream})
+}
+
+type AgentNetworkService_WatchNetworkServer interface {
+	Send(*NetworkEvent) error
+	grpc.ServerStream
+}
+
+type agentNetworkServiceWatchNetworkServer struct {
+	grpc.ServerStream
 }
 
-func (c *agentNetworkServiceClient) GetRouteList(ctx context.Context, in *GetRouteListRequest, opts ...grpc.CallOption) (*GetRouteListResponse, error) {
-	out := new(GetRouteListResponse)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/GetRouteList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *agentNetworkServiceWatchNetworkServer) Send(m *NetworkEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *agentNetworkServiceClient) AddRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*AddRouteResponse, error) {
-	out := new(AddRouteResponse)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/AddRoute", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_ApplyNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyNetworkConfigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).ApplyNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ApplyNetworkConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).ApplyNetworkConfig(ctx, req.(*ApplyNetworkConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *agentNetworkServiceClient) DelRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*DelRouteResponse, error) {
-	out := new(DelRouteResponse)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DelRoute", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_ConfirmNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmNetworkConfigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).ConfirmNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ConfirmNetworkConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).ConfirmNetworkConfig(ctx, req.(*ConfirmNetworkConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *agentNetworkServiceClient) GetInterfaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInterfacesResponse, error) {
-	out := new(GetInterfacesResponse)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/GetInterfaces", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_AddIPAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPAddrRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).AddIPAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/AddIPAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).AddIPAddr(ctx, req.(*IPAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *agentNetworkServiceClient) SetInterfaceLinkUp(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkUp", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_DelIPAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPAddrRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).DelIPAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DelIPAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).DelIPAddr(ctx, req.(*IPAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *agentNetworkServiceClient) SetInterfaceLinkDown(ctx context.Context, in *SetInterfaceLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkDown", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_GetFirewallRuleset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).GetFirewallRuleset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/GetFirewallRuleset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).GetFirewallRuleset(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *agentNetworkServiceClient) AddIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/AddIPAddr", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_ApplyFirewallRuleset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyFirewallRulesetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).ApplyFirewallRuleset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ApplyFirewallRuleset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).ApplyFirewallRuleset(ctx, req.(*ApplyFirewallRulesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *agentNetworkServiceClient) DelIPAddr(ctx context.Context, in *IPAddrRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/pga.api.services.agent.v2.AgentNetworkService/DelIPAddr", in, out, opts...)
-	if err != nil {
+func _AgentNetworkService_ConfirmFirewallRuleset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmFirewallRulesetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// AgentNetworkServiceServer is the server API for AgentNetworkService service.
-type AgentNetworkServiceServer interface {
-	GetRouteList(context.Context, *GetRouteListRequest) (*GetRouteListResponse, error)
-	AddRoute(context.Context, *RouteRequest) (*AddRouteResponse, error)
-	DelRoute(context.Context, *RouteRequest) (*DelRouteResponse, error)
-	GetInterfaces(context.Context, *emptypb.Empty) (*GetInterfacesResponse, error)
-	SetInterfaceLinkUp(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error)
-	SetInterfaceLinkDown(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error)
-	AddIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error)
-	DelIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error)
-}
-
-// UnimplementedAgentNetworkServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAgentNetworkServiceServer struct {
-}
-
-func (*UnimplementedAgentNetworkServiceServer) GetRouteList(context.Context, *GetRouteListRequest) (*GetRouteListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRouteList not implemented")
-}
-func (*UnimplementedAgentNetworkServiceServer) AddRoute(context.Context, *RouteRequest) (*AddRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRoute not implemented")
-}
-func (*UnimplementedAgentNetworkServiceServer) DelRoute(context.Context, *RouteRequest) (*DelRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelRoute not implemented")
-}
-func (*UnimplementedAgentNetworkServiceServer) GetInterfaces(context.Context, *emptypb.Empty) (*GetInterfacesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInterfaces not implemented")
-}
-func (*UnimplementedAgentNetworkServiceServer) SetInterfaceLinkUp(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceLinkUp not implemented")
-}
-func (*UnimplementedAgentNetworkServiceServer) SetInterfaceLinkDown(context.Context, *SetInterfaceLinkStateRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceLinkDown not implemented")
-}
-func (*UnimplementedAgentNetworkServiceServer) AddIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddIPAddr not implemented")
-}
-func (*UnimplementedAgentNetworkServiceServer) DelIPAddr(context.Context, *IPAddrRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelIPAddr not implemented")
-}
-
-func RegisterAgentNetworkServiceServer(s *grpc.Server, srv AgentNetworkServiceServer) {
-	s.RegisterService(&_AgentNetworkService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(AgentNetworkServiceServer).ConfirmFirewallRuleset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ConfirmFirewallRuleset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentNetworkServiceServer).ConfirmFirewallRuleset(ctx, req.(*ConfirmFirewallRulesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_GetRouteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRouteListRequest)
+func _AgentNetworkService_GetWireguardDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWireguardDeviceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).GetRouteList(ctx, in)
+		return srv.(AgentNetworkServiceServer).GetWireguardDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/GetRouteList",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/GetWireguardDevice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).GetRouteList(ctx, req.(*GetRouteListRequest))
+		return srv.(AgentNetworkServiceServer).GetWireguardDevice(ctx, req.(*GetWireguardDeviceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_AddRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RouteRequest)
+func _AgentNetworkService_ConfigureWireguard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureWireguardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).AddRoute(ctx, in)
+		return srv.(AgentNetworkServiceServer).ConfigureWireguard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/AddRoute",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ConfigureWireguard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).AddRoute(ctx, req.(*RouteRequest))
+		return srv.(AgentNetworkServiceServer).ConfigureWireguard(ctx, req.(*ConfigureWireguardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_DelRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RouteRequest)
+func _AgentNetworkService_ListQdiscs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficControlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).DelRoute(ctx, in)
+		return srv.(AgentNetworkServiceServer).ListQdiscs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DelRoute",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ListQdiscs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).DelRoute(ctx, req.(*RouteRequest))
+		return srv.(AgentNetworkServiceServer).ListQdiscs(ctx, req.(*TrafficControlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_GetInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _AgentNetworkService_ListTrafficClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficControlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).GetInterfaces(ctx, in)
+		return srv.(AgentNetworkServiceServer).ListTrafficClasses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/GetInterfaces",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ListTrafficClasses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).GetInterfaces(ctx, req.(*emptypb.Empty))
+		return srv.(AgentNetworkServiceServer).ListTrafficClasses(ctx, req.(*TrafficControlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_SetInterfaceLinkUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetInterfaceLinkStateRequest)
+func _AgentNetworkService_ListTrafficFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficControlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).SetInterfaceLinkUp(ctx, in)
+		return srv.(AgentNetworkServiceServer).ListTrafficFilters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkUp",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ListTrafficFilters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).SetInterfaceLinkUp(ctx, req.(*SetInterfaceLinkStateRequest))
+		return srv.(AgentNetworkServiceServer).ListTrafficFilters(ctx, req.(*TrafficControlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_SetInterfaceLinkDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetInterfaceLinkStateRequest)
+func _AgentNetworkService_SetRootQdisc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRootQdiscRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).SetInterfaceLinkDown(ctx, in)
+		return srv.(AgentNetworkServiceServer).SetRootQdisc(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/SetInterfaceLinkDown",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/SetRootQdisc",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).SetInterfaceLinkDown(ctx, req.(*SetInterfaceLinkStateRequest))
+		return srv.(AgentNetworkServiceServer).SetRootQdisc(ctx, req.(*SetRootQdiscRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_AddIPAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IPAddrRequest)
+func _AgentNetworkService_DeleteRootQdisc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficControlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).AddIPAddr(ctx, in)
+		return srv.(AgentNetworkServiceServer).DeleteRootQdisc(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/AddIPAddr",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DeleteRootQdisc",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).AddIPAddr(ctx, req.(*IPAddrRequest))
+		return srv.(AgentNetworkServiceServer).DeleteRootQdisc(ctx, req.(*TrafficControlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentNetworkService_DelIPAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IPAddrRequest)
+func _AgentNetworkService_ListSockets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSocketsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentNetworkServiceServer).DelIPAddr(ctx, in)
+		return srv.(AgentNetworkServiceServer).ListSockets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/DelIPAddr",
+		FullMethod: "/pga.api.services.agent.v2.AgentNetworkService/ListSockets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentNetworkServiceServer).DelIPAddr(ctx, req.(*IPAddrRequest))
+		return srv.(AgentNetworkServiceServer).ListSockets(ctx, req.(*ListSocketsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2075,6 +7100,38 @@ var _AgentNetworkService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelRoute",
 			Handler:    _AgentNetworkService_DelRoute_Handler,
 		},
+		{
+			MethodName: "ReplaceRoute",
+			Handler:    _AgentNetworkService_ReplaceRoute_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _AgentNetworkService_ListRules_Handler,
+		},
+		{
+			MethodName: "AddRule",
+			Handler:    _AgentNetworkService_AddRule_Handler,
+		},
+		{
+			MethodName: "DelRule",
+			Handler:    _AgentNetworkService_DelRule_Handler,
+		},
+		{
+			MethodName: "ListNeighbors",
+			Handler:    _AgentNetworkService_ListNeighbors_Handler,
+		},
+		{
+			MethodName: "AddNeighbor",
+			Handler:    _AgentNetworkService_AddNeighbor_Handler,
+		},
+		{
+			MethodName: "DelNeighbor",
+			Handler:    _AgentNetworkService_DelNeighbor_Handler,
+		},
+		{
+			MethodName: "FlushNeighbors",
+			Handler:    _AgentNetworkService_FlushNeighbors_Handler,
+		},
 		{
 			MethodName: "GetInterfaces",
 			Handler:    _AgentNetworkService_GetInterfaces_Handler,
@@ -2087,6 +7144,26 @@ var _AgentNetworkService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetInterfaceLinkDown",
 			Handler:    _AgentNetworkService_SetInterfaceLinkDown_Handler,
 		},
+		{
+			MethodName: "SetLinkAttributes",
+			Handler:    _AgentNetworkService_SetLinkAttributes_Handler,
+		},
+		{
+			MethodName: "CreateLink",
+			Handler:    _AgentNetworkService_CreateLink_Handler,
+		},
+		{
+			MethodName: "DeleteLink",
+			Handler:    _AgentNetworkService_DeleteLink_Handler,
+		},
+		{
+			MethodName: "ApplyNetworkConfig",
+			Handler:    _AgentNetworkService_ApplyNetworkConfig_Handler,
+		},
+		{
+			MethodName: "ConfirmNetworkConfig",
+			Handler:    _AgentNetworkService_ConfirmNetworkConfig_Handler,
+		},
 		{
 			MethodName: "AddIPAddr",
 			Handler:    _AgentNetworkService_AddIPAddr_Handler,
@@ -2095,8 +7172,58 @@ var _AgentNetworkService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelIPAddr",
 			Handler:    _AgentNetworkService_DelIPAddr_Handler,
 		},
+		{
+			MethodName: "GetFirewallRuleset",
+			Handler:    _AgentNetworkService_GetFirewallRuleset_Handler,
+		},
+		{
+			MethodName: "ApplyFirewallRuleset",
+			Handler:    _AgentNetworkService_ApplyFirewallRuleset_Handler,
+		},
+		{
+			MethodName: "ConfirmFirewallRuleset",
+			Handler:    _AgentNetworkService_ConfirmFirewallRuleset_Handler,
+		},
+		{
+			MethodName: "GetWireguardDevice",
+			Handler:    _AgentNetworkService_GetWireguardDevice_Handler,
+		},
+		{
+			MethodName: "ConfigureWireguard",
+			Handler:    _AgentNetworkService_ConfigureWireguard_Handler,
+		},
+		{
+			MethodName: "ListQdiscs",
+			Handler:    _AgentNetworkService_ListQdiscs_Handler,
+		},
+		{
+			MethodName: "ListTrafficClasses",
+			Handler:    _AgentNetworkService_ListTrafficClasses_Handler,
+		},
+		{
+			MethodName: "ListTrafficFilters",
+			Handler:    _AgentNetworkService_ListTrafficFilters_Handler,
+		},
+		{
+			MethodName: "SetRootQdisc",
+			Handler:    _AgentNetworkService_SetRootQdisc_Handler,
+		},
+		{
+			MethodName: "DeleteRootQdisc",
+			Handler:    _AgentNetworkService_DeleteRootQdisc_Handler,
+		},
+		{
+			MethodName: "ListSockets",
+			Handler:    _AgentNetworkService_ListSockets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNetwork",
+			Handler:       _AgentNetworkService_WatchNetwork_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/agent/v2/agent.proto",
 }
 
@@ -0,0 +1,1508 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.18.3
+// source: services/diagnostics/v2/diagnostics.proto
+
+package diagnostics
+
+import (
+	context "context"
+	v2 "github.com/0xef53/phoenix-guest-agent/api/types/v2"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host       string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Family     uint32 `protobuf:"varint,2,opt,name=family,proto3" json:"family,omitempty"`
+	Count      uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	IntervalMs uint32 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	TimeoutMs  uint32 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Size       uint32 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Ttl        uint32 `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PingRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *PingRequest) GetFamily() uint32 {
+	if x != nil {
+		return x.Family
+	}
+	return 0
+}
+
+func (x *PingRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PingRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *PingRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *PingRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PingRequest) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*PingResponse_Reply
+	//	*PingResponse_Stats
+	Result isPingResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *PingResponse) GetResult() isPingResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *PingResponse) GetReply() *v2.PingReply {
+	if x, ok := x.GetResult().(*PingResponse_Reply); ok {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *PingResponse) GetStats() *v2.PingStats {
+	if x, ok := x.GetResult().(*PingResponse_Stats); ok {
+		return x.Stats
+	}
+	return nil
+}
+
+type isPingResponse_Result interface {
+	isPingResponse_Result()
+}
+
+type PingResponse_Reply struct {
+	Reply *v2.PingReply `protobuf:"bytes,1,opt,name=reply,proto3,oneof"`
+}
+
+type PingResponse_Stats struct {
+	Stats *v2.PingStats `protobuf:"bytes,2,opt,name=stats,proto3,oneof"`
+}
+
+func (*PingResponse_Reply) isPingResponse_Result() {}
+
+func (*PingResponse_Stats) isPingResponse_Result() {}
+
+type LookupDNSRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *LookupDNSRequest) Reset() {
+	*x = LookupDNSRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupDNSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupDNSRequest) ProtoMessage() {}
+
+func (x *LookupDNSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupDNSRequest.ProtoReflect.Descriptor instead.
+func (*LookupDNSRequest) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LookupDNSRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LookupDNSRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type LookupDNSResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Records     []string `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	Nameservers []string `protobuf:"bytes,4,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+	Search      []string `protobuf:"bytes,5,rep,name=search,proto3" json:"search,omitempty"`
+	DurationUs  uint64   `protobuf:"varint,6,opt,name=duration_us,json=durationUs,proto3" json:"duration_us,omitempty"`
+}
+
+func (x *LookupDNSResponse) Reset() {
+	*x = LookupDNSResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupDNSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupDNSResponse) ProtoMessage() {}
+
+func (x *LookupDNSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupDNSResponse.ProtoReflect.Descriptor instead.
+func (*LookupDNSResponse) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LookupDNSResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LookupDNSResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LookupDNSResponse) GetRecords() []string {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *LookupDNSResponse) GetNameservers() []string {
+	if x != nil {
+		return x.Nameservers
+	}
+	return nil
+}
+
+func (x *LookupDNSResponse) GetSearch() []string {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *LookupDNSResponse) GetDurationUs() uint64 {
+	if x != nil {
+		return x.DurationUs
+	}
+	return 0
+}
+
+type ProbeConnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network    string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Host       string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port       uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Family     uint32 `protobuf:"varint,4,opt,name=family,proto3" json:"family,omitempty"`
+	Count      uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	IntervalMs uint32 `protobuf:"varint,6,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	TimeoutMs  uint32 `protobuf:"varint,7,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *ProbeConnectRequest) Reset() {
+	*x = ProbeConnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConnectRequest) ProtoMessage() {}
+
+func (x *ProbeConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConnectRequest.ProtoReflect.Descriptor instead.
+func (*ProbeConnectRequest) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProbeConnectRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ProbeConnectRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ProbeConnectRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ProbeConnectRequest) GetFamily() uint32 {
+	if x != nil {
+		return x.Family
+	}
+	return 0
+}
+
+func (x *ProbeConnectRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ProbeConnectRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *ProbeConnectRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ProbeConnectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*ProbeConnectResponse_Probe
+	//	*ProbeConnectResponse_Stats
+	Result isProbeConnectResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *ProbeConnectResponse) Reset() {
+	*x = ProbeConnectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConnectResponse) ProtoMessage() {}
+
+func (x *ProbeConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConnectResponse.ProtoReflect.Descriptor instead.
+func (*ProbeConnectResponse) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *ProbeConnectResponse) GetResult() isProbeConnectResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ProbeConnectResponse) GetProbe() *v2.ConnectProbe {
+	if x, ok := x.GetResult().(*ProbeConnectResponse_Probe); ok {
+		return x.Probe
+	}
+	return nil
+}
+
+func (x *ProbeConnectResponse) GetStats() *v2.ConnectStats {
+	if x, ok := x.GetResult().(*ProbeConnectResponse_Stats); ok {
+		return x.Stats
+	}
+	return nil
+}
+
+type isProbeConnectResponse_Result interface {
+	isProbeConnectResponse_Result()
+}
+
+type ProbeConnectResponse_Probe struct {
+	Probe *v2.ConnectProbe `protobuf:"bytes,1,opt,name=probe,proto3,oneof"`
+}
+
+type ProbeConnectResponse_Stats struct {
+	Stats *v2.ConnectStats `protobuf:"bytes,2,opt,name=stats,proto3,oneof"`
+}
+
+func (*ProbeConnectResponse_Probe) isProbeConnectResponse_Result() {}
+
+func (*ProbeConnectResponse_Stats) isProbeConnectResponse_Result() {}
+
+type TracerouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host      string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Family    uint32 `protobuf:"varint,2,opt,name=family,proto3" json:"family,omitempty"`
+	Port      uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	MaxHops   uint32 `protobuf:"varint,4,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	Queries   uint32 `protobuf:"varint,5,opt,name=queries,proto3" json:"queries,omitempty"`
+	TimeoutMs uint32 `protobuf:"varint,6,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *TracerouteRequest) Reset() {
+	*x = TracerouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracerouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracerouteRequest) ProtoMessage() {}
+
+func (x *TracerouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracerouteRequest.ProtoReflect.Descriptor instead.
+func (*TracerouteRequest) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TracerouteRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TracerouteRequest) GetFamily() uint32 {
+	if x != nil {
+		return x.Family
+	}
+	return 0
+}
+
+func (x *TracerouteRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *TracerouteRequest) GetMaxHops() uint32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *TracerouteRequest) GetQueries() uint32 {
+	if x != nil {
+		return x.Queries
+	}
+	return 0
+}
+
+func (x *TracerouteRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type TracerouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*TracerouteResponse_Hop
+	//	*TracerouteResponse_Stats
+	Result isTracerouteResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *TracerouteResponse) Reset() {
+	*x = TracerouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracerouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracerouteResponse) ProtoMessage() {}
+
+func (x *TracerouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracerouteResponse.ProtoReflect.Descriptor instead.
+func (*TracerouteResponse) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *TracerouteResponse) GetResult() isTracerouteResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *TracerouteResponse) GetHop() *v2.TracerouteHop {
+	if x, ok := x.GetResult().(*TracerouteResponse_Hop); ok {
+		return x.Hop
+	}
+	return nil
+}
+
+func (x *TracerouteResponse) GetStats() *v2.TracerouteStats {
+	if x, ok := x.GetResult().(*TracerouteResponse_Stats); ok {
+		return x.Stats
+	}
+	return nil
+}
+
+type isTracerouteResponse_Result interface {
+	isTracerouteResponse_Result()
+}
+
+type TracerouteResponse_Hop struct {
+	Hop *v2.TracerouteHop `protobuf:"bytes,1,opt,name=hop,proto3,oneof"`
+}
+
+type TracerouteResponse_Stats struct {
+	Stats *v2.TracerouteStats `protobuf:"bytes,2,opt,name=stats,proto3,oneof"`
+}
+
+func (*TracerouteResponse_Hop) isTracerouteResponse_Result() {}
+
+func (*TracerouteResponse_Stats) isTracerouteResponse_Result() {}
+
+type CapturePacketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkName   string `protobuf:"bytes,1,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	BpfFilter  string `protobuf:"bytes,2,opt,name=bpf_filter,json=bpfFilter,proto3" json:"bpf_filter,omitempty"`
+	Snaplen    uint32 `protobuf:"varint,3,opt,name=snaplen,proto3" json:"snaplen,omitempty"`
+	MaxPackets uint32 `protobuf:"varint,4,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	Duration   uint32 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *CapturePacketsRequest) Reset() {
+	*x = CapturePacketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapturePacketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePacketsRequest) ProtoMessage() {}
+
+func (x *CapturePacketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePacketsRequest.ProtoReflect.Descriptor instead.
+func (*CapturePacketsRequest) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CapturePacketsRequest) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *CapturePacketsRequest) GetBpfFilter() string {
+	if x != nil {
+		return x.BpfFilter
+	}
+	return ""
+}
+
+func (x *CapturePacketsRequest) GetSnaplen() uint32 {
+	if x != nil {
+		return x.Snaplen
+	}
+	return 0
+}
+
+func (x *CapturePacketsRequest) GetMaxPackets() uint32 {
+	if x != nil {
+		return x.MaxPackets
+	}
+	return 0
+}
+
+func (x *CapturePacketsRequest) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type CapturePacketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CapturePacketsResponse) Reset() {
+	*x = CapturePacketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapturePacketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePacketsResponse) ProtoMessage() {}
+
+func (x *CapturePacketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_diagnostics_v2_diagnostics_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePacketsResponse.ProtoReflect.Descriptor instead.
+func (*CapturePacketsResponse) Descriptor() ([]byte, []int) {
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CapturePacketsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_services_diagnostics_v2_diagnostics_proto protoreflect.FileDescriptor
+
+var file_services_diagnostics_v2_diagnostics_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x32, 0x1a, 0x14, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x3a, 0x0a, 0x10, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x11,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x22, 0xc5,
+	0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x48, 0x00,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x68, 0x6f,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x6f, 0x70, 0x48, 0x00, 0x52, 0x03, 0x68, 0x6f, 0x70, 0x12,
+	0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x70, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x70, 0x66, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e,
+	0x61, 0x70, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x6e, 0x61,
+	0x70, 0x6c, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2c, 0x0a, 0x16, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32,
+	0xfc, 0x04, 0x0a, 0x17, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x04, 0x50,
+	0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x09, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e,
+	0x53, 0x12, 0x31, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x4e, 0x53,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x0c, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x34, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f,
+	0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x0a, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x32, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x36, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x4f,
+	0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65,
+	0x66, 0x35, 0x33, 0x2f, 0x70, 0x68, 0x6f, 0x65, 0x6e, 0x69, 0x78, 0x2d, 0x67, 0x75, 0x65, 0x73,
+	0x74, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2f, 0x76, 0x32, 0x3b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_services_diagnostics_v2_diagnostics_proto_rawDescOnce sync.Once
+	file_services_diagnostics_v2_diagnostics_proto_rawDescData = file_services_diagnostics_v2_diagnostics_proto_rawDesc
+)
+
+func file_services_diagnostics_v2_diagnostics_proto_rawDescGZIP() []byte {
+	file_services_diagnostics_v2_diagnostics_proto_rawDescOnce.Do(func() {
+		file_services_diagnostics_v2_diagnostics_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_diagnostics_v2_diagnostics_proto_rawDescData)
+	})
+	return file_services_diagnostics_v2_diagnostics_proto_rawDescData
+}
+
+var file_services_diagnostics_v2_diagnostics_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_services_diagnostics_v2_diagnostics_proto_goTypes = []interface{}{
+	(*PingRequest)(nil),            // 0: pga.api.services.diagnostics.v2.PingRequest
+	(*PingResponse)(nil),           // 1: pga.api.services.diagnostics.v2.PingResponse
+	(*LookupDNSRequest)(nil),       // 2: pga.api.services.diagnostics.v2.LookupDNSRequest
+	(*LookupDNSResponse)(nil),      // 3: pga.api.services.diagnostics.v2.LookupDNSResponse
+	(*ProbeConnectRequest)(nil),    // 4: pga.api.services.diagnostics.v2.ProbeConnectRequest
+	(*ProbeConnectResponse)(nil),   // 5: pga.api.services.diagnostics.v2.ProbeConnectResponse
+	(*TracerouteRequest)(nil),      // 6: pga.api.services.diagnostics.v2.TracerouteRequest
+	(*TracerouteResponse)(nil),     // 7: pga.api.services.diagnostics.v2.TracerouteResponse
+	(*CapturePacketsRequest)(nil),  // 8: pga.api.services.diagnostics.v2.CapturePacketsRequest
+	(*CapturePacketsResponse)(nil), // 9: pga.api.services.diagnostics.v2.CapturePacketsResponse
+	(*v2.PingReply)(nil),           // 10: pga.api.types.v2.PingReply
+	(*v2.PingStats)(nil),           // 11: pga.api.types.v2.PingStats
+	(*v2.ConnectProbe)(nil),        // 12: pga.api.types.v2.ConnectProbe
+	(*v2.ConnectStats)(nil),        // 13: pga.api.types.v2.ConnectStats
+	(*v2.TracerouteHop)(nil),       // 14: pga.api.types.v2.TracerouteHop
+	(*v2.TracerouteStats)(nil),     // 15: pga.api.types.v2.TracerouteStats
+}
+var file_services_diagnostics_v2_diagnostics_proto_depIdxs = []int32{
+	10, // 0: pga.api.services.diagnostics.v2.PingResponse.reply:type_name -> pga.api.types.v2.PingReply
+	11, // 1: pga.api.services.diagnostics.v2.PingResponse.stats:type_name -> pga.api.types.v2.PingStats
+	12, // 2: pga.api.services.diagnostics.v2.ProbeConnectResponse.probe:type_name -> pga.api.types.v2.ConnectProbe
+	13, // 3: pga.api.services.diagnostics.v2.ProbeConnectResponse.stats:type_name -> pga.api.types.v2.ConnectStats
+	14, // 4: pga.api.services.diagnostics.v2.TracerouteResponse.hop:type_name -> pga.api.types.v2.TracerouteHop
+	15, // 5: pga.api.services.diagnostics.v2.TracerouteResponse.stats:type_name -> pga.api.types.v2.TracerouteStats
+	0,  // 6: pga.api.services.diagnostics.v2.AgentDiagnosticsService.Ping:input_type -> pga.api.services.diagnostics.v2.PingRequest
+	2,  // 7: pga.api.services.diagnostics.v2.AgentDiagnosticsService.LookupDNS:input_type -> pga.api.services.diagnostics.v2.LookupDNSRequest
+	4,  // 8: pga.api.services.diagnostics.v2.AgentDiagnosticsService.ProbeConnect:input_type -> pga.api.services.diagnostics.v2.ProbeConnectRequest
+	6,  // 9: pga.api.services.diagnostics.v2.AgentDiagnosticsService.Traceroute:input_type -> pga.api.services.diagnostics.v2.TracerouteRequest
+	8,  // 10: pga.api.services.diagnostics.v2.AgentDiagnosticsService.CapturePackets:input_type -> pga.api.services.diagnostics.v2.CapturePacketsRequest
+	1,  // 11: pga.api.services.diagnostics.v2.AgentDiagnosticsService.Ping:output_type -> pga.api.services.diagnostics.v2.PingResponse
+	3,  // 12: pga.api.services.diagnostics.v2.AgentDiagnosticsService.LookupDNS:output_type -> pga.api.services.diagnostics.v2.LookupDNSResponse
+	5,  // 13: pga.api.services.diagnostics.v2.AgentDiagnosticsService.ProbeConnect:output_type -> pga.api.services.diagnostics.v2.ProbeConnectResponse
+	7,  // 14: pga.api.services.diagnostics.v2.AgentDiagnosticsService.Traceroute:output_type -> pga.api.services.diagnostics.v2.TracerouteResponse
+	9,  // 15: pga.api.services.diagnostics.v2.AgentDiagnosticsService.CapturePackets:output_type -> pga.api.services.diagnostics.v2.CapturePacketsResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_services_diagnostics_v2_diagnostics_proto_init() }
+func file_services_diagnostics_v2_diagnostics_proto_init() {
+	if File_services_diagnostics_v2_diagnostics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupDNSRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupDNSResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeConnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturePacketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_diagnostics_v2_diagnostics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturePacketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_services_diagnostics_v2_diagnostics_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*PingResponse_Reply)(nil),
+		(*PingResponse_Stats)(nil),
+	}
+	file_services_diagnostics_v2_diagnostics_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*ProbeConnectResponse_Probe)(nil),
+		(*ProbeConnectResponse_Stats)(nil),
+	}
+	file_services_diagnostics_v2_diagnostics_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*TracerouteResponse_Hop)(nil),
+		(*TracerouteResponse_Stats)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_services_diagnostics_v2_diagnostics_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_services_diagnostics_v2_diagnostics_proto_goTypes,
+		DependencyIndexes: file_services_diagnostics_v2_diagnostics_proto_depIdxs,
+		MessageInfos:      file_services_diagnostics_v2_diagnostics_proto_msgTypes,
+	}.Build()
+	File_services_diagnostics_v2_diagnostics_proto = out.File
+	file_services_diagnostics_v2_diagnostics_proto_rawDesc = nil
+	file_services_diagnostics_v2_diagnostics_proto_goTypes = nil
+	file_services_diagnostics_v2_diagnostics_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AgentDiagnosticsServiceClient is the client API for AgentDiagnosticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentDiagnosticsServiceClient interface {
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_PingClient, error)
+	LookupDNS(ctx context.Context, in *LookupDNSRequest, opts ...grpc.CallOption) (*LookupDNSResponse, error)
+	ProbeConnect(ctx context.Context, in *ProbeConnectRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_ProbeConnectClient, error)
+	Traceroute(ctx context.Context, in *TracerouteRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_TracerouteClient, error)
+	CapturePackets(ctx context.Context, in *CapturePacketsRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_CapturePacketsClient, error)
+}
+
+type agentDiagnosticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentDiagnosticsServiceClient(cc grpc.ClientConnInterface) AgentDiagnosticsServiceClient {
+	return &agentDiagnosticsServiceClient{cc}
+}
+
+func (c *agentDiagnosticsServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_PingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentDiagnosticsService_serviceDesc.Streams[0], "/pga.api.services.diagnostics.v2.AgentDiagnosticsService/Ping", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentDiagnosticsServicePingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentDiagnosticsService_PingClient interface {
+	Recv() (*PingResponse, error)
+	grpc.ClientStream
+}
+
+type agentDiagnosticsServicePingClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentDiagnosticsServicePingClient) Recv() (*PingResponse, error) {
+	m := new(PingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentDiagnosticsServiceClient) LookupDNS(ctx context.Context, in *LookupDNSRequest, opts ...grpc.CallOption) (*LookupDNSResponse, error) {
+	out := new(LookupDNSResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.diagnostics.v2.AgentDiagnosticsService/LookupDNS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentDiagnosticsServiceClient) ProbeConnect(ctx context.Context, in *ProbeConnectRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_ProbeConnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentDiagnosticsService_serviceDesc.Streams[1], "/pga.api.services.diagnostics.v2.AgentDiagnosticsService/ProbeConnect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentDiagnosticsServiceProbeConnectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentDiagnosticsService_ProbeConnectClient interface {
+	Recv() (*ProbeConnectResponse, error)
+	grpc.ClientStream
+}
+
+type agentDiagnosticsServiceProbeConnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentDiagnosticsServiceProbeConnectClient) Recv() (*ProbeConnectResponse, error) {
+	m := new(ProbeConnectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentDiagnosticsServiceClient) Traceroute(ctx context.Context, in *TracerouteRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_TracerouteClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentDiagnosticsService_serviceDesc.Streams[2], "/pga.api.services.diagnostics.v2.AgentDiagnosticsService/Traceroute", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentDiagnosticsServiceTracerouteClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentDiagnosticsService_TracerouteClient interface {
+	Recv() (*TracerouteResponse, error)
+	grpc.ClientStream
+}
+
+type agentDiagnosticsServiceTracerouteClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentDiagnosticsServiceTracerouteClient) Recv() (*TracerouteResponse, error) {
+	m := new(TracerouteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentDiagnosticsServiceClient) CapturePackets(ctx context.Context, in *CapturePacketsRequest, opts ...grpc.CallOption) (AgentDiagnosticsService_CapturePacketsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentDiagnosticsService_serviceDesc.Streams[3], "/pga.api.services.diagnostics.v2.AgentDiagnosticsService/CapturePackets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentDiagnosticsServiceCapturePacketsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentDiagnosticsService_CapturePacketsClient interface {
+	Recv() (*CapturePacketsResponse, error)
+	grpc.ClientStream
+}
+
+type agentDiagnosticsServiceCapturePacketsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentDiagnosticsServiceCapturePacketsClient) Recv() (*CapturePacketsResponse, error) {
+	m := new(CapturePacketsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AgentDiagnosticsServiceServer is the server API for AgentDiagnosticsService service.
+type AgentDiagnosticsServiceServer interface {
+	Ping(*PingRequest, AgentDiagnosticsService_PingServer) error
+	LookupDNS(context.Context, *LookupDNSRequest) (*LookupDNSResponse, error)
+	ProbeConnect(*ProbeConnectRequest, AgentDiagnosticsService_ProbeConnectServer) error
+	Traceroute(*TracerouteRequest, AgentDiagnosticsService_TracerouteServer) error
+	CapturePackets(*CapturePacketsRequest, AgentDiagnosticsService_CapturePacketsServer) error
+}
+
+// UnimplementedAgentDiagnosticsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAgentDiagnosticsServiceServer struct {
+}
+
+func (*UnimplementedAgentDiagnosticsServiceServer) Ping(*PingRequest, AgentDiagnosticsService_PingServer) error {
+	return status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedAgentDiagnosticsServiceServer) LookupDNS(context.Context, *LookupDNSRequest) (*LookupDNSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupDNS not implemented")
+}
+func (*UnimplementedAgentDiagnosticsServiceServer) ProbeConnect(*ProbeConnectRequest, AgentDiagnosticsService_ProbeConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProbeConnect not implemented")
+}
+func (*UnimplementedAgentDiagnosticsServiceServer) Traceroute(*TracerouteRequest, AgentDiagnosticsService_TracerouteServer) error {
+	return status.Errorf(codes.Unimplemented, "method Traceroute not implemented")
+}
+func (*UnimplementedAgentDiagnosticsServiceServer) CapturePackets(*CapturePacketsRequest, AgentDiagnosticsService_CapturePacketsServer) error {
+	return status.Errorf(codes.Unimplemented, "method CapturePackets not implemented")
+}
+
+func RegisterAgentDiagnosticsServiceServer(s *grpc.Server, srv AgentDiagnosticsServiceServer) {
+	s.RegisterService(&_AgentDiagnosticsService_serviceDesc, srv)
+}
+
+func _AgentDiagnosticsService_Ping_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentDiagnosticsServiceServer).Ping(m, &agentDiagnosticsServicePingServer{stream})
+}
+
+type AgentDiagnosticsService_PingServer interface {
+	Send(*PingResponse) error
+	grpc.ServerStream
+}
+
+type agentDiagnosticsServicePingServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentDiagnosticsServicePingServer) Send(m *PingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AgentDiagnosticsService_LookupDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentDiagnosticsServiceServer).LookupDNS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.diagnostics.v2.AgentDiagnosticsService/LookupDNS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentDiagnosticsServiceServer).LookupDNS(ctx, req.(*LookupDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentDiagnosticsService_ProbeConnect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProbeConnectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentDiagnosticsServiceServer).ProbeConnect(m, &agentDiagnosticsServiceProbeConnectServer{stream})
+}
+
+type AgentDiagnosticsService_ProbeConnectServer interface {
+	Send(*ProbeConnectResponse) error
+	grpc.ServerStream
+}
+
+type agentDiagnosticsServiceProbeConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentDiagnosticsServiceProbeConnectServer) Send(m *ProbeConnectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AgentDiagnosticsService_Traceroute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TracerouteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentDiagnosticsServiceServer).Traceroute(m, &agentDiagnosticsServiceTracerouteServer{stream})
+}
+
+type AgentDiagnosticsService_TracerouteServer interface {
+	Send(*TracerouteResponse) error
+	grpc.ServerStream
+}
+
+type agentDiagnosticsServiceTracerouteServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentDiagnosticsServiceTracerouteServer) Send(m *TracerouteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AgentDiagnosticsService_CapturePackets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CapturePacketsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentDiagnosticsServiceServer).CapturePackets(m, &agentDiagnosticsServiceCapturePacketsServer{stream})
+}
+
+type AgentDiagnosticsService_CapturePacketsServer interface {
+	Send(*CapturePacketsResponse) error
+	grpc.ServerStream
+}
+
+type agentDiagnosticsServiceCapturePacketsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentDiagnosticsServiceCapturePacketsServer) Send(m *CapturePacketsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _AgentDiagnosticsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pga.api.services.diagnostics.v2.AgentDiagnosticsService",
+	HandlerType: (*AgentDiagnosticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LookupDNS",
+			Handler:    _AgentDiagnosticsService_LookupDNS_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Ping",
+			Handler:       _AgentDiagnosticsService_Ping_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ProbeConnect",
+			Handler:       _AgentDiagnosticsService_ProbeConnect_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Traceroute",
+			Handler:       _AgentDiagnosticsService_Traceroute_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CapturePackets",
+			Handler:       _AgentDiagnosticsService_CapturePackets_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services/diagnostics/v2/diagnostics.proto",
+}
@@ -0,0 +1,1349 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.18.3
+// source: services/storage/v2/storage.proto
+
+package storage
+
+import (
+	context "context"
+	v2 "github.com/0xef53/phoenix-guest-agent/api/types/v2"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPhysicalVolumesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volumes []*v2.PhysicalVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+}
+
+func (x *GetPhysicalVolumesResponse) Reset() {
+	*x = GetPhysicalVolumesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPhysicalVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPhysicalVolumesResponse) ProtoMessage() {}
+
+func (x *GetPhysicalVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPhysicalVolumesResponse.ProtoReflect.Descriptor instead.
+func (*GetPhysicalVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPhysicalVolumesResponse) GetVolumes() []*v2.PhysicalVolume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type GetVolumeGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*v2.VolumeGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *GetVolumeGroupsResponse) Reset() {
+	*x = GetVolumeGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeGroupsResponse) ProtoMessage() {}
+
+func (x *GetVolumeGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVolumeGroupsResponse) GetGroups() []*v2.VolumeGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetLogicalVolumesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volumes []*v2.LogicalVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+}
+
+func (x *GetLogicalVolumesResponse) Reset() {
+	*x = GetLogicalVolumesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogicalVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogicalVolumesResponse) ProtoMessage() {}
+
+func (x *GetLogicalVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogicalVolumesResponse.ProtoReflect.Descriptor instead.
+func (*GetLogicalVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLogicalVolumesResponse) GetVolumes() []*v2.LogicalVolume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type ResizePhysicalVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *ResizePhysicalVolumeRequest) Reset() {
+	*x = ResizePhysicalVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizePhysicalVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizePhysicalVolumeRequest) ProtoMessage() {}
+
+func (x *ResizePhysicalVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizePhysicalVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ResizePhysicalVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResizePhysicalVolumeRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type ExtendLogicalVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VgName    string `protobuf:"bytes,1,opt,name=vg_name,json=vgName,proto3" json:"vg_name,omitempty"`
+	LvName    string `protobuf:"bytes,2,opt,name=lv_name,json=lvName,proto3" json:"lv_name,omitempty"`
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ResizeFs  bool   `protobuf:"varint,4,opt,name=resize_fs,json=resizeFs,proto3" json:"resize_fs,omitempty"`
+}
+
+func (x *ExtendLogicalVolumeRequest) Reset() {
+	*x = ExtendLogicalVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendLogicalVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendLogicalVolumeRequest) ProtoMessage() {}
+
+func (x *ExtendLogicalVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendLogicalVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ExtendLogicalVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExtendLogicalVolumeRequest) GetVgName() string {
+	if x != nil {
+		return x.VgName
+	}
+	return ""
+}
+
+func (x *ExtendLogicalVolumeRequest) GetLvName() string {
+	if x != nil {
+		return x.LvName
+	}
+	return ""
+}
+
+func (x *ExtendLogicalVolumeRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ExtendLogicalVolumeRequest) GetResizeFs() bool {
+	if x != nil {
+		return x.ResizeFs
+	}
+	return false
+}
+
+type ExtendLogicalVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volume *v2.LogicalVolume `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *ExtendLogicalVolumeResponse) Reset() {
+	*x = ExtendLogicalVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendLogicalVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendLogicalVolumeResponse) ProtoMessage() {}
+
+func (x *ExtendLogicalVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendLogicalVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ExtendLogicalVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExtendLogicalVolumeResponse) GetVolume() *v2.LogicalVolume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UnlockEncryptedVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//
+	//	*UnlockEncryptedVolumeRequest_Info
+	//	*UnlockEncryptedVolumeRequest_KeyData
+	Data isUnlockEncryptedVolumeRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *UnlockEncryptedVolumeRequest) Reset() {
+	*x = UnlockEncryptedVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockEncryptedVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockEncryptedVolumeRequest) ProtoMessage() {}
+
+func (x *UnlockEncryptedVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockEncryptedVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UnlockEncryptedVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *UnlockEncryptedVolumeRequest) GetData() isUnlockEncryptedVolumeRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UnlockEncryptedVolumeRequest) GetInfo() *UnlockEncryptedVolumeRequest_VolumeInfo {
+	if x, ok := x.GetData().(*UnlockEncryptedVolumeRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UnlockEncryptedVolumeRequest) GetKeyData() []byte {
+	if x, ok := x.GetData().(*UnlockEncryptedVolumeRequest_KeyData); ok {
+		return x.KeyData
+	}
+	return nil
+}
+
+type isUnlockEncryptedVolumeRequest_Data interface {
+	isUnlockEncryptedVolumeRequest_Data()
+}
+
+type UnlockEncryptedVolumeRequest_Info struct {
+	Info *UnlockEncryptedVolumeRequest_VolumeInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UnlockEncryptedVolumeRequest_KeyData struct {
+	KeyData []byte `protobuf:"bytes,2,opt,name=key_data,json=keyData,proto3,oneof"`
+}
+
+func (*UnlockEncryptedVolumeRequest_Info) isUnlockEncryptedVolumeRequest_Data() {}
+
+func (*UnlockEncryptedVolumeRequest_KeyData) isUnlockEncryptedVolumeRequest_Data() {}
+
+type LockEncryptedVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *LockEncryptedVolumeRequest) Reset() {
+	*x = LockEncryptedVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockEncryptedVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockEncryptedVolumeRequest) ProtoMessage() {}
+
+func (x *LockEncryptedVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockEncryptedVolumeRequest.ProtoReflect.Descriptor instead.
+func (*LockEncryptedVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LockEncryptedVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetEncryptedVolumeStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetEncryptedVolumeStatusRequest) Reset() {
+	*x = GetEncryptedVolumeStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEncryptedVolumeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncryptedVolumeStatusRequest) ProtoMessage() {}
+
+func (x *GetEncryptedVolumeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncryptedVolumeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEncryptedVolumeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetEncryptedVolumeStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EncryptedVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volume *v2.EncryptedVolume `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *EncryptedVolumeResponse) Reset() {
+	*x = EncryptedVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncryptedVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptedVolumeResponse) ProtoMessage() {}
+
+func (x *EncryptedVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptedVolumeResponse.ProtoReflect.Descriptor instead.
+func (*EncryptedVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EncryptedVolumeResponse) GetVolume() *v2.EncryptedVolume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UnlockEncryptedVolumeRequest_VolumeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device   string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ReadOnly bool   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *UnlockEncryptedVolumeRequest_VolumeInfo) Reset() {
+	*x = UnlockEncryptedVolumeRequest_VolumeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_storage_v2_storage_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockEncryptedVolumeRequest_VolumeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockEncryptedVolumeRequest_VolumeInfo) ProtoMessage() {}
+
+func (x *UnlockEncryptedVolumeRequest_VolumeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_storage_v2_storage_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockEncryptedVolumeRequest_VolumeInfo.ProtoReflect.Descriptor instead.
+func (*UnlockEncryptedVolumeRequest_VolumeInfo) Descriptor() ([]byte, []int) {
+	return file_services_storage_v2_storage_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *UnlockEncryptedVolumeRequest_VolumeInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *UnlockEncryptedVolumeRequest_VolumeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnlockEncryptedVolumeRequest_VolumeInfo) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+var File_services_storage_v2_storage_proto protoreflect.FileDescriptor
+
+var file_services_storage_v2_storage_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x32,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x50, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x56, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x07,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x69, 0x7a,
+	0x65, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x8a,
+	0x01, 0x0a, 0x1a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x76, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x76, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x46, 0x73, 0x22, 0x56, 0x0a, 0x1b, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x06, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x1c, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x44, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x32,
+	0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x1b, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x55, 0x0a,
+	0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x30, 0x0a, 0x1a,
+	0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x32, 0xcd, 0x07, 0x0a, 0x13,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x37, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x65, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x36, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65,
+	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x38,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x69, 0x7a, 0x65, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x8a, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67,
+	0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x37, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c,
+	0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x8c, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x39, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x32, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x68,
+	0x0a, 0x13, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x37, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x90, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x47, 0x5a, 0x45, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33,
+	0x2f, 0x70, 0x68, 0x6f, 0x65, 0x6e, 0x69, 0x78, 0x2d, 0x67, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_services_storage_v2_storage_proto_rawDescOnce sync.Once
+	file_services_storage_v2_storage_proto_rawDescData = file_services_storage_v2_storage_proto_rawDesc
+)
+
+func file_services_storage_v2_storage_proto_rawDescGZIP() []byte {
+	file_services_storage_v2_storage_proto_rawDescOnce.Do(func() {
+		file_services_storage_v2_storage_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_storage_v2_storage_proto_rawDescData)
+	})
+	return file_services_storage_v2_storage_proto_rawDescData
+}
+
+var file_services_storage_v2_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_services_storage_v2_storage_proto_goTypes = []interface{}{
+	(*GetPhysicalVolumesResponse)(nil),              // 0: pga.api.services.storage.v2.GetPhysicalVolumesResponse
+	(*GetVolumeGroupsResponse)(nil),                 // 1: pga.api.services.storage.v2.GetVolumeGroupsResponse
+	(*GetLogicalVolumesResponse)(nil),               // 2: pga.api.services.storage.v2.GetLogicalVolumesResponse
+	(*ResizePhysicalVolumeRequest)(nil),             // 3: pga.api.services.storage.v2.ResizePhysicalVolumeRequest
+	(*ExtendLogicalVolumeRequest)(nil),              // 4: pga.api.services.storage.v2.ExtendLogicalVolumeRequest
+	(*ExtendLogicalVolumeResponse)(nil),             // 5: pga.api.services.storage.v2.ExtendLogicalVolumeResponse
+	(*UnlockEncryptedVolumeRequest)(nil),            // 6: pga.api.services.storage.v2.UnlockEncryptedVolumeRequest
+	(*LockEncryptedVolumeRequest)(nil),              // 7: pga.api.services.storage.v2.LockEncryptedVolumeRequest
+	(*GetEncryptedVolumeStatusRequest)(nil),         // 8: pga.api.services.storage.v2.GetEncryptedVolumeStatusRequest
+	(*EncryptedVolumeResponse)(nil),                 // 9: pga.api.services.storage.v2.EncryptedVolumeResponse
+	(*UnlockEncryptedVolumeRequest_VolumeInfo)(nil), // 10: pga.api.services.storage.v2.UnlockEncryptedVolumeRequest.VolumeInfo
+	(*v2.PhysicalVolume)(nil),                       // 11: pga.api.types.v2.PhysicalVolume
+	(*v2.VolumeGroup)(nil),                          // 12: pga.api.types.v2.VolumeGroup
+	(*v2.LogicalVolume)(nil),                        // 13: pga.api.types.v2.LogicalVolume
+	(*v2.EncryptedVolume)(nil),                      // 14: pga.api.types.v2.EncryptedVolume
+	(*emptypb.Empty)(nil),                           // 15: google.protobuf.Empty
+}
+var file_services_storage_v2_storage_proto_depIdxs = []int32{
+	11, // 0: pga.api.services.storage.v2.GetPhysicalVolumesResponse.volumes:type_name -> pga.api.types.v2.PhysicalVolume
+	12, // 1: pga.api.services.storage.v2.GetVolumeGroupsResponse.groups:type_name -> pga.api.types.v2.VolumeGroup
+	13, // 2: pga.api.services.storage.v2.GetLogicalVolumesResponse.volumes:type_name -> pga.api.types.v2.LogicalVolume
+	13, // 3: pga.api.services.storage.v2.ExtendLogicalVolumeResponse.volume:type_name -> pga.api.types.v2.LogicalVolume
+	10, // 4: pga.api.services.storage.v2.UnlockEncryptedVolumeRequest.info:type_name -> pga.api.services.storage.v2.UnlockEncryptedVolumeRequest.VolumeInfo
+	14, // 5: pga.api.services.storage.v2.EncryptedVolumeResponse.volume:type_name -> pga.api.types.v2.EncryptedVolume
+	15, // 6: pga.api.services.storage.v2.AgentStorageService.GetPhysicalVolumes:input_type -> google.protobuf.Empty
+	15, // 7: pga.api.services.storage.v2.AgentStorageService.GetVolumeGroups:input_type -> google.protobuf.Empty
+	15, // 8: pga.api.services.storage.v2.AgentStorageService.GetLogicalVolumes:input_type -> google.protobuf.Empty
+	3,  // 9: pga.api.services.storage.v2.AgentStorageService.ResizePhysicalVolume:input_type -> pga.api.services.storage.v2.ResizePhysicalVolumeRequest
+	4,  // 10: pga.api.services.storage.v2.AgentStorageService.ExtendLogicalVolume:input_type -> pga.api.services.storage.v2.ExtendLogicalVolumeRequest
+	6,  // 11: pga.api.services.storage.v2.AgentStorageService.UnlockEncryptedVolume:input_type -> pga.api.services.storage.v2.UnlockEncryptedVolumeRequest
+	7,  // 12: pga.api.services.storage.v2.AgentStorageService.LockEncryptedVolume:input_type -> pga.api.services.storage.v2.LockEncryptedVolumeRequest
+	8,  // 13: pga.api.services.storage.v2.AgentStorageService.GetEncryptedVolumeStatus:input_type -> pga.api.services.storage.v2.GetEncryptedVolumeStatusRequest
+	0,  // 14: pga.api.services.storage.v2.AgentStorageService.GetPhysicalVolumes:output_type -> pga.api.services.storage.v2.GetPhysicalVolumesResponse
+	1,  // 15: pga.api.services.storage.v2.AgentStorageService.GetVolumeGroups:output_type -> pga.api.services.storage.v2.GetVolumeGroupsResponse
+	2,  // 16: pga.api.services.storage.v2.AgentStorageService.GetLogicalVolumes:output_type -> pga.api.services.storage.v2.GetLogicalVolumesResponse
+	15, // 17: pga.api.services.storage.v2.AgentStorageService.ResizePhysicalVolume:output_type -> google.protobuf.Empty
+	5,  // 18: pga.api.services.storage.v2.AgentStorageService.ExtendLogicalVolume:output_type -> pga.api.services.storage.v2.ExtendLogicalVolumeResponse
+	9,  // 19: pga.api.services.storage.v2.AgentStorageService.UnlockEncryptedVolume:output_type -> pga.api.services.storage.v2.EncryptedVolumeResponse
+	15, // 20: pga.api.services.storage.v2.AgentStorageService.LockEncryptedVolume:output_type -> google.protobuf.Empty
+	9,  // 21: pga.api.services.storage.v2.AgentStorageService.GetEncryptedVolumeStatus:output_type -> pga.api.services.storage.v2.EncryptedVolumeResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_services_storage_v2_storage_proto_init() }
+func file_services_storage_v2_storage_proto_init() {
+	if File_services_storage_v2_storage_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_services_storage_v2_storage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPhysicalVolumesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogicalVolumesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizePhysicalVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendLogicalVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendLogicalVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockEncryptedVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockEncryptedVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEncryptedVolumeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_storage_v2_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockEncryptedVolumeRequest_VolumeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_services_storage_v2_storage_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*UnlockEncryptedVolumeRequest_Info)(nil),
+		(*UnlockEncryptedVolumeRequest_KeyData)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_services_storage_v2_storage_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_services_storage_v2_storage_proto_goTypes,
+		DependencyIndexes: file_services_storage_v2_storage_proto_depIdxs,
+		MessageInfos:      file_services_storage_v2_storage_proto_msgTypes,
+	}.Build()
+	File_services_storage_v2_storage_proto = out.File
+	file_services_storage_v2_storage_proto_rawDesc = nil
+	file_services_storage_v2_storage_proto_goTypes = nil
+	file_services_storage_v2_storage_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AgentStorageServiceClient is the client API for AgentStorageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentStorageServiceClient interface {
+	GetPhysicalVolumes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPhysicalVolumesResponse, error)
+	GetVolumeGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetVolumeGroupsResponse, error)
+	GetLogicalVolumes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLogicalVolumesResponse, error)
+	ResizePhysicalVolume(ctx context.Context, in *ResizePhysicalVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExtendLogicalVolume(ctx context.Context, in *ExtendLogicalVolumeRequest, opts ...grpc.CallOption) (*ExtendLogicalVolumeResponse, error)
+	UnlockEncryptedVolume(ctx context.Context, opts ...grpc.CallOption) (AgentStorageService_UnlockEncryptedVolumeClient, error)
+	LockEncryptedVolume(ctx context.Context, in *LockEncryptedVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetEncryptedVolumeStatus(ctx context.Context, in *GetEncryptedVolumeStatusRequest, opts ...grpc.CallOption) (*EncryptedVolumeResponse, error)
+}
+
+type agentStorageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentStorageServiceClient(cc grpc.ClientConnInterface) AgentStorageServiceClient {
+	return &agentStorageServiceClient{cc}
+}
+
+func (c *agentStorageServiceClient) GetPhysicalVolumes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPhysicalVolumesResponse, error) {
+	out := new(GetPhysicalVolumesResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.storage.v2.AgentStorageService/GetPhysicalVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentStorageServiceClient) GetVolumeGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetVolumeGroupsResponse, error) {
+	out := new(GetVolumeGroupsResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.storage.v2.AgentStorageService/GetVolumeGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentStorageServiceClient) GetLogicalVolumes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLogicalVolumesResponse, error) {
+	out := new(GetLogicalVolumesResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.storage.v2.AgentStorageService/GetLogicalVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentStorageServiceClient) ResizePhysicalVolume(ctx context.Context, in *ResizePhysicalVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.storage.v2.AgentStorageService/ResizePhysicalVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentStorageServiceClient) ExtendLogicalVolume(ctx context.Context, in *ExtendLogicalVolumeRequest, opts ...grpc.CallOption) (*ExtendLogicalVolumeResponse, error) {
+	out := new(ExtendLogicalVolumeResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.storage.v2.AgentStorageService/ExtendLogicalVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentStorageServiceClient) UnlockEncryptedVolume(ctx context.Context, opts ...grpc.CallOption) (AgentStorageService_UnlockEncryptedVolumeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentStorageService_serviceDesc.Streams[0], "/pga.api.services.storage.v2.AgentStorageService/UnlockEncryptedVolume", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentStorageServiceUnlockEncryptedVolumeClient{stream}
+	return x, nil
+}
+
+type AgentStorageService_UnlockEncryptedVolumeClient interface {
+	Send(*UnlockEncryptedVolumeRequest) error
+	CloseAndRecv() (*EncryptedVolumeResponse, error)
+	grpc.ClientStream
+}
+
+type agentStorageServiceUnlockEncryptedVolumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentStorageServiceUnlockEncryptedVolumeClient) Send(m *UnlockEncryptedVolumeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentStorageServiceUnlockEncryptedVolumeClient) CloseAndRecv() (*EncryptedVolumeResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EncryptedVolumeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentStorageServiceClient) LockEncryptedVolume(ctx context.Context, in *LockEncryptedVolumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.storage.v2.AgentStorageService/LockEncryptedVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentStorageServiceClient) GetEncryptedVolumeStatus(ctx context.Context, in *GetEncryptedVolumeStatusRequest, opts ...grpc.CallOption) (*EncryptedVolumeResponse, error) {
+	out := new(EncryptedVolumeResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.storage.v2.AgentStorageService/GetEncryptedVolumeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentStorageServiceServer is the server API for AgentStorageService service.
+type AgentStorageServiceServer interface {
+	GetPhysicalVolumes(context.Context, *emptypb.Empty) (*GetPhysicalVolumesResponse, error)
+	GetVolumeGroups(context.Context, *emptypb.Empty) (*GetVolumeGroupsResponse, error)
+	GetLogicalVolumes(context.Context, *emptypb.Empty) (*GetLogicalVolumesResponse, error)
+	ResizePhysicalVolume(context.Context, *ResizePhysicalVolumeRequest) (*emptypb.Empty, error)
+	ExtendLogicalVolume(context.Context, *ExtendLogicalVolumeRequest) (*ExtendLogicalVolumeResponse, error)
+	UnlockEncryptedVolume(AgentStorageService_UnlockEncryptedVolumeServer) error
+	LockEncryptedVolume(context.Context, *LockEncryptedVolumeRequest) (*emptypb.Empty, error)
+	GetEncryptedVolumeStatus(context.Context, *GetEncryptedVolumeStatusRequest) (*EncryptedVolumeResponse, error)
+}
+
+// UnimplementedAgentStorageServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAgentStorageServiceServer struct {
+}
+
+func (*UnimplementedAgentStorageServiceServer) GetPhysicalVolumes(context.Context, *emptypb.Empty) (*GetPhysicalVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPhysicalVolumes not implemented")
+}
+func (*UnimplementedAgentStorageServiceServer) GetVolumeGroups(context.Context, *emptypb.Empty) (*GetVolumeGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeGroups not implemented")
+}
+func (*UnimplementedAgentStorageServiceServer) GetLogicalVolumes(context.Context, *emptypb.Empty) (*GetLogicalVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogicalVolumes not implemented")
+}
+func (*UnimplementedAgentStorageServiceServer) ResizePhysicalVolume(context.Context, *ResizePhysicalVolumeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizePhysicalVolume not implemented")
+}
+func (*UnimplementedAgentStorageServiceServer) ExtendLogicalVolume(context.Context, *ExtendLogicalVolumeRequest) (*ExtendLogicalVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLogicalVolume not implemented")
+}
+func (*UnimplementedAgentStorageServiceServer) UnlockEncryptedVolume(AgentStorageService_UnlockEncryptedVolumeServer) error {
+	return status.Errorf(codes.Unimplemented, "method UnlockEncryptedVolume not implemented")
+}
+func (*UnimplementedAgentStorageServiceServer) LockEncryptedVolume(context.Context, *LockEncryptedVolumeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockEncryptedVolume not implemented")
+}
+func (*UnimplementedAgentStorageServiceServer) GetEncryptedVolumeStatus(context.Context, *GetEncryptedVolumeStatusRequest) (*EncryptedVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncryptedVolumeStatus not implemented")
+}
+
+func RegisterAgentStorageServiceServer(s *grpc.Server, srv AgentStorageServiceServer) {
+	s.RegisterService(&_AgentStorageService_serviceDesc, srv)
+}
+
+func _AgentStorageService_GetPhysicalVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentStorageServiceServer).GetPhysicalVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.storage.v2.AgentStorageService/GetPhysicalVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentStorageServiceServer).GetPhysicalVolumes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentStorageService_GetVolumeGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentStorageServiceServer).GetVolumeGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.storage.v2.AgentStorageService/GetVolumeGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentStorageServiceServer).GetVolumeGroups(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentStorageService_GetLogicalVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentStorageServiceServer).GetLogicalVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.storage.v2.AgentStorageService/GetLogicalVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentStorageServiceServer).GetLogicalVolumes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentStorageService_ResizePhysicalVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizePhysicalVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentStorageServiceServer).ResizePhysicalVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.storage.v2.AgentStorageService/ResizePhysicalVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentStorageServiceServer).ResizePhysicalVolume(ctx, req.(*ResizePhysicalVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentStorageService_ExtendLogicalVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLogicalVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentStorageServiceServer).ExtendLogicalVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.storage.v2.AgentStorageService/ExtendLogicalVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentStorageServiceServer).ExtendLogicalVolume(ctx, req.(*ExtendLogicalVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentStorageService_UnlockEncryptedVolume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentStorageServiceServer).UnlockEncryptedVolume(&agentStorageServiceUnlockEncryptedVolumeServer{stream})
+}
+
+type AgentStorageService_UnlockEncryptedVolumeServer interface {
+	SendAndClose(*EncryptedVolumeResponse) error
+	Recv() (*UnlockEncryptedVolumeRequest, error)
+	grpc.ServerStream
+}
+
+type agentStorageServiceUnlockEncryptedVolumeServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentStorageServiceUnlockEncryptedVolumeServer) SendAndClose(m *EncryptedVolumeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentStorageServiceUnlockEncryptedVolumeServer) Recv() (*UnlockEncryptedVolumeRequest, error) {
+	m := new(UnlockEncryptedVolumeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AgentStorageService_LockEncryptedVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockEncryptedVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentStorageServiceServer).LockEncryptedVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.storage.v2.AgentStorageService/LockEncryptedVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentStorageServiceServer).LockEncryptedVolume(ctx, req.(*LockEncryptedVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentStorageService_GetEncryptedVolumeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEncryptedVolumeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentStorageServiceServer).GetEncryptedVolumeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.storage.v2.AgentStorageService/GetEncryptedVolumeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentStorageServiceServer).GetEncryptedVolumeStatus(ctx, req.(*GetEncryptedVolumeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AgentStorageService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pga.api.services.storage.v2.AgentStorageService",
+	HandlerType: (*AgentStorageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPhysicalVolumes",
+			Handler:    _AgentStorageService_GetPhysicalVolumes_Handler,
+		},
+		{
+			MethodName: "GetVolumeGroups",
+			Handler:    _AgentStorageService_GetVolumeGroups_Handler,
+		},
+		{
+			MethodName: "GetLogicalVolumes",
+			Handler:    _AgentStorageService_GetLogicalVolumes_Handler,
+		},
+		{
+			MethodName: "ResizePhysicalVolume",
+			Handler:    _AgentStorageService_ResizePhysicalVolume_Handler,
+		},
+		{
+			MethodName: "ExtendLogicalVolume",
+			Handler:    _AgentStorageService_ExtendLogicalVolume_Handler,
+		},
+		{
+			MethodName: "LockEncryptedVolume",
+			Handler:    _AgentStorageService_LockEncryptedVolume_Handler,
+		},
+		{
+			MethodName: "GetEncryptedVolumeStatus",
+			Handler:    _AgentStorageService_GetEncryptedVolumeStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UnlockEncryptedVolume",
+			Handler:       _AgentStorageService_UnlockEncryptedVolume_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "services/storage/v2/storage.proto",
+}
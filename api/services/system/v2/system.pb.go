@@ -73,6 +73,210 @@ func (x *GetInfoResponse) GetInfo() *v2.AgentInfo {
 	return nil
 }
 
+type SysctlParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SysctlParam) Reset() {
+	*x = SysctlParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysctlParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysctlParam) ProtoMessage() {}
+
+func (x *SysctlParam) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysctlParam.ProtoReflect.Descriptor instead.
+func (*SysctlParam) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SysctlParam) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SysctlParam) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetSysctlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+}
+
+func (x *GetSysctlRequest) Reset() {
+	*x = GetSysctlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSysctlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSysctlRequest) ProtoMessage() {}
+
+func (x *GetSysctlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSysctlRequest.ProtoReflect.Descriptor instead.
+func (*GetSysctlRequest) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSysctlRequest) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+type GetSysctlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params []*SysctlParam `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *GetSysctlResponse) Reset() {
+	*x = GetSysctlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSysctlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSysctlResponse) ProtoMessage() {}
+
+func (x *GetSysctlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSysctlResponse.ProtoReflect.Descriptor instead.
+func (*GetSysctlResponse) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSysctlResponse) GetParams() []*SysctlParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type SetSysctlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params  []*SysctlParam `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+	Persist bool           `protobuf:"varint,2,opt,name=persist,proto3" json:"persist,omitempty"`
+}
+
+func (x *SetSysctlRequest) Reset() {
+	*x = SetSysctlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSysctlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSysctlRequest) ProtoMessage() {}
+
+func (x *SetSysctlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSysctlRequest.ProtoReflect.Descriptor instead.
+func (*SetSysctlRequest) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetSysctlRequest) GetParams() []*SysctlParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SetSysctlRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
 var File_services_system_v2_system_proto protoreflect.FileDescriptor
 
 var file_services_system_v2_system_proto_rawDesc = []byte{
@@ -87,22 +291,53 @@ var file_services_system_v2_system_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x32, 0xa9, 0x01, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2b,
-	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a,
-	0x0d, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30,
-	0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x70, 0x68, 0x6f, 0x65, 0x6e, 0x69, 0x78, 0x2d, 0x67, 0x75,
-	0x65, 0x73, 0x74, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x32,
-	0x3b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x35, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x79,
+	0x73, 0x63, 0x74, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x6d, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x32, 0xea, 0x02, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x12, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53,
+	0x79, 0x73, 0x63, 0x74, 0x6c, 0x12, 0x2c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x45, 0x5a,
+	0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66,
+	0x35, 0x33, 0x2f, 0x70, 0x68, 0x6f, 0x65, 0x6e, 0x69, 0x78, 0x2d, 0x67, 0x75, 0x65, 0x73, 0x74,
+	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x32, 0x3b, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -117,23 +352,33 @@ func file_services_system_v2_system_proto_rawDescGZIP() []byte {
 	return file_services_system_v2_system_proto_rawDescData
 }
 
-var file_services_system_v2_system_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_services_system_v2_system_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_services_system_v2_system_proto_goTypes = []interface{}{
-	(*GetInfoResponse)(nil), // 0: pga.api.services.system.v2.GetInfoResponse
-	(*v2.AgentInfo)(nil),    // 1: pga.api.types.v2.AgentInfo
-	(*emptypb.Empty)(nil),   // 2: google.protobuf.Empty
+	(*GetInfoResponse)(nil),   // 0: pga.api.services.system.v2.GetInfoResponse
+	(*SysctlParam)(nil),       // 1: pga.api.services.system.v2.SysctlParam
+	(*GetSysctlRequest)(nil),  // 2: pga.api.services.system.v2.GetSysctlRequest
+	(*GetSysctlResponse)(nil), // 3: pga.api.services.system.v2.GetSysctlResponse
+	(*SetSysctlRequest)(nil),  // 4: pga.api.services.system.v2.SetSysctlRequest
+	(*v2.AgentInfo)(nil),      // 5: pga.api.types.v2.AgentInfo
+	(*emptypb.Empty)(nil),     // 6: google.protobuf.Empty
 }
 var file_services_system_v2_system_proto_depIdxs = []int32{
-	1, // 0: pga.api.services.system.v2.GetInfoResponse.info:type_name -> pga.api.types.v2.AgentInfo
-	2, // 1: pga.api.services.system.v2.AgentSystemService.GetInfo:input_type -> google.protobuf.Empty
-	2, // 2: pga.api.services.system.v2.AgentSystemService.ShutdownAgent:input_type -> google.protobuf.Empty
-	0, // 3: pga.api.services.system.v2.AgentSystemService.GetInfo:output_type -> pga.api.services.system.v2.GetInfoResponse
-	2, // 4: pga.api.services.system.v2.AgentSystemService.ShutdownAgent:output_type -> google.protobuf.Empty
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: pga.api.services.system.v2.GetInfoResponse.info:type_name -> pga.api.types.v2.AgentInfo
+	1, // 1: pga.api.services.system.v2.GetSysctlResponse.params:type_name -> pga.api.services.system.v2.SysctlParam
+	1, // 2: pga.api.services.system.v2.SetSysctlRequest.params:type_name -> pga.api.services.system.v2.SysctlParam
+	6, // 3: pga.api.services.system.v2.AgentSystemService.GetInfo:input_type -> google.protobuf.Empty
+	6, // 4: pga.api.services.system.v2.AgentSystemService.ShutdownAgent:input_type -> google.protobuf.Empty
+	2, // 5: pga.api.services.system.v2.AgentSystemService.GetSysctl:input_type -> pga.api.services.system.v2.GetSysctlRequest
+	4, // 6: pga.api.services.system.v2.AgentSystemService.SetSysctl:input_type -> pga.api.services.system.v2.SetSysctlRequest
+	0, // 7: pga.api.services.system.v2.AgentSystemService.GetInfo:output_type -> pga.api.services.system.v2.GetInfoResponse
+	6, // 8: pga.api.services.system.v2.AgentSystemService.ShutdownAgent:output_type -> google.protobuf.Empty
+	3, // 9: pga.api.services.system.v2.AgentSystemService.GetSysctl:output_type -> pga.api.services.system.v2.GetSysctlResponse
+	6, // 10: pga.api.services.system.v2.AgentSystemService.SetSysctl:output_type -> google.protobuf.Empty
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_services_system_v2_system_proto_init() }
@@ -154,6 +399,54 @@ func file_services_system_v2_system_proto_init() {
 				return nil
 			}
 		}
+		file_services_system_v2_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysctlParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_system_v2_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSysctlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_system_v2_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSysctlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_system_v2_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSysctlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -161,7 +454,7 @@ func file_services_system_v2_system_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_system_v2_system_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -189,6 +482,8 @@ const _ = grpc.SupportPackageIsVersion6
 type AgentSystemServiceClient interface {
 	GetInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetInfoResponse, error)
 	ShutdownAgent(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSysctl(ctx context.Context, in *GetSysctlRequest, opts ...grpc.CallOption) (*GetSysctlResponse, error)
+	SetSysctl(ctx context.Context, in *SetSysctlRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type agentSystemServiceClient struct {
@@ -217,10 +512,30 @@ func (c *agentSystemServiceClient) ShutdownAgent(ctx context.Context, in *emptyp
 	return out, nil
 }
 
+func (c *agentSystemServiceClient) GetSysctl(ctx context.Context, in *GetSysctlRequest, opts ...grpc.CallOption) (*GetSysctlResponse, error) {
+	out := new(GetSysctlResponse)
+	err := c.cc.Invoke(ctx, "/pga.api.services.system.v2.AgentSystemService/GetSysctl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentSystemServiceClient) SetSysctl(ctx context.Context, in *SetSysctlRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pga.api.services.system.v2.AgentSystemService/SetSysctl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentSystemServiceServer is the server API for AgentSystemService service.
 type AgentSystemServiceServer interface {
 	GetInfo(context.Context, *emptypb.Empty) (*GetInfoResponse, error)
 	ShutdownAgent(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	GetSysctl(context.Context, *GetSysctlRequest) (*GetSysctlResponse, error)
+	SetSysctl(context.Context, *SetSysctlRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedAgentSystemServiceServer can be embedded to have forward compatible implementations.
@@ -233,6 +548,12 @@ func (*UnimplementedAgentSystemServiceServer) GetInfo(context.Context, *emptypb.
 func (*UnimplementedAgentSystemServiceServer) ShutdownAgent(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShutdownAgent not implemented")
 }
+func (*UnimplementedAgentSystemServiceServer) GetSysctl(context.Context, *GetSysctlRequest) (*GetSysctlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSysctl not implemented")
+}
+func (*UnimplementedAgentSystemServiceServer) SetSysctl(context.Context, *SetSysctlRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSysctl not implemented")
+}
 
 func RegisterAgentSystemServiceServer(s *grpc.Server, srv AgentSystemServiceServer) {
 	s.RegisterService(&_AgentSystemService_serviceDesc, srv)
@@ -274,6 +595,42 @@ func _AgentSystemService_ShutdownAgent_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentSystemService_GetSysctl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSysctlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentSystemServiceServer).GetSysctl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.system.v2.AgentSystemService/GetSysctl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentSystemServiceServer).GetSysctl(ctx, req.(*GetSysctlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentSystemService_SetSysctl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSysctlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentSystemServiceServer).SetSysctl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pga.api.services.system.v2.AgentSystemService/SetSysctl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentSystemServiceServer).SetSysctl(ctx, req.(*SetSysctlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AgentSystemService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pga.api.services.system.v2.AgentSystemService",
 	HandlerType: (*AgentSystemServiceServer)(nil),
@@ -286,6 +643,14 @@ var _AgentSystemService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShutdownAgent",
 			Handler:    _AgentSystemService_ShutdownAgent_Handler,
 		},
+		{
+			MethodName: "GetSysctl",
+			Handler:    _AgentSystemService_GetSysctl_Handler,
+		},
+		{
+			MethodName: "SetSysctl",
+			Handler:    _AgentSystemService_SetSysctl_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/system/v2/system.proto",
@@ -124,6 +124,79 @@ func (RouteScope) EnumDescriptor() ([]byte, []int) {
 	return file_types_v2_agent_proto_rawDescGZIP(), []int{1}
 }
 
+type RouteType int32
+
+const (
+	RouteType_RTN_UNSPEC      RouteType = 0
+	RouteType_RTN_UNICAST     RouteType = 1
+	RouteType_RTN_LOCAL       RouteType = 2
+	RouteType_RTN_BROADCAST   RouteType = 3
+	RouteType_RTN_ANYCAST     RouteType = 4
+	RouteType_RTN_MULTICAST   RouteType = 5
+	RouteType_RTN_BLACKHOLE   RouteType = 6
+	RouteType_RTN_UNREACHABLE RouteType = 7
+	RouteType_RTN_PROHIBIT    RouteType = 8
+	RouteType_RTN_THROW       RouteType = 9
+	RouteType_RTN_NAT         RouteType = 10
+)
+
+// Enum value maps for RouteType.
+var (
+	RouteType_name = map[int32]string{
+		0:  "RTN_UNSPEC",
+		1:  "RTN_UNICAST",
+		2:  "RTN_LOCAL",
+		3:  "RTN_BROADCAST",
+		4:  "RTN_ANYCAST",
+		5:  "RTN_MULTICAST",
+		6:  "RTN_BLACKHOLE",
+		7:  "RTN_UNREACHABLE",
+		8:  "RTN_PROHIBIT",
+		9:  "RTN_THROW",
+		10: "RTN_NAT",
+	}
+	RouteType_value = map[string]int32{
+		"RTN_UNSPEC":      0,
+		"RTN_UNICAST":     1,
+		"RTN_LOCAL":       2,
+		"RTN_BROADCAST":   3,
+		"RTN_ANYCAST":     4,
+		"RTN_MULTICAST":   5,
+		"RTN_BLACKHOLE":   6,
+		"RTN_UNREACHABLE": 7,
+		"RTN_PROHIBIT":    8,
+		"RTN_THROW":       9,
+		"RTN_NAT":         10,
+	}
+)
+
+func (x RouteType) Enum() *RouteType {
+	p := new(RouteType)
+	*p = x
+	return p
+}
+
+func (x RouteType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RouteType) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v2_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (RouteType) Type() protoreflect.EnumType {
+	return &file_types_v2_agent_proto_enumTypes[2]
+}
+
+func (x RouteType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RouteType.Descriptor instead.
+func (RouteType) EnumDescriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{2}
+}
+
 type AgentInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -192,13 +265,18 @@ type GuestInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uname        *GuestInfo_Utsname       `protobuf:"bytes,1,opt,name=uname,proto3" json:"uname,omitempty"`
-	Uptime       int64                    `protobuf:"varint,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	Loadavg      *GuestInfo_LoadAverage   `protobuf:"bytes,3,opt,name=loadavg,proto3" json:"loadavg,omitempty"`
-	Mem          *GuestInfo_MemStat       `protobuf:"bytes,4,opt,name=mem,proto3" json:"mem,omitempty"`
-	Swap         *GuestInfo_SwapStat      `protobuf:"bytes,5,opt,name=swap,proto3" json:"swap,omitempty"`
-	Users        []*GuestInfo_LoggedUser  `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
-	BlockDevices []*GuestInfo_BlockDevice `protobuf:"bytes,7,rep,name=block_devices,json=blockDevices,proto3" json:"block_devices,omitempty"`
+	Uname        *GuestInfo_Utsname        `protobuf:"bytes,1,opt,name=uname,proto3" json:"uname,omitempty"`
+	Uptime       int64                     `protobuf:"varint,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Loadavg      *GuestInfo_LoadAverage    `protobuf:"bytes,3,opt,name=loadavg,proto3" json:"loadavg,omitempty"`
+	Mem          *GuestInfo_MemStat        `protobuf:"bytes,4,opt,name=mem,proto3" json:"mem,omitempty"`
+	Swap         *GuestInfo_SwapStat       `protobuf:"bytes,5,opt,name=swap,proto3" json:"swap,omitempty"`
+	Users        []*GuestInfo_LoggedUser   `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
+	BlockDevices []*GuestInfo_BlockDevice  `protobuf:"bytes,7,rep,name=block_devices,json=blockDevices,proto3" json:"block_devices,omitempty"`
+	Cpu          *GuestInfo_CPUStat        `protobuf:"bytes,8,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Cpus         []*GuestInfo_CPUStat      `protobuf:"bytes,9,rep,name=cpus,proto3" json:"cpus,omitempty"`
+	Disks        []*GuestInfo_DiskStat     `protobuf:"bytes,10,rep,name=disks,proto3" json:"disks,omitempty"`
+	Netdevs      []*GuestInfo_NetDevStat   `protobuf:"bytes,11,rep,name=netdevs,proto3" json:"netdevs,omitempty"`
+	Pressure     []*GuestInfo_PressureStat `protobuf:"bytes,12,rep,name=pressure,proto3" json:"pressure,omitempty"`
 }
 
 func (x *GuestInfo) Reset() {
@@ -282,22 +360,55 @@ func (x *GuestInfo) GetBlockDevices() []*GuestInfo_BlockDevice {
 	return nil
 }
 
-type RouteInfo struct {
+func (x *GuestInfo) GetCpu() *GuestInfo_CPUStat {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *GuestInfo) GetCpus() []*GuestInfo_CPUStat {
+	if x != nil {
+		return x.Cpus
+	}
+	return nil
+}
+
+func (x *GuestInfo) GetDisks() []*GuestInfo_DiskStat {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *GuestInfo) GetNetdevs() []*GuestInfo_NetDevStat {
+	if x != nil {
+		return x.Netdevs
+	}
+	return nil
+}
+
+func (x *GuestInfo) GetPressure() []*GuestInfo_PressureStat {
+	if x != nil {
+		return x.Pressure
+	}
+	return nil
+}
+
+type RouteNextHop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LinkIndex int32      `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
-	LinkName  string     `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
-	Scope     RouteScope `protobuf:"varint,3,opt,name=scope,proto3,enum=pga.api.types.v2.RouteScope" json:"scope,omitempty"`
-	Dst       string     `protobuf:"bytes,4,opt,name=dst,proto3" json:"dst,omitempty"`
-	Src       string     `protobuf:"bytes,5,opt,name=src,proto3" json:"src,omitempty"`
-	Gw        string     `protobuf:"bytes,6,opt,name=gw,proto3" json:"gw,omitempty"`
-	Table     int32      `protobuf:"varint,7,opt,name=table,proto3" json:"table,omitempty"`
+	LinkIndex int32  `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	LinkName  string `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Gw        string `protobuf:"bytes,3,opt,name=gw,proto3" json:"gw,omitempty"`
+	Weight    int32  `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Onlink    bool   `protobuf:"varint,5,opt,name=onlink,proto3" json:"onlink,omitempty"`
 }
 
-func (x *RouteInfo) Reset() {
-	*x = RouteInfo{}
+func (x *RouteNextHop) Reset() {
+	*x = RouteNextHop{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -305,13 +416,13 @@ func (x *RouteInfo) Reset() {
 	}
 }
 
-func (x *RouteInfo) String() string {
+func (x *RouteNextHop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteInfo) ProtoMessage() {}
+func (*RouteNextHop) ProtoMessage() {}
 
-func (x *RouteInfo) ProtoReflect() protoreflect.Message {
+func (x *RouteNextHop) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -323,75 +434,68 @@ func (x *RouteInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteInfo.ProtoReflect.Descriptor instead.
-func (*RouteInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteNextHop.ProtoReflect.Descriptor instead.
+func (*RouteNextHop) Descriptor() ([]byte, []int) {
 	return file_types_v2_agent_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RouteInfo) GetLinkIndex() int32 {
+func (x *RouteNextHop) GetLinkIndex() int32 {
 	if x != nil {
 		return x.LinkIndex
 	}
 	return 0
 }
 
-func (x *RouteInfo) GetLinkName() string {
+func (x *RouteNextHop) GetLinkName() string {
 	if x != nil {
 		return x.LinkName
 	}
 	return ""
 }
 
-func (x *RouteInfo) GetScope() RouteScope {
-	if x != nil {
-		return x.Scope
-	}
-	return RouteScope_SCOPE_UNIVERSE
-}
-
-func (x *RouteInfo) GetDst() string {
-	if x != nil {
-		return x.Dst
-	}
-	return ""
-}
-
-func (x *RouteInfo) GetSrc() string {
+func (x *RouteNextHop) GetGw() string {
 	if x != nil {
-		return x.Src
+		return x.Gw
 	}
 	return ""
 }
 
-func (x *RouteInfo) GetGw() string {
+func (x *RouteNextHop) GetWeight() int32 {
 	if x != nil {
-		return x.Gw
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-func (x *RouteInfo) GetTable() int32 {
+func (x *RouteNextHop) GetOnlink() bool {
 	if x != nil {
-		return x.Table
+		return x.Onlink
 	}
-	return 0
+	return false
 }
 
-type InterfaceInfo struct {
+type RouteInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index  int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Name   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	HwAddr string   `protobuf:"bytes,3,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
-	Flags  uint32   `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
-	MTU    int32    `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	Addrs  []string `protobuf:"bytes,6,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	LinkIndex int32           `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	LinkName  string          `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Scope     RouteScope      `protobuf:"varint,3,opt,name=scope,proto3,enum=pga.api.types.v2.RouteScope" json:"scope,omitempty"`
+	Dst       string          `protobuf:"bytes,4,opt,name=dst,proto3" json:"dst,omitempty"`
+	Src       string          `protobuf:"bytes,5,opt,name=src,proto3" json:"src,omitempty"`
+	Gw        string          `protobuf:"bytes,6,opt,name=gw,proto3" json:"gw,omitempty"`
+	Table     int32           `protobuf:"varint,7,opt,name=table,proto3" json:"table,omitempty"`
+	Priority  int32           `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Protocol  uint32          `protobuf:"varint,9,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Type      RouteType       `protobuf:"varint,10,opt,name=type,proto3,enum=pga.api.types.v2.RouteType" json:"type,omitempty"`
+	MTU       int32           `protobuf:"varint,11,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Onlink    bool            `protobuf:"varint,12,opt,name=onlink,proto3" json:"onlink,omitempty"`
+	Multipath []*RouteNextHop `protobuf:"bytes,13,rep,name=multipath,proto3" json:"multipath,omitempty"`
 }
 
-func (x *InterfaceInfo) Reset() {
-	*x = InterfaceInfo{}
+func (x *RouteInfo) Reset() {
+	*x = RouteInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,13 +503,13 @@ func (x *InterfaceInfo) Reset() {
 	}
 }
 
-func (x *InterfaceInfo) String() string {
+func (x *RouteInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceInfo) ProtoMessage() {}
+func (*RouteInfo) ProtoMessage() {}
 
-func (x *InterfaceInfo) ProtoReflect() protoreflect.Message {
+func (x *RouteInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,68 +521,121 @@ func (x *InterfaceInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceInfo.ProtoReflect.Descriptor instead.
-func (*InterfaceInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteInfo.ProtoReflect.Descriptor instead.
+func (*RouteInfo) Descriptor() ([]byte, []int) {
 	return file_types_v2_agent_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *InterfaceInfo) GetIndex() int32 {
+func (x *RouteInfo) GetLinkIndex() int32 {
 	if x != nil {
-		return x.Index
+		return x.LinkIndex
 	}
 	return 0
 }
 
-func (x *InterfaceInfo) GetName() string {
+func (x *RouteInfo) GetLinkName() string {
 	if x != nil {
-		return x.Name
+		return x.LinkName
 	}
 	return ""
 }
 
-func (x *InterfaceInfo) GetHwAddr() string {
+func (x *RouteInfo) GetScope() RouteScope {
 	if x != nil {
-		return x.HwAddr
+		return x.Scope
+	}
+	return RouteScope_SCOPE_UNIVERSE
+}
+
+func (x *RouteInfo) GetDst() string {
+	if x != nil {
+		return x.Dst
 	}
 	return ""
 }
 
-func (x *InterfaceInfo) GetFlags() uint32 {
+func (x *RouteInfo) GetSrc() string {
 	if x != nil {
-		return x.Flags
+		return x.Src
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetGw() string {
+	if x != nil {
+		return x.Gw
+	}
+	return ""
+}
+
+func (x *RouteInfo) GetTable() int32 {
+	if x != nil {
+		return x.Table
 	}
 	return 0
 }
 
-func (x *InterfaceInfo) GetMTU() int32 {
+func (x *RouteInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *RouteInfo) GetType() RouteType {
+	if x != nil {
+		return x.Type
+	}
+	return RouteType_RTN_UNSPEC
+}
+
+func (x *RouteInfo) GetMTU() int32 {
 	if x != nil {
 		return x.MTU
 	}
 	return 0
 }
 
-func (x *InterfaceInfo) GetAddrs() []string {
+func (x *RouteInfo) GetOnlink() bool {
 	if x != nil {
-		return x.Addrs
+		return x.Onlink
+	}
+	return false
+}
+
+func (x *RouteInfo) GetMultipath() []*RouteNextHop {
+	if x != nil {
+		return x.Multipath
 	}
 	return nil
 }
 
-type FileStat struct {
+type RuleInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Mode      uint32          `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
-	IsDir     bool            `protobuf:"varint,3,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
-	Owner     *FileStat_Owner `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Group     *FileStat_Group `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
-	SizeBytes int64           `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Priority int32      `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Family   InetFamily `protobuf:"varint,2,opt,name=family,proto3,enum=pga.api.types.v2.InetFamily" json:"family,omitempty"`
+	Src      string     `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Dst      string     `protobuf:"bytes,4,opt,name=dst,proto3" json:"dst,omitempty"`
+	Iif      string     `protobuf:"bytes,5,opt,name=iif,proto3" json:"iif,omitempty"`
+	Oif      string     `protobuf:"bytes,6,opt,name=oif,proto3" json:"oif,omitempty"`
+	Fwmark   uint32     `protobuf:"varint,7,opt,name=fwmark,proto3" json:"fwmark,omitempty"`
+	Fwmask   uint32     `protobuf:"varint,8,opt,name=fwmask,proto3" json:"fwmask,omitempty"`
+	Table    int32      `protobuf:"varint,9,opt,name=table,proto3" json:"table,omitempty"`
+	Invert   bool       `protobuf:"varint,10,opt,name=invert,proto3" json:"invert,omitempty"`
 }
 
-func (x *FileStat) Reset() {
-	*x = FileStat{}
+func (x *RuleInfo) Reset() {
+	*x = RuleInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,13 +643,13 @@ func (x *FileStat) Reset() {
 	}
 }
 
-func (x *FileStat) String() string {
+func (x *RuleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileStat) ProtoMessage() {}
+func (*RuleInfo) ProtoMessage() {}
 
-func (x *FileStat) ProtoReflect() protoreflect.Message {
+func (x *RuleInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,66 +661,97 @@ func (x *FileStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileStat.ProtoReflect.Descriptor instead.
-func (*FileStat) Descriptor() ([]byte, []int) {
+// Deprecated: Use RuleInfo.ProtoReflect.Descriptor instead.
+func (*RuleInfo) Descriptor() ([]byte, []int) {
 	return file_types_v2_agent_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *FileStat) GetName() string {
+func (x *RuleInfo) GetPriority() int32 {
 	if x != nil {
-		return x.Name
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RuleInfo) GetFamily() InetFamily {
+	if x != nil {
+		return x.Family
+	}
+	return InetFamily_AF_UNSPECIFIED
+}
+
+func (x *RuleInfo) GetSrc() string {
+	if x != nil {
+		return x.Src
 	}
 	return ""
 }
 
-func (x *FileStat) GetMode() uint32 {
+func (x *RuleInfo) GetDst() string {
 	if x != nil {
-		return x.Mode
+		return x.Dst
 	}
-	return 0
+	return ""
 }
 
-func (x *FileStat) GetIsDir() bool {
+func (x *RuleInfo) GetIif() string {
 	if x != nil {
-		return x.IsDir
+		return x.Iif
 	}
-	return false
+	return ""
 }
 
-func (x *FileStat) GetOwner() *FileStat_Owner {
+func (x *RuleInfo) GetOif() string {
 	if x != nil {
-		return x.Owner
+		return x.Oif
 	}
-	return nil
+	return ""
 }
 
-func (x *FileStat) GetGroup() *FileStat_Group {
+func (x *RuleInfo) GetFwmark() uint32 {
 	if x != nil {
-		return x.Group
+		return x.Fwmark
 	}
-	return nil
+	return 0
 }
 
-func (x *FileStat) GetSizeBytes() int64 {
+func (x *RuleInfo) GetFwmask() uint32 {
 	if x != nil {
-		return x.SizeBytes
+		return x.Fwmask
 	}
 	return 0
 }
 
-type AgentInfo_Features struct {
+func (x *RuleInfo) GetTable() int32 {
+	if x != nil {
+		return x.Table
+	}
+	return 0
+}
+
+func (x *RuleInfo) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
+type NeighborInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LegacyMode bool   `protobuf:"varint,1,opt,name=legacy_mode,json=legacyMode,proto3" json:"legacy_mode,omitempty"`
-	SerialPort string `protobuf:"bytes,2,opt,name=serial_port,json=serialPort,proto3" json:"serial_port,omitempty"`
-	WithoutSSH bool   `protobuf:"varint,3,opt,name=without_ssh,json=withoutSsh,proto3" json:"without_ssh,omitempty"`
-	WithoutTCP bool   `protobuf:"varint,4,opt,name=without_tcp,json=withoutTcp,proto3" json:"without_tcp,omitempty"`
+	LinkIndex int32      `protobuf:"varint,1,opt,name=link_index,json=linkIndex,proto3" json:"link_index,omitempty"`
+	LinkName  string     `protobuf:"bytes,2,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	Family    InetFamily `protobuf:"varint,3,opt,name=family,proto3,enum=pga.api.types.v2.InetFamily" json:"family,omitempty"`
+	Ip        string     `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	HwAddr    string     `protobuf:"bytes,5,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
+	State     string     `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Router    bool       `protobuf:"varint,7,opt,name=router,proto3" json:"router,omitempty"`
 }
 
-func (x *AgentInfo_Features) Reset() {
-	*x = AgentInfo_Features{}
+func (x *NeighborInfo) Reset() {
+	*x = NeighborInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -571,13 +759,13 @@ func (x *AgentInfo_Features) Reset() {
 	}
 }
 
-func (x *AgentInfo_Features) String() string {
+func (x *NeighborInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentInfo_Features) ProtoMessage() {}
+func (*NeighborInfo) ProtoMessage() {}
 
-func (x *AgentInfo_Features) ProtoReflect() protoreflect.Message {
+func (x *NeighborInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,54 +777,84 @@ func (x *AgentInfo_Features) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentInfo_Features.ProtoReflect.Descriptor instead.
-func (*AgentInfo_Features) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use NeighborInfo.ProtoReflect.Descriptor instead.
+func (*NeighborInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AgentInfo_Features) GetLegacyMode() bool {
+func (x *NeighborInfo) GetLinkIndex() int32 {
 	if x != nil {
-		return x.LegacyMode
+		return x.LinkIndex
 	}
-	return false
+	return 0
 }
 
-func (x *AgentInfo_Features) GetSerialPort() string {
+func (x *NeighborInfo) GetLinkName() string {
 	if x != nil {
-		return x.SerialPort
+		return x.LinkName
 	}
 	return ""
 }
 
-func (x *AgentInfo_Features) GetWithoutSSH() bool {
+func (x *NeighborInfo) GetFamily() InetFamily {
 	if x != nil {
-		return x.WithoutSSH
+		return x.Family
 	}
-	return false
+	return InetFamily_AF_UNSPECIFIED
 }
 
-func (x *AgentInfo_Features) GetWithoutTCP() bool {
+func (x *NeighborInfo) GetIp() string {
 	if x != nil {
-		return x.WithoutTCP
+		return x.Ip
 	}
-	return false
+	return ""
 }
 
-type GuestInfo_Utsname struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *NeighborInfo) GetHwAddr() string {
+	if x != nil {
+		return x.HwAddr
+	}
+	return ""
+}
 
-	Sysname    string `protobuf:"bytes,1,opt,name=sysname,proto3" json:"sysname,omitempty"`
-	Nodename   string `protobuf:"bytes,2,opt,name=nodename,proto3" json:"nodename,omitempty"`
-	Release    string `protobuf:"bytes,3,opt,name=release,proto3" json:"release,omitempty"`
-	Version    string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Machine    string `protobuf:"bytes,5,opt,name=machine,proto3" json:"machine,omitempty"`
-	Domainname string `protobuf:"bytes,6,opt,name=domainname,proto3" json:"domainname,omitempty"`
+func (x *NeighborInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (x *GuestInfo_Utsname) Reset() {
-	*x = GuestInfo_Utsname{}
+func (x *NeighborInfo) GetRouter() bool {
+	if x != nil {
+		return x.Router
+	}
+	return false
+}
+
+type InterfaceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index      int32                     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name       string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	HwAddr     string                    `protobuf:"bytes,3,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
+	Flags      uint32                    `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	MTU        int32                     `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Addrs      []string                  `protobuf:"bytes,6,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	OperState  string                    `protobuf:"bytes,7,opt,name=oper_state,json=operState,proto3" json:"oper_state,omitempty"`
+	LinkType   string                    `protobuf:"bytes,8,opt,name=link_type,json=linkType,proto3" json:"link_type,omitempty"`
+	Master     string                    `protobuf:"bytes,9,opt,name=master,proto3" json:"master,omitempty"`
+	Carrier    bool                      `protobuf:"varint,10,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	Speed      int32                     `protobuf:"varint,11,opt,name=speed,proto3" json:"speed,omitempty"`
+	Duplex     string                    `protobuf:"bytes,12,opt,name=duplex,proto3" json:"duplex,omitempty"`
+	Stats      *InterfaceInfo_Statistics `protobuf:"bytes,13,opt,name=stats,proto3" json:"stats,omitempty"`
+	Txqueuelen int32                     `protobuf:"varint,14,opt,name=txqueuelen,proto3" json:"txqueuelen,omitempty"`
+	Alias      string                    `protobuf:"bytes,15,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (x *InterfaceInfo) Reset() {
+	*x = InterfaceInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -644,13 +862,13 @@ func (x *GuestInfo_Utsname) Reset() {
 	}
 }
 
-func (x *GuestInfo_Utsname) String() string {
+func (x *InterfaceInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuestInfo_Utsname) ProtoMessage() {}
+func (*InterfaceInfo) ProtoMessage() {}
 
-func (x *GuestInfo_Utsname) ProtoReflect() protoreflect.Message {
+func (x *InterfaceInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,65 +880,132 @@ func (x *GuestInfo_Utsname) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuestInfo_Utsname.ProtoReflect.Descriptor instead.
-func (*GuestInfo_Utsname) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use InterfaceInfo.ProtoReflect.Descriptor instead.
+func (*InterfaceInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GuestInfo_Utsname) GetSysname() string {
+func (x *InterfaceInfo) GetIndex() int32 {
 	if x != nil {
-		return x.Sysname
+		return x.Index
+	}
+	return 0
+}
+
+func (x *InterfaceInfo) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GuestInfo_Utsname) GetNodename() string {
+func (x *InterfaceInfo) GetHwAddr() string {
 	if x != nil {
-		return x.Nodename
+		return x.HwAddr
 	}
 	return ""
 }
 
-func (x *GuestInfo_Utsname) GetRelease() string {
+func (x *InterfaceInfo) GetFlags() uint32 {
 	if x != nil {
-		return x.Release
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *InterfaceInfo) GetMTU() int32 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
+}
+
+func (x *InterfaceInfo) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+func (x *InterfaceInfo) GetOperState() string {
+	if x != nil {
+		return x.OperState
 	}
 	return ""
 }
 
-func (x *GuestInfo_Utsname) GetVersion() string {
+func (x *InterfaceInfo) GetLinkType() string {
 	if x != nil {
-		return x.Version
+		return x.LinkType
 	}
 	return ""
 }
 
-func (x *GuestInfo_Utsname) GetMachine() string {
+func (x *InterfaceInfo) GetMaster() string {
 	if x != nil {
-		return x.Machine
+		return x.Master
 	}
 	return ""
 }
 
-func (x *GuestInfo_Utsname) GetDomainname() string {
+func (x *InterfaceInfo) GetCarrier() bool {
 	if x != nil {
-		return x.Domainname
+		return x.Carrier
+	}
+	return false
+}
+
+func (x *InterfaceInfo) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *InterfaceInfo) GetDuplex() string {
+	if x != nil {
+		return x.Duplex
 	}
 	return ""
 }
 
-type GuestInfo_LoadAverage struct {
+func (x *InterfaceInfo) GetStats() *InterfaceInfo_Statistics {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *InterfaceInfo) GetTxqueuelen() int32 {
+	if x != nil {
+		return x.Txqueuelen
+	}
+	return 0
+}
+
+func (x *InterfaceInfo) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type TrafficControlStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	One     float64 `protobuf:"fixed64,1,opt,name=one,proto3" json:"one,omitempty"`
-	Five    float64 `protobuf:"fixed64,2,opt,name=five,proto3" json:"five,omitempty"`
-	Fifteen float64 `protobuf:"fixed64,3,opt,name=fifteen,proto3" json:"fifteen,omitempty"`
+	Bytes      uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Packets    uint32 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Drops      uint32 `protobuf:"varint,3,opt,name=drops,proto3" json:"drops,omitempty"`
+	Overlimits uint32 `protobuf:"varint,4,opt,name=overlimits,proto3" json:"overlimits,omitempty"`
+	Requeues   uint32 `protobuf:"varint,5,opt,name=requeues,proto3" json:"requeues,omitempty"`
+	Backlog    uint32 `protobuf:"varint,6,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	Qlen       uint32 `protobuf:"varint,7,opt,name=qlen,proto3" json:"qlen,omitempty"`
 }
 
-func (x *GuestInfo_LoadAverage) Reset() {
-	*x = GuestInfo_LoadAverage{}
+func (x *TrafficControlStats) Reset() {
+	*x = TrafficControlStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -728,13 +1013,13 @@ func (x *GuestInfo_LoadAverage) Reset() {
 	}
 }
 
-func (x *GuestInfo_LoadAverage) String() string {
+func (x *TrafficControlStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuestInfo_LoadAverage) ProtoMessage() {}
+func (*TrafficControlStats) ProtoMessage() {}
 
-func (x *GuestInfo_LoadAverage) ProtoReflect() protoreflect.Message {
+func (x *TrafficControlStats) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,46 +1031,73 @@ func (x *GuestInfo_LoadAverage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuestInfo_LoadAverage.ProtoReflect.Descriptor instead.
-func (*GuestInfo_LoadAverage) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use TrafficControlStats.ProtoReflect.Descriptor instead.
+func (*TrafficControlStats) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GuestInfo_LoadAverage) GetOne() float64 {
+func (x *TrafficControlStats) GetBytes() uint64 {
 	if x != nil {
-		return x.One
+		return x.Bytes
 	}
 	return 0
 }
 
-func (x *GuestInfo_LoadAverage) GetFive() float64 {
+func (x *TrafficControlStats) GetPackets() uint32 {
 	if x != nil {
-		return x.Five
+		return x.Packets
 	}
 	return 0
 }
 
-func (x *GuestInfo_LoadAverage) GetFifteen() float64 {
+func (x *TrafficControlStats) GetDrops() uint32 {
 	if x != nil {
-		return x.Fifteen
+		return x.Drops
 	}
 	return 0
 }
 
-type GuestInfo_MemStat struct {
+func (x *TrafficControlStats) GetOverlimits() uint32 {
+	if x != nil {
+		return x.Overlimits
+	}
+	return 0
+}
+
+func (x *TrafficControlStats) GetRequeues() uint32 {
+	if x != nil {
+		return x.Requeues
+	}
+	return 0
+}
+
+func (x *TrafficControlStats) GetBacklog() uint32 {
+	if x != nil {
+		return x.Backlog
+	}
+	return 0
+}
+
+func (x *TrafficControlStats) GetQlen() uint32 {
+	if x != nil {
+		return x.Qlen
+	}
+	return 0
+}
+
+type TbfOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total     uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Free      uint64 `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
-	Buffers   uint64 `protobuf:"varint,3,opt,name=buffers,proto3" json:"buffers,omitempty"`
-	Cached    uint64 `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`
-	FreeTotal uint64 `protobuf:"varint,5,opt,name=free_total,json=freeTotal,proto3" json:"free_total,omitempty"`
+	Rate      uint64 `protobuf:"varint,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst     uint32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	Limit     uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	LatencyUs uint32 `protobuf:"varint,4,opt,name=latency_us,json=latencyUs,proto3" json:"latency_us,omitempty"`
 }
 
-func (x *GuestInfo_MemStat) Reset() {
-	*x = GuestInfo_MemStat{}
+func (x *TbfOptions) Reset() {
+	*x = TbfOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -793,13 +1105,13 @@ func (x *GuestInfo_MemStat) Reset() {
 	}
 }
 
-func (x *GuestInfo_MemStat) String() string {
+func (x *TbfOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuestInfo_MemStat) ProtoMessage() {}
+func (*TbfOptions) ProtoMessage() {}
 
-func (x *GuestInfo_MemStat) ProtoReflect() protoreflect.Message {
+func (x *TbfOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -811,57 +1123,50 @@ func (x *GuestInfo_MemStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuestInfo_MemStat.ProtoReflect.Descriptor instead.
-func (*GuestInfo_MemStat) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 2}
-}
-
-func (x *GuestInfo_MemStat) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use TbfOptions.ProtoReflect.Descriptor instead.
+func (*TbfOptions) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GuestInfo_MemStat) GetFree() uint64 {
+func (x *TbfOptions) GetRate() uint64 {
 	if x != nil {
-		return x.Free
+		return x.Rate
 	}
 	return 0
 }
 
-func (x *GuestInfo_MemStat) GetBuffers() uint64 {
+func (x *TbfOptions) GetBurst() uint32 {
 	if x != nil {
-		return x.Buffers
+		return x.Burst
 	}
 	return 0
 }
 
-func (x *GuestInfo_MemStat) GetCached() uint64 {
+func (x *TbfOptions) GetLimit() uint32 {
 	if x != nil {
-		return x.Cached
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *GuestInfo_MemStat) GetFreeTotal() uint64 {
+func (x *TbfOptions) GetLatencyUs() uint32 {
 	if x != nil {
-		return x.FreeTotal
+		return x.LatencyUs
 	}
 	return 0
 }
 
-type GuestInfo_SwapStat struct {
+type HtbOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Free  uint64 `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Rate uint64 `protobuf:"varint,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Ceil uint64 `protobuf:"varint,2,opt,name=ceil,proto3" json:"ceil,omitempty"`
 }
 
-func (x *GuestInfo_SwapStat) Reset() {
-	*x = GuestInfo_SwapStat{}
+func (x *HtbOptions) Reset() {
+	*x = HtbOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,13 +1174,13 @@ func (x *GuestInfo_SwapStat) Reset() {
 	}
 }
 
-func (x *GuestInfo_SwapStat) String() string {
+func (x *HtbOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuestInfo_SwapStat) ProtoMessage() {}
+func (*HtbOptions) ProtoMessage() {}
 
-func (x *GuestInfo_SwapStat) ProtoReflect() protoreflect.Message {
+func (x *HtbOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -887,38 +1192,38 @@ func (x *GuestInfo_SwapStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuestInfo_SwapStat.ProtoReflect.Descriptor instead.
-func (*GuestInfo_SwapStat) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 3}
+// Deprecated: Use HtbOptions.ProtoReflect.Descriptor instead.
+func (*HtbOptions) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GuestInfo_SwapStat) GetTotal() uint64 {
+func (x *HtbOptions) GetRate() uint64 {
 	if x != nil {
-		return x.Total
+		return x.Rate
 	}
 	return 0
 }
 
-func (x *GuestInfo_SwapStat) GetFree() uint64 {
+func (x *HtbOptions) GetCeil() uint64 {
 	if x != nil {
-		return x.Free
+		return x.Ceil
 	}
 	return 0
 }
 
-type GuestInfo_LoggedUser struct {
+type FqCodelOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Device    string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
-	Host      string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
-	LoginTime int64  `protobuf:"varint,4,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+	Limit      uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Flows      uint32 `protobuf:"varint,2,opt,name=flows,proto3" json:"flows,omitempty"`
+	IntervalUs uint32 `protobuf:"varint,3,opt,name=interval_us,json=intervalUs,proto3" json:"interval_us,omitempty"`
+	NoEcn      bool   `protobuf:"varint,4,opt,name=no_ecn,json=noEcn,proto3" json:"no_ecn,omitempty"`
 }
 
-func (x *GuestInfo_LoggedUser) Reset() {
-	*x = GuestInfo_LoggedUser{}
+func (x *FqCodelOptions) Reset() {
+	*x = FqCodelOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -926,13 +1231,13 @@ func (x *GuestInfo_LoggedUser) Reset() {
 	}
 }
 
-func (x *GuestInfo_LoggedUser) String() string {
+func (x *FqCodelOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuestInfo_LoggedUser) ProtoMessage() {}
+func (*FqCodelOptions) ProtoMessage() {}
 
-func (x *GuestInfo_LoggedUser) ProtoReflect() protoreflect.Message {
+func (x *FqCodelOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -944,57 +1249,56 @@ func (x *GuestInfo_LoggedUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuestInfo_LoggedUser.ProtoReflect.Descriptor instead.
-func (*GuestInfo_LoggedUser) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 4}
+// Deprecated: Use FqCodelOptions.ProtoReflect.Descriptor instead.
+func (*FqCodelOptions) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GuestInfo_LoggedUser) GetName() string {
+func (x *FqCodelOptions) GetLimit() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *GuestInfo_LoggedUser) GetDevice() string {
+func (x *FqCodelOptions) GetFlows() uint32 {
 	if x != nil {
-		return x.Device
+		return x.Flows
 	}
-	return ""
+	return 0
 }
 
-func (x *GuestInfo_LoggedUser) GetHost() string {
+func (x *FqCodelOptions) GetIntervalUs() uint32 {
 	if x != nil {
-		return x.Host
+		return x.IntervalUs
 	}
-	return ""
+	return 0
 }
 
-func (x *GuestInfo_LoggedUser) GetLoginTime() int64 {
+func (x *FqCodelOptions) GetNoEcn() bool {
 	if x != nil {
-		return x.LoginTime
+		return x.NoEcn
 	}
-	return 0
+	return false
 }
 
-type GuestInfo_BlockDevice struct {
+type NetemOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path        string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	IsMounted   bool   `protobuf:"varint,2,opt,name=is_mounted,json=isMounted,proto3" json:"is_mounted,omitempty"`
-	MountPoint  string `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
-	SizeTotal   int64  `protobuf:"varint,4,opt,name=size_total,json=sizeTotal,proto3" json:"size_total,omitempty"`
-	SizeUsed    int64  `protobuf:"varint,5,opt,name=size_used,json=sizeUsed,proto3" json:"size_used,omitempty"`
-	SizeAvail   int64  `protobuf:"varint,6,opt,name=size_avail,json=sizeAvail,proto3" json:"size_avail,omitempty"`
-	InodesTotal int64  `protobuf:"varint,7,opt,name=inodes_total,json=inodesTotal,proto3" json:"inodes_total,omitempty"`
-	InodesUsed  int64  `protobuf:"varint,8,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`
-	InodesAvail int64  `protobuf:"varint,9,opt,name=inodes_avail,json=inodesAvail,proto3" json:"inodes_avail,omitempty"`
+	DelayUs   uint32  `protobuf:"varint,1,opt,name=delay_us,json=delayUs,proto3" json:"delay_us,omitempty"`
+	JitterUs  uint32  `protobuf:"varint,2,opt,name=jitter_us,json=jitterUs,proto3" json:"jitter_us,omitempty"`
+	Loss      float32 `protobuf:"fixed32,3,opt,name=loss,proto3" json:"loss,omitempty"`
+	Duplicate float32 `protobuf:"fixed32,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Corrupt   float32 `protobuf:"fixed32,5,opt,name=corrupt,proto3" json:"corrupt,omitempty"`
+	Reorder   float32 `protobuf:"fixed32,6,opt,name=reorder,proto3" json:"reorder,omitempty"`
+	Limit     uint32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Rate      uint64  `protobuf:"varint,8,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
-func (x *GuestInfo_BlockDevice) Reset() {
-	*x = GuestInfo_BlockDevice{}
+func (x *NetemOptions) Reset() {
+	*x = NetemOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1002,13 +1306,13 @@ func (x *GuestInfo_BlockDevice) Reset() {
 	}
 }
 
-func (x *GuestInfo_BlockDevice) String() string {
+func (x *NetemOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuestInfo_BlockDevice) ProtoMessage() {}
+func (*NetemOptions) ProtoMessage() {}
 
-func (x *GuestInfo_BlockDevice) ProtoReflect() protoreflect.Message {
+func (x *NetemOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,85 +1324,83 @@ func (x *GuestInfo_BlockDevice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuestInfo_BlockDevice.ProtoReflect.Descriptor instead.
-func (*GuestInfo_BlockDevice) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 5}
-}
-
-func (x *GuestInfo_BlockDevice) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use NetemOptions.ProtoReflect.Descriptor instead.
+func (*NetemOptions) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GuestInfo_BlockDevice) GetIsMounted() bool {
+func (x *NetemOptions) GetDelayUs() uint32 {
 	if x != nil {
-		return x.IsMounted
+		return x.DelayUs
 	}
-	return false
+	return 0
 }
 
-func (x *GuestInfo_BlockDevice) GetMountPoint() string {
+func (x *NetemOptions) GetJitterUs() uint32 {
 	if x != nil {
-		return x.MountPoint
+		return x.JitterUs
 	}
-	return ""
+	return 0
 }
 
-func (x *GuestInfo_BlockDevice) GetSizeTotal() int64 {
+func (x *NetemOptions) GetLoss() float32 {
 	if x != nil {
-		return x.SizeTotal
+		return x.Loss
 	}
 	return 0
 }
 
-func (x *GuestInfo_BlockDevice) GetSizeUsed() int64 {
+func (x *NetemOptions) GetDuplicate() float32 {
 	if x != nil {
-		return x.SizeUsed
+		return x.Duplicate
 	}
 	return 0
 }
 
-func (x *GuestInfo_BlockDevice) GetSizeAvail() int64 {
+func (x *NetemOptions) GetCorrupt() float32 {
 	if x != nil {
-		return x.SizeAvail
+		return x.Corrupt
 	}
 	return 0
 }
 
-func (x *GuestInfo_BlockDevice) GetInodesTotal() int64 {
+func (x *NetemOptions) GetReorder() float32 {
 	if x != nil {
-		return x.InodesTotal
+		return x.Reorder
 	}
 	return 0
 }
 
-func (x *GuestInfo_BlockDevice) GetInodesUsed() int64 {
+func (x *NetemOptions) GetLimit() uint32 {
 	if x != nil {
-		return x.InodesUsed
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *GuestInfo_BlockDevice) GetInodesAvail() int64 {
+func (x *NetemOptions) GetRate() uint64 {
 	if x != nil {
-		return x.InodesAvail
+		return x.Rate
 	}
 	return 0
 }
 
-type FileStat_Owner struct {
+type QdiscInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UID  uint32 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind    string               `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Handle  string               `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Parent  string               `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	Stats   *TrafficControlStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	Tbf     *TbfOptions          `protobuf:"bytes,5,opt,name=tbf,proto3" json:"tbf,omitempty"`
+	FqCodel *FqCodelOptions      `protobuf:"bytes,6,opt,name=fq_codel,json=fqCodel,proto3" json:"fq_codel,omitempty"`
+	Netem   *NetemOptions        `protobuf:"bytes,7,opt,name=netem,proto3" json:"netem,omitempty"`
 }
 
-func (x *FileStat_Owner) Reset() {
-	*x = FileStat_Owner{}
+func (x *QdiscInfo) Reset() {
+	*x = QdiscInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_types_v2_agent_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1106,316 +1408,3696 @@ func (x *FileStat_Owner) Reset() {
 	}
 }
 
-func (x *FileStat_Owner) String() string {
+func (x *QdiscInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileStat_Owner) ProtoMessage() {}
+func (*QdiscInfo) ProtoMessage() {}
 
-func (x *FileStat_Owner) ProtoReflect() protoreflect.Message {
+func (x *QdiscInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_types_v2_agent_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FileStat_Owner.ProtoReflect.Descriptor instead.
-func (*FileStat_Owner) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{4, 0}
-}
-
-func (x *FileStat_Owner) GetUID() uint32 {
-	if x != nil {
-		return x.UID
-	}
-	return 0
-}
-
-func (x *FileStat_Owner) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type FileStat_Group struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GID  uint32 `protobuf:"varint,1,opt,name=gid,proto3" json:"gid,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *FileStat_Group) Reset() {
-	*x = FileStat_Group{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_agent_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FileStat_Group) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FileStat_Group) ProtoMessage() {}
-
-func (x *FileStat_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_agent_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QdiscInfo.ProtoReflect.Descriptor instead.
+func (*QdiscInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QdiscInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *QdiscInfo) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *QdiscInfo) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *QdiscInfo) GetStats() *TrafficControlStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *QdiscInfo) GetTbf() *TbfOptions {
+	if x != nil {
+		return x.Tbf
+	}
+	return nil
+}
+
+func (x *QdiscInfo) GetFqCodel() *FqCodelOptions {
+	if x != nil {
+		return x.FqCodel
+	}
+	return nil
+}
+
+func (x *QdiscInfo) GetNetem() *NetemOptions {
+	if x != nil {
+		return x.Netem
+	}
+	return nil
+}
+
+type TrafficClassInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind   string               `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Handle string               `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Parent string               `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	Stats  *TrafficControlStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	Rate   uint64               `protobuf:"varint,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Ceil   uint64               `protobuf:"varint,6,opt,name=ceil,proto3" json:"ceil,omitempty"`
+}
+
+func (x *TrafficClassInfo) Reset() {
+	*x = TrafficClassInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficClassInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficClassInfo) ProtoMessage() {}
+
+func (x *TrafficClassInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficClassInfo.ProtoReflect.Descriptor instead.
+func (*TrafficClassInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TrafficClassInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TrafficClassInfo) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *TrafficClassInfo) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *TrafficClassInfo) GetStats() *TrafficControlStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *TrafficClassInfo) GetRate() uint64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TrafficClassInfo) GetCeil() uint64 {
+	if x != nil {
+		return x.Ceil
+	}
+	return 0
+}
+
+type TrafficFilterInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Handle   string `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Parent   string `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	Priority int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Protocol string `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ClassId  string `protobuf:"bytes,6,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *TrafficFilterInfo) Reset() {
+	*x = TrafficFilterInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficFilterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficFilterInfo) ProtoMessage() {}
+
+func (x *TrafficFilterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficFilterInfo.ProtoReflect.Descriptor instead.
+func (*TrafficFilterInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TrafficFilterInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TrafficFilterInfo) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *TrafficFilterInfo) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *TrafficFilterInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TrafficFilterInfo) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *TrafficFilterInfo) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+type Socket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol   string            `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Type       string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	State      string            `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Listening  bool              `protobuf:"varint,4,opt,name=listening,proto3" json:"listening,omitempty"`
+	LocalAddr  string            `protobuf:"bytes,5,opt,name=local_addr,json=localAddr,proto3" json:"local_addr,omitempty"`
+	RemoteAddr string            `protobuf:"bytes,6,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	RecvQ      uint64            `protobuf:"varint,7,opt,name=recv_q,json=recvQ,proto3" json:"recv_q,omitempty"`
+	SendQ      uint64            `protobuf:"varint,8,opt,name=send_q,json=sendQ,proto3" json:"send_q,omitempty"`
+	UID        uint32            `protobuf:"varint,9,opt,name=uid,proto3" json:"uid,omitempty"`
+	Inode      uint64            `protobuf:"varint,10,opt,name=inode,proto3" json:"inode,omitempty"`
+	Processes  []*Socket_Process `protobuf:"bytes,11,rep,name=processes,proto3" json:"processes,omitempty"`
+}
+
+func (x *Socket) Reset() {
+	*x = Socket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Socket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Socket) ProtoMessage() {}
+
+func (x *Socket) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Socket.ProtoReflect.Descriptor instead.
+func (*Socket) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Socket) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Socket) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Socket) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Socket) GetListening() bool {
+	if x != nil {
+		return x.Listening
+	}
+	return false
+}
+
+func (x *Socket) GetLocalAddr() string {
+	if x != nil {
+		return x.LocalAddr
+	}
+	return ""
+}
+
+func (x *Socket) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *Socket) GetRecvQ() uint64 {
+	if x != nil {
+		return x.RecvQ
+	}
+	return 0
+}
+
+func (x *Socket) GetSendQ() uint64 {
+	if x != nil {
+		return x.SendQ
+	}
+	return 0
+}
+
+func (x *Socket) GetUID() uint32 {
+	if x != nil {
+		return x.UID
+	}
+	return 0
+}
+
+func (x *Socket) GetInode() uint64 {
+	if x != nil {
+		return x.Inode
+	}
+	return 0
+}
+
+func (x *Socket) GetProcesses() []*Socket_Process {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type FileStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Mode      uint32          `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	IsDir     bool            `protobuf:"varint,3,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	Owner     *FileStat_Owner `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Group     *FileStat_Group `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	SizeBytes int64           `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *FileStat) Reset() {
+	*x = FileStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileStat) ProtoMessage() {}
+
+func (x *FileStat) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileStat.ProtoReflect.Descriptor instead.
+func (*FileStat) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FileStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileStat) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileStat) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *FileStat) GetOwner() *FileStat_Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *FileStat) GetGroup() *FileStat_Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *FileStat) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type PhysicalVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VgName               string `protobuf:"bytes,2,opt,name=vg_name,json=vgName,proto3" json:"vg_name,omitempty"`
+	Format               string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	SizeBytes            uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FreeBytes            uint64 `protobuf:"varint,5,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	ExtentCount          uint64 `protobuf:"varint,6,opt,name=extent_count,json=extentCount,proto3" json:"extent_count,omitempty"`
+	AllocatedExtentCount uint64 `protobuf:"varint,7,opt,name=allocated_extent_count,json=allocatedExtentCount,proto3" json:"allocated_extent_count,omitempty"`
+}
+
+func (x *PhysicalVolume) Reset() {
+	*x = PhysicalVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhysicalVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhysicalVolume) ProtoMessage() {}
+
+func (x *PhysicalVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhysicalVolume.ProtoReflect.Descriptor instead.
+func (*PhysicalVolume) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PhysicalVolume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PhysicalVolume) GetVgName() string {
+	if x != nil {
+		return x.VgName
+	}
+	return ""
+}
+
+func (x *PhysicalVolume) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PhysicalVolume) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *PhysicalVolume) GetFreeBytes() uint64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+func (x *PhysicalVolume) GetExtentCount() uint64 {
+	if x != nil {
+		return x.ExtentCount
+	}
+	return 0
+}
+
+func (x *PhysicalVolume) GetAllocatedExtentCount() uint64 {
+	if x != nil {
+		return x.AllocatedExtentCount
+	}
+	return 0
+}
+
+type VolumeGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SizeBytes       uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	FreeBytes       uint64 `protobuf:"varint,3,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	ExtentSize      uint64 `protobuf:"varint,4,opt,name=extent_size,json=extentSize,proto3" json:"extent_size,omitempty"`
+	ExtentCount     uint64 `protobuf:"varint,5,opt,name=extent_count,json=extentCount,proto3" json:"extent_count,omitempty"`
+	FreeExtentCount uint64 `protobuf:"varint,6,opt,name=free_extent_count,json=freeExtentCount,proto3" json:"free_extent_count,omitempty"`
+	PvCount         uint32 `protobuf:"varint,7,opt,name=pv_count,json=pvCount,proto3" json:"pv_count,omitempty"`
+	LvCount         uint32 `protobuf:"varint,8,opt,name=lv_count,json=lvCount,proto3" json:"lv_count,omitempty"`
+}
+
+func (x *VolumeGroup) Reset() {
+	*x = VolumeGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeGroup) ProtoMessage() {}
+
+func (x *VolumeGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeGroup.ProtoReflect.Descriptor instead.
+func (*VolumeGroup) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VolumeGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeGroup) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VolumeGroup) GetFreeBytes() uint64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+func (x *VolumeGroup) GetExtentSize() uint64 {
+	if x != nil {
+		return x.ExtentSize
+	}
+	return 0
+}
+
+func (x *VolumeGroup) GetExtentCount() uint64 {
+	if x != nil {
+		return x.ExtentCount
+	}
+	return 0
+}
+
+func (x *VolumeGroup) GetFreeExtentCount() uint64 {
+	if x != nil {
+		return x.FreeExtentCount
+	}
+	return 0
+}
+
+func (x *VolumeGroup) GetPvCount() uint32 {
+	if x != nil {
+		return x.PvCount
+	}
+	return 0
+}
+
+func (x *VolumeGroup) GetLvCount() uint32 {
+	if x != nil {
+		return x.LvCount
+	}
+	return 0
+}
+
+type LogicalVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VgName    string `protobuf:"bytes,2,opt,name=vg_name,json=vgName,proto3" json:"vg_name,omitempty"`
+	Path      string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	DmPath    string `protobuf:"bytes,4,opt,name=dm_path,json=dmPath,proto3" json:"dm_path,omitempty"`
+	Attr      string `protobuf:"bytes,5,opt,name=attr,proto3" json:"attr,omitempty"`
+	SizeBytes uint64 `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *LogicalVolume) Reset() {
+	*x = LogicalVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogicalVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogicalVolume) ProtoMessage() {}
+
+func (x *LogicalVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogicalVolume.ProtoReflect.Descriptor instead.
+func (*LogicalVolume) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LogicalVolume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogicalVolume) GetVgName() string {
+	if x != nil {
+		return x.VgName
+	}
+	return ""
+}
+
+func (x *LogicalVolume) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *LogicalVolume) GetDmPath() string {
+	if x != nil {
+		return x.DmPath
+	}
+	return ""
+}
+
+func (x *LogicalVolume) GetAttr() string {
+	if x != nil {
+		return x.Attr
+	}
+	return ""
+}
+
+func (x *LogicalVolume) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type EncryptedVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive bool   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Cipher   string `protobuf:"bytes,4,opt,name=cipher,proto3" json:"cipher,omitempty"`
+	KeySize  uint32 `protobuf:"varint,5,opt,name=key_size,json=keySize,proto3" json:"key_size,omitempty"`
+	Device   string `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
+	ReadOnly bool   `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *EncryptedVolume) Reset() {
+	*x = EncryptedVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncryptedVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptedVolume) ProtoMessage() {}
+
+func (x *EncryptedVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptedVolume.ProtoReflect.Descriptor instead.
+func (*EncryptedVolume) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EncryptedVolume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EncryptedVolume) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *EncryptedVolume) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EncryptedVolume) GetCipher() string {
+	if x != nil {
+		return x.Cipher
+	}
+	return ""
+}
+
+func (x *EncryptedVolume) GetKeySize() uint32 {
+	if x != nil {
+		return x.KeySize
+	}
+	return 0
+}
+
+func (x *EncryptedVolume) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *EncryptedVolume) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type PingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq   uint32 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	From  string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Size  uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Ttl   uint32 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	RttUs uint64 `protobuf:"varint,5,opt,name=rtt_us,json=rttUs,proto3" json:"rtt_us,omitempty"`
+	Lost  bool   `protobuf:"varint,6,opt,name=lost,proto3" json:"lost,omitempty"`
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PingReply) Reset() {
+	*x = PingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingReply) ProtoMessage() {}
+
+func (x *PingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingReply.ProtoReflect.Descriptor instead.
+func (*PingReply) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PingReply) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PingReply) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PingReply) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PingReply) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *PingReply) GetRttUs() uint64 {
+	if x != nil {
+		return x.RttUs
+	}
+	return 0
+}
+
+func (x *PingReply) GetLost() bool {
+	if x != nil {
+		return x.Lost
+	}
+	return false
+}
+
+func (x *PingReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PingStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr     string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Sent     uint32 `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	Received uint32 `protobuf:"varint,3,opt,name=received,proto3" json:"received,omitempty"`
+	MinRttUs uint64 `protobuf:"varint,4,opt,name=min_rtt_us,json=minRttUs,proto3" json:"min_rtt_us,omitempty"`
+	AvgRttUs uint64 `protobuf:"varint,5,opt,name=avg_rtt_us,json=avgRttUs,proto3" json:"avg_rtt_us,omitempty"`
+	MaxRttUs uint64 `protobuf:"varint,6,opt,name=max_rtt_us,json=maxRttUs,proto3" json:"max_rtt_us,omitempty"`
+}
+
+func (x *PingStats) Reset() {
+	*x = PingStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingStats) ProtoMessage() {}
+
+func (x *PingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingStats.ProtoReflect.Descriptor instead.
+func (*PingStats) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PingStats) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *PingStats) GetSent() uint32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *PingStats) GetReceived() uint32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *PingStats) GetMinRttUs() uint64 {
+	if x != nil {
+		return x.MinRttUs
+	}
+	return 0
+}
+
+func (x *PingStats) GetAvgRttUs() uint64 {
+	if x != nil {
+		return x.AvgRttUs
+	}
+	return 0
+}
+
+func (x *PingStats) GetMaxRttUs() uint64 {
+	if x != nil {
+		return x.MaxRttUs
+	}
+	return 0
+}
+
+type ConnectProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq       uint32 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	LocalAddr string `protobuf:"bytes,2,opt,name=local_addr,json=localAddr,proto3" json:"local_addr,omitempty"`
+	RttUs     uint64 `protobuf:"varint,3,opt,name=rtt_us,json=rttUs,proto3" json:"rtt_us,omitempty"`
+	Lost      bool   `protobuf:"varint,4,opt,name=lost,proto3" json:"lost,omitempty"`
+	Error     string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ConnectProbe) Reset() {
+	*x = ConnectProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectProbe) ProtoMessage() {}
+
+func (x *ConnectProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectProbe.ProtoReflect.Descriptor instead.
+func (*ConnectProbe) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConnectProbe) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ConnectProbe) GetLocalAddr() string {
+	if x != nil {
+		return x.LocalAddr
+	}
+	return ""
+}
+
+func (x *ConnectProbe) GetRttUs() uint64 {
+	if x != nil {
+		return x.RttUs
+	}
+	return 0
+}
+
+func (x *ConnectProbe) GetLost() bool {
+	if x != nil {
+		return x.Lost
+	}
+	return false
+}
+
+func (x *ConnectProbe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ConnectStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr      string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Sent      uint32 `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	Succeeded uint32 `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	MinRttUs  uint64 `protobuf:"varint,4,opt,name=min_rtt_us,json=minRttUs,proto3" json:"min_rtt_us,omitempty"`
+	AvgRttUs  uint64 `protobuf:"varint,5,opt,name=avg_rtt_us,json=avgRttUs,proto3" json:"avg_rtt_us,omitempty"`
+	MaxRttUs  uint64 `protobuf:"varint,6,opt,name=max_rtt_us,json=maxRttUs,proto3" json:"max_rtt_us,omitempty"`
+}
+
+func (x *ConnectStats) Reset() {
+	*x = ConnectStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectStats) ProtoMessage() {}
+
+func (x *ConnectStats) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectStats.ProtoReflect.Descriptor instead.
+func (*ConnectStats) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConnectStats) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ConnectStats) GetSent() uint32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *ConnectStats) GetSucceeded() uint32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ConnectStats) GetMinRttUs() uint64 {
+	if x != nil {
+		return x.MinRttUs
+	}
+	return 0
+}
+
+func (x *ConnectStats) GetAvgRttUs() uint64 {
+	if x != nil {
+		return x.AvgRttUs
+	}
+	return 0
+}
+
+func (x *ConnectStats) GetMaxRttUs() uint64 {
+	if x != nil {
+		return x.MaxRttUs
+	}
+	return 0
+}
+
+type TracerouteHop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ttl    uint32                 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Probes []*TracerouteHop_Probe `protobuf:"bytes,2,rep,name=probes,proto3" json:"probes,omitempty"`
+}
+
+func (x *TracerouteHop) Reset() {
+	*x = TracerouteHop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracerouteHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracerouteHop) ProtoMessage() {}
+
+func (x *TracerouteHop) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracerouteHop.ProtoReflect.Descriptor instead.
+func (*TracerouteHop) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TracerouteHop) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *TracerouteHop) GetProbes() []*TracerouteHop_Probe {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+type TracerouteStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr    string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Hops    uint32 `protobuf:"varint,2,opt,name=hops,proto3" json:"hops,omitempty"`
+	Reached bool   `protobuf:"varint,3,opt,name=reached,proto3" json:"reached,omitempty"`
+}
+
+func (x *TracerouteStats) Reset() {
+	*x = TracerouteStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracerouteStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracerouteStats) ProtoMessage() {}
+
+func (x *TracerouteStats) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracerouteStats.ProtoReflect.Descriptor instead.
+func (*TracerouteStats) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TracerouteStats) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *TracerouteStats) GetHops() uint32 {
+	if x != nil {
+		return x.Hops
+	}
+	return 0
+}
+
+func (x *TracerouteStats) GetReached() bool {
+	if x != nil {
+		return x.Reached
+	}
+	return false
+}
+
+type AgentInfo_Features struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LegacyMode      bool     `protobuf:"varint,1,opt,name=legacy_mode,json=legacyMode,proto3" json:"legacy_mode,omitempty"`
+	SerialPort      string   `protobuf:"bytes,2,opt,name=serial_port,json=serialPort,proto3" json:"serial_port,omitempty"`
+	WithoutSSH      bool     `protobuf:"varint,3,opt,name=without_ssh,json=withoutSsh,proto3" json:"without_ssh,omitempty"`
+	WithoutTCP      bool     `protobuf:"varint,4,opt,name=without_tcp,json=withoutTcp,proto3" json:"without_tcp,omitempty"`
+	SysctlAllowlist []string `protobuf:"bytes,5,rep,name=sysctl_allowlist,json=sysctlAllowlist,proto3" json:"sysctl_allowlist,omitempty"`
+}
+
+func (x *AgentInfo_Features) Reset() {
+	*x = AgentInfo_Features{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentInfo_Features) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentInfo_Features) ProtoMessage() {}
+
+func (x *AgentInfo_Features) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentInfo_Features.ProtoReflect.Descriptor instead.
+func (*AgentInfo_Features) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AgentInfo_Features) GetLegacyMode() bool {
+	if x != nil {
+		return x.LegacyMode
+	}
+	return false
+}
+
+func (x *AgentInfo_Features) GetSerialPort() string {
+	if x != nil {
+		return x.SerialPort
+	}
+	return ""
+}
+
+func (x *AgentInfo_Features) GetWithoutSSH() bool {
+	if x != nil {
+		return x.WithoutSSH
+	}
+	return false
+}
+
+func (x *AgentInfo_Features) GetWithoutTCP() bool {
+	if x != nil {
+		return x.WithoutTCP
+	}
+	return false
+}
+
+func (x *AgentInfo_Features) GetSysctlAllowlist() []string {
+	if x != nil {
+		return x.SysctlAllowlist
+	}
+	return nil
+}
+
+type GuestInfo_Utsname struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sysname    string `protobuf:"bytes,1,opt,name=sysname,proto3" json:"sysname,omitempty"`
+	Nodename   string `protobuf:"bytes,2,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Release    string `protobuf:"bytes,3,opt,name=release,proto3" json:"release,omitempty"`
+	Version    string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Machine    string `protobuf:"bytes,5,opt,name=machine,proto3" json:"machine,omitempty"`
+	Domainname string `protobuf:"bytes,6,opt,name=domainname,proto3" json:"domainname,omitempty"`
+}
+
+func (x *GuestInfo_Utsname) Reset() {
+	*x = GuestInfo_Utsname{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_Utsname) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_Utsname) ProtoMessage() {}
+
+func (x *GuestInfo_Utsname) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_Utsname.ProtoReflect.Descriptor instead.
+func (*GuestInfo_Utsname) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GuestInfo_Utsname) GetSysname() string {
+	if x != nil {
+		return x.Sysname
+	}
+	return ""
+}
+
+func (x *GuestInfo_Utsname) GetNodename() string {
+	if x != nil {
+		return x.Nodename
+	}
+	return ""
+}
+
+func (x *GuestInfo_Utsname) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *GuestInfo_Utsname) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GuestInfo_Utsname) GetMachine() string {
+	if x != nil {
+		return x.Machine
+	}
+	return ""
+}
+
+func (x *GuestInfo_Utsname) GetDomainname() string {
+	if x != nil {
+		return x.Domainname
+	}
+	return ""
+}
+
+type GuestInfo_LoadAverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	One     float64 `protobuf:"fixed64,1,opt,name=one,proto3" json:"one,omitempty"`
+	Five    float64 `protobuf:"fixed64,2,opt,name=five,proto3" json:"five,omitempty"`
+	Fifteen float64 `protobuf:"fixed64,3,opt,name=fifteen,proto3" json:"fifteen,omitempty"`
+}
+
+func (x *GuestInfo_LoadAverage) Reset() {
+	*x = GuestInfo_LoadAverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_LoadAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_LoadAverage) ProtoMessage() {}
+
+func (x *GuestInfo_LoadAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_LoadAverage.ProtoReflect.Descriptor instead.
+func (*GuestInfo_LoadAverage) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GuestInfo_LoadAverage) GetOne() float64 {
+	if x != nil {
+		return x.One
+	}
+	return 0
+}
+
+func (x *GuestInfo_LoadAverage) GetFive() float64 {
+	if x != nil {
+		return x.Five
+	}
+	return 0
+}
+
+func (x *GuestInfo_LoadAverage) GetFifteen() float64 {
+	if x != nil {
+		return x.Fifteen
+	}
+	return 0
+}
+
+type GuestInfo_MemStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total          uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Free           uint64 `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Buffers        uint64 `protobuf:"varint,3,opt,name=buffers,proto3" json:"buffers,omitempty"`
+	Cached         uint64 `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`
+	FreeTotal      uint64 `protobuf:"varint,5,opt,name=free_total,json=freeTotal,proto3" json:"free_total,omitempty"`
+	Available      uint64 `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	Dirty          uint64 `protobuf:"varint,7,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	Writeback      uint64 `protobuf:"varint,8,opt,name=writeback,proto3" json:"writeback,omitempty"`
+	Slab           uint64 `protobuf:"varint,9,opt,name=slab,proto3" json:"slab,omitempty"`
+	HugePagesTotal uint64 `protobuf:"varint,10,opt,name=huge_pages_total,json=hugePagesTotal,proto3" json:"huge_pages_total,omitempty"`
+	HugePagesFree  uint64 `protobuf:"varint,11,opt,name=huge_pages_free,json=hugePagesFree,proto3" json:"huge_pages_free,omitempty"`
+	HugePageSize   uint64 `protobuf:"varint,12,opt,name=huge_page_size,json=hugePageSize,proto3" json:"huge_page_size,omitempty"`
+}
+
+func (x *GuestInfo_MemStat) Reset() {
+	*x = GuestInfo_MemStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_MemStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_MemStat) ProtoMessage() {}
+
+func (x *GuestInfo_MemStat) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_MemStat.ProtoReflect.Descriptor instead.
+func (*GuestInfo_MemStat) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *GuestInfo_MemStat) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetBuffers() uint64 {
+	if x != nil {
+		return x.Buffers
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetCached() uint64 {
+	if x != nil {
+		return x.Cached
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetFreeTotal() uint64 {
+	if x != nil {
+		return x.FreeTotal
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetDirty() uint64 {
+	if x != nil {
+		return x.Dirty
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetWriteback() uint64 {
+	if x != nil {
+		return x.Writeback
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetSlab() uint64 {
+	if x != nil {
+		return x.Slab
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetHugePagesTotal() uint64 {
+	if x != nil {
+		return x.HugePagesTotal
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetHugePagesFree() uint64 {
+	if x != nil {
+		return x.HugePagesFree
+	}
+	return 0
+}
+
+func (x *GuestInfo_MemStat) GetHugePageSize() uint64 {
+	if x != nil {
+		return x.HugePageSize
+	}
+	return 0
+}
+
+type GuestInfo_SwapStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Free  uint64 `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+}
+
+func (x *GuestInfo_SwapStat) Reset() {
+	*x = GuestInfo_SwapStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_SwapStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_SwapStat) ProtoMessage() {}
+
+func (x *GuestInfo_SwapStat) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_SwapStat.ProtoReflect.Descriptor instead.
+func (*GuestInfo_SwapStat) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *GuestInfo_SwapStat) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GuestInfo_SwapStat) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+type GuestInfo_LoggedUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Device    string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Host      string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	LoginTime int64  `protobuf:"varint,4,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+}
+
+func (x *GuestInfo_LoggedUser) Reset() {
+	*x = GuestInfo_LoggedUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_LoggedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_LoggedUser) ProtoMessage() {}
+
+func (x *GuestInfo_LoggedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_LoggedUser.ProtoReflect.Descriptor instead.
+func (*GuestInfo_LoggedUser) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *GuestInfo_LoggedUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuestInfo_LoggedUser) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *GuestInfo_LoggedUser) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GuestInfo_LoggedUser) GetLoginTime() int64 {
+	if x != nil {
+		return x.LoginTime
+	}
+	return 0
+}
+
+type GuestInfo_BlockDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path        string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	IsMounted   bool   `protobuf:"varint,2,opt,name=is_mounted,json=isMounted,proto3" json:"is_mounted,omitempty"`
+	MountPoint  string `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	SizeTotal   int64  `protobuf:"varint,4,opt,name=size_total,json=sizeTotal,proto3" json:"size_total,omitempty"`
+	SizeUsed    int64  `protobuf:"varint,5,opt,name=size_used,json=sizeUsed,proto3" json:"size_used,omitempty"`
+	SizeAvail   int64  `protobuf:"varint,6,opt,name=size_avail,json=sizeAvail,proto3" json:"size_avail,omitempty"`
+	InodesTotal int64  `protobuf:"varint,7,opt,name=inodes_total,json=inodesTotal,proto3" json:"inodes_total,omitempty"`
+	InodesUsed  int64  `protobuf:"varint,8,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`
+	InodesAvail int64  `protobuf:"varint,9,opt,name=inodes_avail,json=inodesAvail,proto3" json:"inodes_avail,omitempty"`
+}
+
+func (x *GuestInfo_BlockDevice) Reset() {
+	*x = GuestInfo_BlockDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_BlockDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_BlockDevice) ProtoMessage() {}
+
+func (x *GuestInfo_BlockDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_BlockDevice.ProtoReflect.Descriptor instead.
+func (*GuestInfo_BlockDevice) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *GuestInfo_BlockDevice) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GuestInfo_BlockDevice) GetIsMounted() bool {
+	if x != nil {
+		return x.IsMounted
+	}
+	return false
+}
+
+func (x *GuestInfo_BlockDevice) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *GuestInfo_BlockDevice) GetSizeTotal() int64 {
+	if x != nil {
+		return x.SizeTotal
+	}
+	return 0
+}
+
+func (x *GuestInfo_BlockDevice) GetSizeUsed() int64 {
+	if x != nil {
+		return x.SizeUsed
+	}
+	return 0
+}
+
+func (x *GuestInfo_BlockDevice) GetSizeAvail() int64 {
+	if x != nil {
+		return x.SizeAvail
+	}
+	return 0
+}
+
+func (x *GuestInfo_BlockDevice) GetInodesTotal() int64 {
+	if x != nil {
+		return x.InodesTotal
+	}
+	return 0
+}
+
+func (x *GuestInfo_BlockDevice) GetInodesUsed() int64 {
+	if x != nil {
+		return x.InodesUsed
+	}
+	return 0
+}
+
+func (x *GuestInfo_BlockDevice) GetInodesAvail() int64 {
+	if x != nil {
+		return x.InodesAvail
+	}
+	return 0
+}
+
+type GuestInfo_CPUStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	User    float64 `protobuf:"fixed64,2,opt,name=user,proto3" json:"user,omitempty"`
+	Nice    float64 `protobuf:"fixed64,3,opt,name=nice,proto3" json:"nice,omitempty"`
+	System  float64 `protobuf:"fixed64,4,opt,name=system,proto3" json:"system,omitempty"`
+	Idle    float64 `protobuf:"fixed64,5,opt,name=idle,proto3" json:"idle,omitempty"`
+	Iowait  float64 `protobuf:"fixed64,6,opt,name=iowait,proto3" json:"iowait,omitempty"`
+	Irq     float64 `protobuf:"fixed64,7,opt,name=irq,proto3" json:"irq,omitempty"`
+	Softirq float64 `protobuf:"fixed64,8,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	Steal   float64 `protobuf:"fixed64,9,opt,name=steal,proto3" json:"steal,omitempty"`
+}
+
+func (x *GuestInfo_CPUStat) Reset() {
+	*x = GuestInfo_CPUStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_CPUStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_CPUStat) ProtoMessage() {}
+
+func (x *GuestInfo_CPUStat) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_CPUStat.ProtoReflect.Descriptor instead.
+func (*GuestInfo_CPUStat) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 6}
+}
+
+func (x *GuestInfo_CPUStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuestInfo_CPUStat) GetUser() float64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *GuestInfo_CPUStat) GetNice() float64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *GuestInfo_CPUStat) GetSystem() float64 {
+	if x != nil {
+		return x.System
+	}
+	return 0
+}
+
+func (x *GuestInfo_CPUStat) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *GuestInfo_CPUStat) GetIowait() float64 {
+	if x != nil {
+		return x.Iowait
+	}
+	return 0
+}
+
+func (x *GuestInfo_CPUStat) GetIrq() float64 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
+
+func (x *GuestInfo_CPUStat) GetSoftirq() float64 {
+	if x != nil {
+		return x.Softirq
+	}
+	return 0
+}
+
+func (x *GuestInfo_CPUStat) GetSteal() float64 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
+}
+
+type GuestInfo_DiskStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReadsPerSec      float64 `protobuf:"fixed64,2,opt,name=reads_per_sec,json=readsPerSec,proto3" json:"reads_per_sec,omitempty"`
+	WritesPerSec     float64 `protobuf:"fixed64,3,opt,name=writes_per_sec,json=writesPerSec,proto3" json:"writes_per_sec,omitempty"`
+	ReadBytesPerSec  float64 `protobuf:"fixed64,4,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec float64 `protobuf:"fixed64,5,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+	Utilization      float64 `protobuf:"fixed64,6,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	InFlight         uint64  `protobuf:"varint,7,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	Reads            uint64  `protobuf:"varint,8,opt,name=reads,proto3" json:"reads,omitempty"`
+	Writes           uint64  `protobuf:"varint,9,opt,name=writes,proto3" json:"writes,omitempty"`
+	ReadBytes        uint64  `protobuf:"varint,10,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes       uint64  `protobuf:"varint,11,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+}
+
+func (x *GuestInfo_DiskStat) Reset() {
+	*x = GuestInfo_DiskStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_DiskStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_DiskStat) ProtoMessage() {}
+
+func (x *GuestInfo_DiskStat) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_DiskStat.ProtoReflect.Descriptor instead.
+func (*GuestInfo_DiskStat) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 7}
+}
+
+func (x *GuestInfo_DiskStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuestInfo_DiskStat) GetReadsPerSec() float64 {
+	if x != nil {
+		return x.ReadsPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetWritesPerSec() float64 {
+	if x != nil {
+		return x.WritesPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetReadBytesPerSec() float64 {
+	if x != nil {
+		return x.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetWriteBytesPerSec() float64 {
+	if x != nil {
+		return x.WriteBytesPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetInFlight() uint64 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetReads() uint64 {
+	if x != nil {
+		return x.Reads
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetWrites() uint64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *GuestInfo_DiskStat) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+type GuestInfo_NetDevStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RxBytesPerSec   float64 `protobuf:"fixed64,2,opt,name=rx_bytes_per_sec,json=rxBytesPerSec,proto3" json:"rx_bytes_per_sec,omitempty"`
+	TxBytesPerSec   float64 `protobuf:"fixed64,3,opt,name=tx_bytes_per_sec,json=txBytesPerSec,proto3" json:"tx_bytes_per_sec,omitempty"`
+	RxPacketsPerSec float64 `protobuf:"fixed64,4,opt,name=rx_packets_per_sec,json=rxPacketsPerSec,proto3" json:"rx_packets_per_sec,omitempty"`
+	TxPacketsPerSec float64 `protobuf:"fixed64,5,opt,name=tx_packets_per_sec,json=txPacketsPerSec,proto3" json:"tx_packets_per_sec,omitempty"`
+	RxBytes         uint64  `protobuf:"varint,6,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes         uint64  `protobuf:"varint,7,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	RxPackets       uint64  `protobuf:"varint,8,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	TxPackets       uint64  `protobuf:"varint,9,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	RxErrors        uint64  `protobuf:"varint,10,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	TxErrors        uint64  `protobuf:"varint,11,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	RxDropped       uint64  `protobuf:"varint,12,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
+	TxDropped       uint64  `protobuf:"varint,13,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
+}
+
+func (x *GuestInfo_NetDevStat) Reset() {
+	*x = GuestInfo_NetDevStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_NetDevStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_NetDevStat) ProtoMessage() {}
+
+func (x *GuestInfo_NetDevStat) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_NetDevStat.ProtoReflect.Descriptor instead.
+func (*GuestInfo_NetDevStat) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 8}
+}
+
+func (x *GuestInfo_NetDevStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuestInfo_NetDevStat) GetRxBytesPerSec() float64 {
+	if x != nil {
+		return x.RxBytesPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetTxBytesPerSec() float64 {
+	if x != nil {
+		return x.TxBytesPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetRxPacketsPerSec() float64 {
+	if x != nil {
+		return x.RxPacketsPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetTxPacketsPerSec() float64 {
+	if x != nil {
+		return x.TxPacketsPerSec
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetRxDropped() uint64 {
+	if x != nil {
+		return x.RxDropped
+	}
+	return 0
+}
+
+func (x *GuestInfo_NetDevStat) GetTxDropped() uint64 {
+	if x != nil {
+		return x.TxDropped
+	}
+	return 0
+}
+
+type GuestInfo_PressureStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resource   string  `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	SomeAvg10  float64 `protobuf:"fixed64,2,opt,name=some_avg10,json=someAvg10,proto3" json:"some_avg10,omitempty"`
+	SomeAvg60  float64 `protobuf:"fixed64,3,opt,name=some_avg60,json=someAvg60,proto3" json:"some_avg60,omitempty"`
+	SomeAvg300 float64 `protobuf:"fixed64,4,opt,name=some_avg300,json=someAvg300,proto3" json:"some_avg300,omitempty"`
+	SomeTotal  uint64  `protobuf:"varint,5,opt,name=some_total,json=someTotal,proto3" json:"some_total,omitempty"`
+	FullAvg10  float64 `protobuf:"fixed64,6,opt,name=full_avg10,json=fullAvg10,proto3" json:"full_avg10,omitempty"`
+	FullAvg60  float64 `protobuf:"fixed64,7,opt,name=full_avg60,json=fullAvg60,proto3" json:"full_avg60,omitempty"`
+	FullAvg300 float64 `protobuf:"fixed64,8,opt,name=full_avg300,json=fullAvg300,proto3" json:"full_avg300,omitempty"`
+	FullTotal  uint64  `protobuf:"varint,9,opt,name=full_total,json=fullTotal,proto3" json:"full_total,omitempty"`
+}
+
+func (x *GuestInfo_PressureStat) Reset() {
+	*x = GuestInfo_PressureStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuestInfo_PressureStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInfo_PressureStat) ProtoMessage() {}
+
+func (x *GuestInfo_PressureStat) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInfo_PressureStat.ProtoReflect.Descriptor instead.
+func (*GuestInfo_PressureStat) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{1, 9}
+}
+
+func (x *GuestInfo_PressureStat) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *GuestInfo_PressureStat) GetSomeAvg10() float64 {
+	if x != nil {
+		return x.SomeAvg10
+	}
+	return 0
+}
+
+func (x *GuestInfo_PressureStat) GetSomeAvg60() float64 {
+	if x != nil {
+		return x.SomeAvg60
+	}
+	return 0
+}
+
+func (x *GuestInfo_PressureStat) GetSomeAvg300() float64 {
+	if x != nil {
+		return x.SomeAvg300
+	}
+	return 0
+}
+
+func (x *GuestInfo_PressureStat) GetSomeTotal() uint64 {
+	if x != nil {
+		return x.SomeTotal
+	}
+	return 0
+}
+
+func (x *GuestInfo_PressureStat) GetFullAvg10() float64 {
+	if x != nil {
+		return x.FullAvg10
+	}
+	return 0
+}
+
+func (x *GuestInfo_PressureStat) GetFullAvg60() float64 {
+	if x != nil {
+		return x.FullAvg60
+	}
+	return 0
+}
+
+func (x *GuestInfo_PressureStat) GetFullAvg300() float64 {
+	if x != nil {
+		return x.FullAvg300
+	}
+	return 0
+}
+
+func (x *GuestInfo_PressureStat) GetFullTotal() uint64 {
+	if x != nil {
+		return x.FullTotal
+	}
+	return 0
+}
+
+type InterfaceInfo_Statistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RxBytes   uint64 `protobuf:"varint,1,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	RxPackets uint64 `protobuf:"varint,2,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	RxErrors  uint64 `protobuf:"varint,3,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	RxDropped uint64 `protobuf:"varint,4,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
+	TxBytes   uint64 `protobuf:"varint,5,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	TxPackets uint64 `protobuf:"varint,6,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	TxErrors  uint64 `protobuf:"varint,7,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	TxDropped uint64 `protobuf:"varint,8,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
+}
+
+func (x *InterfaceInfo_Statistics) Reset() {
+	*x = InterfaceInfo_Statistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceInfo_Statistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceInfo_Statistics) ProtoMessage() {}
+
+func (x *InterfaceInfo_Statistics) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceInfo_Statistics.ProtoReflect.Descriptor instead.
+func (*InterfaceInfo_Statistics) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *InterfaceInfo_Statistics) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *InterfaceInfo_Statistics) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *InterfaceInfo_Statistics) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceInfo_Statistics) GetRxDropped() uint64 {
+	if x != nil {
+		return x.RxDropped
+	}
+	return 0
+}
+
+func (x *InterfaceInfo_Statistics) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *InterfaceInfo_Statistics) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *InterfaceInfo_Statistics) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceInfo_Statistics) GetTxDropped() uint64 {
+	if x != nil {
+		return x.TxDropped
+	}
+	return 0
+}
+
+type Socket_Process struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid  uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Socket_Process) Reset() {
+	*x = Socket_Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Socket_Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Socket_Process) ProtoMessage() {}
+
+func (x *Socket_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Socket_Process.ProtoReflect.Descriptor instead.
+func (*Socket_Process) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *Socket_Process) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Socket_Process) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FileStat_Owner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UID  uint32 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *FileStat_Owner) Reset() {
+	*x = FileStat_Owner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileStat_Owner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileStat_Owner) ProtoMessage() {}
+
+func (x *FileStat_Owner) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileStat_Owner.ProtoReflect.Descriptor instead.
+func (*FileStat_Owner) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *FileStat_Owner) GetUID() uint32 {
+	if x != nil {
+		return x.UID
+	}
+	return 0
+}
+
+func (x *FileStat_Owner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FileStat_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GID  uint32 `protobuf:"varint,1,opt,name=gid,proto3" json:"gid,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *FileStat_Group) Reset() {
+	*x = FileStat_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileStat_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileStat_Group) ProtoMessage() {}
+
+func (x *FileStat_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileStat_Group.ProtoReflect.Descriptor instead.
+func (*FileStat_Group) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *FileStat_Group) GetGID() uint32 {
+	if x != nil {
+		return x.GID
+	}
+	return 0
+}
+
+func (x *FileStat_Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TracerouteHop_Probe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	RttUs uint64 `protobuf:"varint,2,opt,name=rtt_us,json=rttUs,proto3" json:"rtt_us,omitempty"`
+	Lost  bool   `protobuf:"varint,3,opt,name=lost,proto3" json:"lost,omitempty"`
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TracerouteHop_Probe) Reset() {
+	*x = TracerouteHop_Probe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_agent_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracerouteHop_Probe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracerouteHop_Probe) ProtoMessage() {}
+
+func (x *TracerouteHop_Probe) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_agent_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracerouteHop_Probe.ProtoReflect.Descriptor instead.
+func (*TracerouteHop_Probe) Descriptor() ([]byte, []int) {
+	return file_types_v2_agent_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *TracerouteHop_Probe) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TracerouteHop_Probe) GetRttUs() uint64 {
+	if x != nil {
+		return x.RttUs
+	}
+	return 0
+}
+
+func (x *TracerouteHop_Probe) GetLost() bool {
+	if x != nil {
+		return x.Lost
+	}
+	return false
+}
+
+func (x *TracerouteHop_Probe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_types_v2_agent_proto protoreflect.FileDescriptor
+
+var file_types_v2_agent_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x22, 0xc0, 0x02, 0x0a, 0x09, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x40, 0x0a,
+	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a,
+	0xb9, 0x01, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x73, 0x68, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x54, 0x63, 0x70,
+	0x12, 0x29, 0x0a, 0x10, 0x73, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x79, 0x73, 0x63,
+	0x74, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xa5, 0x18, 0x0a, 0x09,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x05, 0x75, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x74, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x75,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x07,
+	0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x67, 0x12,
+	0x35, 0x0a, 0x03, 0x6d, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x03, 0x6d, 0x65, 0x6d, 0x12, 0x38, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70,
+	0x12, 0x3c, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4c, 0x6f, 0x67,
+	0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x4c,
+	0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x03,
+	0x63, 0x70, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x52, 0x03,
+	0x63, 0x70, 0x75, 0x12, 0x37, 0x0a, 0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43,
+	0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x63, 0x70, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x05,
+	0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x64,
+	0x65, 0x76, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65,
+	0x1a, 0xad, 0x01, 0x0a, 0x07, 0x55, 0x74, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x79, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x79, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65,
+	0x1a, 0x4d, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6f, 0x6e,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x66, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x1a,
+	0xe2, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x72, 0x74, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x69, 0x72, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x61, 0x62,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x61, 0x62, 0x12, 0x28, 0x0a, 0x10,
+	0x68, 0x75, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x1a, 0x34, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x1a, 0x6b, 0x0a, 0x0a, 0x4c, 0x6f,
+	0x67, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xa3, 0x02, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x1a, 0xcb, 0x01,
+	0x0a, 0x07, 0x43, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x6e, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x64, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x72, 0x71,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x72, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x6f,
+	0x66, 0x74, 0x69, 0x72, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x1a, 0xf1, 0x02, 0x0a, 0x08,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x12, 0x24, 0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a,
+	0xb8, 0x03, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x10, 0x74,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74,
+	0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x74, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x1a, 0xa6, 0x02, 0x0a, 0x0c, 0x50,
+	0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f,
+	0x61, 0x76, 0x67, 0x31, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x6f, 0x6d,
+	0x65, 0x41, 0x76, 0x67, 0x31, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x61,
+	0x76, 0x67, 0x36, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65,
+	0x41, 0x76, 0x67, 0x36, 0x30, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x61, 0x76,
+	0x67, 0x33, 0x30, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x6f, 0x6d, 0x65,
+	0x41, 0x76, 0x67, 0x33, 0x30, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x76,
+	0x67, 0x31, 0x30, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x41,
+	0x76, 0x67, 0x31, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x76, 0x67,
+	0x36, 0x30, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x76,
+	0x67, 0x36, 0x30, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x76, 0x67, 0x33,
+	0x30, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x76,
+	0x67, 0x33, 0x30, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x78,
+	0x74, 0x48, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x67, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x77,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x22, 0x96, 0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x72, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x67, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6d, 0x74, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x3c, 0x0a, 0x09, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x52, 0x09,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x74, 0x68, 0x22, 0x82, 0x02, 0x0a, 0x08, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x69, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x69, 0x66, 0x12, 0x10,
+	0x0a, 0x03, 0x6f, 0x69, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x69, 0x66,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x73, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x22, 0xd7,
+	0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49,
+	0x6e, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x70, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x22, 0x9f, 0x05, 0x0a, 0x0d, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x78, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x6c, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x78, 0x71, 0x75, 0x65, 0x75, 0x65, 0x6c, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x1a,
+	0xf8, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x74, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x13, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x71, 0x6c, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x71, 0x6c,
+	0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x0a, 0x54, 0x62, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x73, 0x22,
+	0x34, 0x0a, 0x0a, 0x48, 0x74, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x63, 0x65, 0x69, 0x6c, 0x22, 0x74, 0x0a, 0x0e, 0x46, 0x71, 0x43, 0x6f, 0x64, 0x65, 0x6c,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x55, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x5f, 0x65, 0x63, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x45, 0x63, 0x6e, 0x22, 0xd6, 0x01, 0x0a, 0x0c,
+	0x4e, 0x65, 0x74, 0x65, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x55, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x07, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x09, 0x51, 0x64, 0x69, 0x73, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x62, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03,
+	0x74, 0x62, 0x66, 0x12, 0x3b, 0x0a, 0x08, 0x66, 0x71, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x71, 0x43, 0x6f, 0x64, 0x65, 0x6c,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x66, 0x71, 0x43, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x34, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x63, 0x65, 0x69, 0x6c, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49,
+	0x64, 0x22, 0xf3, 0x02, 0x0a, 0x06, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x72, 0x65, 0x63, 0x76, 0x51, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x6e, 0x64, 0x51, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x67, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x2f, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73,
+	0x44, 0x69, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x67, 0x61,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x1a, 0x2d, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x1a, 0x2d, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x67, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xec, 0x01, 0x0a, 0x0e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x67, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x85, 0x02, 0x0a, 0x0b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x76, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x76, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6c, 0x76, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69,
+	0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x76, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x6d,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6d, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x98, 0x01, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x72, 0x74, 0x74, 0x55, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x74, 0x74, 0x5f,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x52, 0x74, 0x74,
+	0x55, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x74, 0x74, 0x5f, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x76, 0x67, 0x52, 0x74, 0x74, 0x55, 0x73,
+	0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x74, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x52, 0x74, 0x74, 0x55, 0x73, 0x22, 0x80,
+	0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x72, 0x74, 0x74, 0x55, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
+	0x72, 0x74, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x52, 0x74, 0x74, 0x55, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x74,
+	0x74, 0x5f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x76, 0x67, 0x52,
+	0x74, 0x74, 0x55, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x74, 0x74, 0x5f,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x52, 0x74, 0x74,
+	0x55, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x48, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x48, 0x6f, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x06, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x73, 0x1a, 0x5c, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x72, 0x74, 0x74, 0x55, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x53, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x2a, 0x3b, 0x0a, 0x0a, 0x49, 0x6e, 0x65, 0x74,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x46, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x46,
+	0x5f, 0x49, 0x4e, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x46, 0x5f, 0x49, 0x4e,
+	0x45, 0x54, 0x36, 0x10, 0x0a, 0x2a, 0x67, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49,
+	0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45,
+	0x5f, 0x53, 0x49, 0x54, 0x45, 0x10, 0xc8, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50,
+	0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f,
+	0x50, 0x45, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0xfe, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10, 0xff, 0x01, 0x2a, 0xc8,
+	0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a,
+	0x52, 0x54, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x52, 0x54, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x52, 0x54, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x52, 0x54, 0x4e, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12,
+	0x0f, 0x0a, 0x0b, 0x52, 0x54, 0x4e, 0x5f, 0x41, 0x4e, 0x59, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04,
+	0x12, 0x11, 0x0a, 0x0d, 0x52, 0x54, 0x4e, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53,
+	0x54, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x54, 0x4e, 0x5f, 0x42, 0x4c, 0x41, 0x43, 0x4b,
+	0x48, 0x4f, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x54, 0x4e, 0x5f, 0x55, 0x4e,
+	0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x52,
+	0x54, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x10, 0x08, 0x12, 0x0d, 0x0a,
+	0x09, 0x52, 0x54, 0x4e, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x57, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x54, 0x4e, 0x5f, 0x4e, 0x41, 0x54, 0x10, 0x0a, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x70,
+	0x68, 0x6f, 0x65, 0x6e, 0x69, 0x78, 0x2d, 0x67, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x3b,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_v2_agent_proto_rawDescOnce sync.Once
+	file_types_v2_agent_proto_rawDescData = file_types_v2_agent_proto_rawDesc
+)
+
+func file_types_v2_agent_proto_rawDescGZIP() []byte {
+	file_types_v2_agent_proto_rawDescOnce.Do(func() {
+		file_types_v2_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_v2_agent_proto_rawDescData)
+	})
+	return file_types_v2_agent_proto_rawDescData
+}
+
+var file_types_v2_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_types_v2_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_types_v2_agent_proto_goTypes = []interface{}{
+	(InetFamily)(0),                  // 0: pga.api.types.v2.InetFamily
+	(RouteScope)(0),                  // 1: pga.api.types.v2.RouteScope
+	(RouteType)(0),                   // 2: pga.api.types.v2.RouteType
+	(*AgentInfo)(nil),                // 3: pga.api.types.v2.AgentInfo
+	(*GuestInfo)(nil),                // 4: pga.api.types.v2.GuestInfo
+	(*RouteNextHop)(nil),             // 5: pga.api.types.v2.RouteNextHop
+	(*RouteInfo)(nil),                // 6: pga.api.types.v2.RouteInfo
+	(*RuleInfo)(nil),                 // 7: pga.api.types.v2.RuleInfo
+	(*NeighborInfo)(nil),             // 8: pga.api.types.v2.NeighborInfo
+	(*InterfaceInfo)(nil),            // 9: pga.api.types.v2.InterfaceInfo
+	(*TrafficControlStats)(nil),      // 10: pga.api.types.v2.TrafficControlStats
+	(*TbfOptions)(nil),               // 11: pga.api.types.v2.TbfOptions
+	(*HtbOptions)(nil),               // 12: pga.api.types.v2.HtbOptions
+	(*FqCodelOptions)(nil),           // 13: pga.api.types.v2.FqCodelOptions
+	(*NetemOptions)(nil),             // 14: pga.api.types.v2.NetemOptions
+	(*QdiscInfo)(nil),                // 15: pga.api.types.v2.QdiscInfo
+	(*TrafficClassInfo)(nil),         // 16: pga.api.types.v2.TrafficClassInfo
+	(*TrafficFilterInfo)(nil),        // 17: pga.api.types.v2.TrafficFilterInfo
+	(*Socket)(nil),                   // 18: pga.api.types.v2.Socket
+	(*FileStat)(nil),                 // 19: pga.api.types.v2.FileStat
+	(*PhysicalVolume)(nil),           // 20: pga.api.types.v2.PhysicalVolume
+	(*VolumeGroup)(nil),              // 21: pga.api.types.v2.VolumeGroup
+	(*LogicalVolume)(nil),            // 22: pga.api.types.v2.LogicalVolume
+	(*EncryptedVolume)(nil),          // 23: pga.api.types.v2.EncryptedVolume
+	(*PingReply)(nil),                // 24: pga.api.types.v2.PingReply
+	(*PingStats)(nil),                // 25: pga.api.types.v2.PingStats
+	(*ConnectProbe)(nil),             // 26: pga.api.types.v2.ConnectProbe
+	(*ConnectStats)(nil),             // 27: pga.api.types.v2.ConnectStats
+	(*TracerouteHop)(nil),            // 28: pga.api.types.v2.TracerouteHop
+	(*TracerouteStats)(nil),          // 29: pga.api.types.v2.TracerouteStats
+	(*AgentInfo_Features)(nil),       // 30: pga.api.types.v2.AgentInfo.Features
+	(*GuestInfo_Utsname)(nil),        // 31: pga.api.types.v2.GuestInfo.Utsname
+	(*GuestInfo_LoadAverage)(nil),    // 32: pga.api.types.v2.GuestInfo.LoadAverage
+	(*GuestInfo_MemStat)(nil),        // 33: pga.api.types.v2.GuestInfo.MemStat
+	(*GuestInfo_SwapStat)(nil),       // 34: pga.api.types.v2.GuestInfo.SwapStat
+	(*GuestInfo_LoggedUser)(nil),     // 35: pga.api.types.v2.GuestInfo.LoggedUser
+	(*GuestInfo_BlockDevice)(nil),    // 36: pga.api.types.v2.GuestInfo.BlockDevice
+	(*GuestInfo_CPUStat)(nil),        // 37: pga.api.types.v2.GuestInfo.CPUStat
+	(*GuestInfo_DiskStat)(nil),       // 38: pga.api.types.v2.GuestInfo.DiskStat
+	(*GuestInfo_NetDevStat)(nil),     // 39: pga.api.types.v2.GuestInfo.NetDevStat
+	(*GuestInfo_PressureStat)(nil),   // 40: pga.api.types.v2.GuestInfo.PressureStat
+	(*InterfaceInfo_Statistics)(nil), // 41: pga.api.types.v2.InterfaceInfo.Statistics
+	(*Socket_Process)(nil),           // 42: pga.api.types.v2.Socket.Process
+	(*FileStat_Owner)(nil),           // 43: pga.api.types.v2.FileStat.Owner
+	(*FileStat_Group)(nil),           // 44: pga.api.types.v2.FileStat.Group
+	(*TracerouteHop_Probe)(nil),      // 45: pga.api.types.v2.TracerouteHop.Probe
+}
+var file_types_v2_agent_proto_depIdxs = []int32{
+	30, // 0: pga.api.types.v2.AgentInfo.features:type_name -> pga.api.types.v2.AgentInfo.Features
+	31, // 1: pga.api.types.v2.GuestInfo.uname:type_name -> pga.api.types.v2.GuestInfo.Utsname
+	32, // 2: pga.api.types.v2.GuestInfo.loadavg:type_name -> pga.api.types.v2.GuestInfo.LoadAverage
+	33, // 3: pga.api.types.v2.GuestInfo.mem:type_name -> pga.api.types.v2.GuestInfo.MemStat
+	34, // 4: pga.api.types.v2.GuestInfo.swap:type_name -> pga.api.types.v2.GuestInfo.SwapStat
+	35, // 5: pga.api.types.v2.GuestInfo.users:type_name -> pga.api.types.v2.GuestInfo.LoggedUser
+	36, // 6: pga.api.types.v2.GuestInfo.block_devices:type_name -> pga.api.types.v2.GuestInfo.BlockDevice
+	37, // 7: pga.api.types.v2.GuestInfo.cpu:type_name -> pga.api.types.v2.GuestInfo.CPUStat
+	37, // 8: pga.api.types.v2.GuestInfo.cpus:type_name -> pga.api.types.v2.GuestInfo.CPUStat
+	38, // 9: pga.api.types.v2.GuestInfo.disks:type_name -> pga.api.types.v2.GuestInfo.DiskStat
+	39, // 10: pga.api.types.v2.GuestInfo.netdevs:type_name -> pga.api.types.v2.GuestInfo.NetDevStat
+	40, // 11: pga.api.types.v2.GuestInfo.pressure:type_name -> pga.api.types.v2.GuestInfo.PressureStat
+	1,  // 12: pga.api.types.v2.RouteInfo.scope:type_name -> pga.api.types.v2.RouteScope
+	2,  // 13: pga.api.types.v2.RouteInfo.type:type_name -> pga.api.types.v2.RouteType
+	5,  // 14: pga.api.types.v2.RouteInfo.multipath:type_name -> pga.api.types.v2.RouteNextHop
+	0,  // 15: pga.api.types.v2.RuleInfo.family:type_name -> pga.api.types.v2.InetFamily
+	0,  // 16: pga.api.types.v2.NeighborInfo.family:type_name -> pga.api.types.v2.InetFamily
+	41, // 17: pga.api.types.v2.InterfaceInfo.stats:type_name -> pga.api.types.v2.InterfaceInfo.Statistics
+	10, // 18: pga.api.types.v2.QdiscInfo.stats:type_name -> pga.api.types.v2.TrafficControlStats
+	11, // 19: pga.api.types.v2.QdiscInfo.tbf:type_name -> pga.api.types.v2.TbfOptions
+	13, // 20: pga.api.types.v2.QdiscInfo.fq_codel:type_name -> pga.api.types.v2.FqCodelOptions
+	14, // 21: pga.api.types.v2.QdiscInfo.netem:type_name -> pga.api.types.v2.NetemOptions
+	10, // 22: pga.api.types.v2.TrafficClassInfo.stats:type_name -> pga.api.types.v2.TrafficControlStats
+	42, // 23: pga.api.types.v2.Socket.processes:type_name -> pga.api.types.v2.Socket.Process
+	43, // 24: pga.api.types.v2.FileStat.owner:type_name -> pga.api.types.v2.FileStat.Owner
+	44, // 25: pga.api.types.v2.FileStat.group:type_name -> pga.api.types.v2.FileStat.Group
+	45, // 26: pga.api.types.v2.TracerouteHop.probes:type_name -> pga.api.types.v2.TracerouteHop.Probe
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_types_v2_agent_proto_init() }
+func file_types_v2_agent_proto_init() {
+	if File_types_v2_agent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_v2_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuestInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteNextHop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficControlStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TbfOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HtbOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FqCodelOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetemOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QdiscInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficClassInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficFilterInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Socket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhysicalVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogicalVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FileStat_Group.ProtoReflect.Descriptor instead.
-func (*FileStat_Group) Descriptor() ([]byte, []int) {
-	return file_types_v2_agent_proto_rawDescGZIP(), []int{4, 1}
-}
-
-func (x *FileStat_Group) GetGID() uint32 {
-	if x != nil {
-		return x.GID
-	}
-	return 0
-}
-
-func (x *FileStat_Group) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-var File_types_v2_agent_proto protoreflect.FileDescriptor
-
-var file_types_v2_agent_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x22, 0x95, 0x02, 0x0a, 0x09, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x40, 0x0a,
-	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x76, 0x32, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x46, 0x65, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a,
-	0x8e, 0x01, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x73, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53, 0x73, 0x68, 0x12,
-	0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x54, 0x63, 0x70,
-	0x22, 0xed, 0x09, 0x0a, 0x09, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39,
-	0x0a, 0x05, 0x75, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x74, 0x73, 0x6e, 0x61,
-	0x6d, 0x65, 0x52, 0x05, 0x75, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
-	0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x61,
-	0x64, 0x61, 0x76, 0x67, 0x12, 0x35, 0x0a, 0x03, 0x6d, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d,
-	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x03, 0x6d, 0x65, 0x6d, 0x12, 0x38, 0x0a, 0x04, 0x73,
-	0x77, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x67, 0x61, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x52,
-	0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x3c, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x67, 0x61,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x1a, 0xad, 0x01, 0x0a, 0x07, 0x55, 0x74, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x79, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x73, 0x79, 0x73, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d,
-	0x65, 0x1a, 0x4d, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6f,
-	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x04, 0x66, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e,
-	0x1a, 0x84, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65,
-	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72,
-	0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x34, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x1a, 0x6b, 0x0a,
-	0x0a, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xa3, 0x02, 0x0a, 0x0b, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x73, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69,
-	0x7a, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x73, 0x69, 0x7a, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6f,
-	0x64, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x22, 0xc5, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x63,
-	0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x67, 0x61, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
-	0x72, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x67, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0xb6, 0x02, 0x0a, 0x08,
-	0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x36, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x70, 0x67, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
-	0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a,
-	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x2d, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x2d, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10,
-	0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x67, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x3b, 0x0a, 0x0a, 0x49, 0x6e, 0x65, 0x74, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x46, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x46, 0x5f, 0x49, 0x4e, 0x45,
-	0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x46, 0x5f, 0x49, 0x4e, 0x45, 0x54, 0x36, 0x10,
-	0x0a, 0x2a, 0x67, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x0e, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x56, 0x45, 0x52, 0x53,
-	0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x54,
-	0x45, 0x10, 0xc8, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4c, 0x49,
-	0x4e, 0x4b, 0x10, 0xfd, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x48,
-	0x4f, 0x53, 0x54, 0x10, 0xfe, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f,
-	0x4e, 0x4f, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10, 0xff, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f,
-	0x70, 0x68, 0x6f, 0x65, 0x6e, 0x69, 0x78, 0x2d, 0x67, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32,
-	0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_types_v2_agent_proto_rawDescOnce sync.Once
-	file_types_v2_agent_proto_rawDescData = file_types_v2_agent_proto_rawDesc
-)
-
-func file_types_v2_agent_proto_rawDescGZIP() []byte {
-	file_types_v2_agent_proto_rawDescOnce.Do(func() {
-		file_types_v2_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_v2_agent_proto_rawDescData)
-	})
-	return file_types_v2_agent_proto_rawDescData
-}
-
-var file_types_v2_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_types_v2_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_types_v2_agent_proto_goTypes = []interface{}{
-	(InetFamily)(0),               // 0: pga.api.types.v2.InetFamily
-	(RouteScope)(0),               // 1: pga.api.types.v2.RouteScope
-	(*AgentInfo)(nil),             // 2: pga.api.types.v2.AgentInfo
-	(*GuestInfo)(nil),             // 3: pga.api.types.v2.GuestInfo
-	(*RouteInfo)(nil),             // 4: pga.api.types.v2.RouteInfo
-	(*InterfaceInfo)(nil),         // 5: pga.api.types.v2.InterfaceInfo
-	(*FileStat)(nil),              // 6: pga.api.types.v2.FileStat
-	(*AgentInfo_Features)(nil),    // 7: pga.api.types.v2.AgentInfo.Features
-	(*GuestInfo_Utsname)(nil),     // 8: pga.api.types.v2.GuestInfo.Utsname
-	(*GuestInfo_LoadAverage)(nil), // 9: pga.api.types.v2.GuestInfo.LoadAverage
-	(*GuestInfo_MemStat)(nil),     // 10: pga.api.types.v2.GuestInfo.MemStat
-	(*GuestInfo_SwapStat)(nil),    // 11: pga.api.types.v2.GuestInfo.SwapStat
-	(*GuestInfo_LoggedUser)(nil),  // 12: pga.api.types.v2.GuestInfo.LoggedUser
-	(*GuestInfo_BlockDevice)(nil), // 13: pga.api.types.v2.GuestInfo.BlockDevice
-	(*FileStat_Owner)(nil),        // 14: pga.api.types.v2.FileStat.Owner
-	(*FileStat_Group)(nil),        // 15: pga.api.types.v2.FileStat.Group
-}
-var file_types_v2_agent_proto_depIdxs = []int32{
-	7,  // 0: pga.api.types.v2.AgentInfo.features:type_name -> pga.api.types.v2.AgentInfo.Features
-	8,  // 1: pga.api.types.v2.GuestInfo.uname:type_name -> pga.api.types.v2.GuestInfo.Utsname
-	9,  // 2: pga.api.types.v2.GuestInfo.loadavg:type_name -> pga.api.types.v2.GuestInfo.LoadAverage
-	10, // 3: pga.api.types.v2.GuestInfo.mem:type_name -> pga.api.types.v2.GuestInfo.MemStat
-	11, // 4: pga.api.types.v2.GuestInfo.swap:type_name -> pga.api.types.v2.GuestInfo.SwapStat
-	12, // 5: pga.api.types.v2.GuestInfo.users:type_name -> pga.api.types.v2.GuestInfo.LoggedUser
-	13, // 6: pga.api.types.v2.GuestInfo.block_devices:type_name -> pga.api.types.v2.GuestInfo.BlockDevice
-	1,  // 7: pga.api.types.v2.RouteInfo.scope:type_name -> pga.api.types.v2.RouteScope
-	14, // 8: pga.api.types.v2.FileStat.owner:type_name -> pga.api.types.v2.FileStat.Owner
-	15, // 9: pga.api.types.v2.FileStat.group:type_name -> pga.api.types.v2.FileStat.Group
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
-}
-
-func init() { file_types_v2_agent_proto_init() }
-func file_types_v2_agent_proto_init() {
-	if File_types_v2_agent_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_types_v2_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentInfo); i {
+		file_types_v2_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1426,8 +5108,8 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GuestInfo); i {
+		file_types_v2_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1438,8 +5120,8 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteInfo); i {
+		file_types_v2_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1450,8 +5132,8 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterfaceInfo); i {
+		file_types_v2_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1462,8 +5144,8 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileStat); i {
+		file_types_v2_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerouteHop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1474,7 +5156,19 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerouteStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AgentInfo_Features); i {
 			case 0:
 				return &v.state
@@ -1486,7 +5180,7 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GuestInfo_Utsname); i {
 			case 0:
 				return &v.state
@@ -1498,7 +5192,7 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GuestInfo_LoadAverage); i {
 			case 0:
 				return &v.state
@@ -1510,7 +5204,7 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GuestInfo_MemStat); i {
 			case 0:
 				return &v.state
@@ -1522,7 +5216,7 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GuestInfo_SwapStat); i {
 			case 0:
 				return &v.state
@@ -1534,7 +5228,7 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GuestInfo_LoggedUser); i {
 			case 0:
 				return &v.state
@@ -1546,7 +5240,7 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GuestInfo_BlockDevice); i {
 			case 0:
 				return &v.state
@@ -1558,7 +5252,79 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuestInfo_CPUStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuestInfo_DiskStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuestInfo_NetDevStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuestInfo_PressureStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceInfo_Statistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Socket_Process); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileStat_Owner); i {
 			case 0:
 				return &v.state
@@ -1570,7 +5336,7 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
-		file_types_v2_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_types_v2_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileStat_Group); i {
 			case 0:
 				return &v.state
@@ -1582,14 +5348,26 @@ func file_types_v2_agent_proto_init() {
 				return nil
 			}
 		}
+		file_types_v2_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerouteHop_Probe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v2_agent_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   14,
+			NumEnums:      3,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"io"
+	"os"
+
+	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
+
+	pb_diagnostics "github.com/0xef53/phoenix-guest-agent/api/services/diagnostics/v2"
+)
+
+// recvAll prints the stream messages until the stream is closed.
+func recvAll[T any](recv func() (T, error)) error {
+	for {
+		msg, err := recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := PrintJSON(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *client) Ping(ctx context.Context, req *pb_diagnostics.PingRequest) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		stream, err := grpcClient.Diagnostics().Ping(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return recvAll(stream.Recv)
+	})
+}
+
+func (c *client) LookupDNS(ctx context.Context, name, qtype string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Diagnostics().LookupDNS(ctx, &pb_diagnostics.LookupDNSRequest{Name: name, Type: qtype})
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ProbeConnect(ctx context.Context, req *pb_diagnostics.ProbeConnectRequest) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		stream, err := grpcClient.Diagnostics().ProbeConnect(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return recvAll(stream.Recv)
+	})
+}
+
+func (c *client) Traceroute(ctx context.Context, req *pb_diagnostics.TracerouteRequest) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		stream, err := grpcClient.Diagnostics().Traceroute(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return recvAll(stream.Recv)
+	})
+}
+
+// CapturePackets writes the captured packets in the pcapng format
+// to the file or to stdout if the file name is "-".
+func (c *client) CapturePackets(ctx context.Context, req *pb_diagnostics.CapturePacketsRequest, output string) error {
+	var w io.Writer = os.Stdout
+
+	if output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		stream, err := grpcClient.Diagnostics().CapturePackets(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+
+				return err
+			}
+
+			if _, err := w.Write(resp.Data); err != nil {
+				return err
+			}
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
+
+	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+)
+
+// ShowFirewallRuleset prints the nftables ruleset of the guest in JSON format.
+func (c *client) ShowFirewallRuleset(ctx context.Context) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().GetFirewallRuleset(ctx, new(empty.Empty))
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(resp.Ruleset)
+
+		return nil
+	})
+}
+
+// ApplyFirewallRuleset reads the ruleset of the agent table in the nftables
+// JSON format from the file (or from stdin if fname is "-") and applies it.
+// The change must be confirmed within the timeout (in seconds),
+// otherwise the agent restores the previous content of the table.
+func (c *client) ApplyFirewallRuleset(ctx context.Context, fname string, timeout uint32) error {
+	var b []byte
+	var err error
+
+	if fname == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(fname)
+	}
+	if err != nil {
+		return err
+	}
+
+	req := pb_agent.ApplyFirewallRulesetRequest{
+		Ruleset: string(b),
+		Timeout: timeout,
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ApplyFirewallRuleset(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ConfirmFirewallRuleset(ctx context.Context, id string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		_, err := grpcClient.Network().ConfirmFirewallRuleset(ctx, &pb_agent.ConfirmFirewallRulesetRequest{Id: id})
+
+		return err
+	})
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"io"
+	"os"
+
+	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
+
+	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ApplyNetworkConfig reads the desired network state in JSON format
+// from the file (or from stdin if fname is "-") and applies it.
+// The change must be confirmed within the timeout (in seconds),
+// otherwise the agent restores the previous state.
+func (c *client) ApplyNetworkConfig(ctx context.Context, fname string, timeout uint32) error {
+	var b []byte
+	var err error
+
+	if fname == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(fname)
+	}
+	if err != nil {
+		return err
+	}
+
+	req := pb_agent.ApplyNetworkConfigRequest{}
+
+	if err := protojson.Unmarshal(b, &req); err != nil {
+		return err
+	}
+
+	if timeout > 0 {
+		req.Timeout = timeout
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ApplyNetworkConfig(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ConfirmNetworkConfig(ctx context.Context, id string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		_, err := grpcClient.Network().ConfirmNetworkConfig(ctx, &pb_agent.ConfirmNetworkConfigRequest{Id: id})
+
+		return err
+	})
+}
@@ -3,16 +3,14 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"syscall"
 
 	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
 
 	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
-	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
 
 	empty "github.com/golang/protobuf/ptypes/empty"
-
-	"github.com/vishvananda/netlink"
 )
 
 func (c *client) ShowRouteList(ctx context.Context, family string) error {
@@ -35,52 +33,94 @@ func (c *client) ShowRouteList(ctx context.Context, family string) error {
 	})
 }
 
-func (c *client) UpdateRouteTable(ctx context.Context, action, dst, gw, ifname string) error {
-	req := pb_agent.RouteRequest{
-		LinkName: ifname,
+func (c *client) UpdateRouteTable(ctx context.Context, action, family string, args []string) error {
+	req, err := ParseRouteArgs(family, args)
+	if err != nil {
+		return err
 	}
 
-	if len(gw) == 0 {
-		// link route
-		req.Scope = pb_types.RouteScope(netlink.SCOPE_LINK)
-
-		dstAddr, err := ParseIPNet(dst)
-		if err != nil {
-			return err
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) (err error) {
+		switch action {
+		case "add":
+			_, err = grpcClient.Network().AddRoute(ctx, req)
+		case "del":
+			_, err = grpcClient.Network().DelRoute(ctx, req)
+		case "replace":
+			_, err = grpcClient.Network().ReplaceRoute(ctx, req)
+		default:
+			return fmt.Errorf("invalid action: %s", action)
 		}
 
-		req.Dst = dstAddr.String()
-	} else {
-		// route via gateway
-		gwAddr, err := ParseIPNet(gw)
+		return err
+	})
+}
+
+func (c *client) ShowRuleList(ctx context.Context, family string) error {
+	req := pb_agent.ListRulesRequest{
+		Family: parseInetFamily(family),
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ListRules(ctx, &req)
 		if err != nil {
 			return err
 		}
 
-		req.Gw = gwAddr.IP.String()
+		return PrintJSON(resp)
+	})
+}
 
-		if dst == "default" {
-			if gwAddr.IP.To4() != nil {
-				req.Dst = "0.0.0.0/0"
-			} else {
-				req.Dst = "::/0"
-			}
-		} else {
-			dstAddr, err := ParseIPNet(dst)
-			if err != nil {
-				return err
-			}
+func (c *client) UpdateRuleList(ctx context.Context, action, family string, args []string) error {
+	req, err := ParseRuleArgs(args)
+	if err != nil {
+		return err
+	}
 
-			req.Dst = dstAddr.String()
+	req.Family = parseInetFamily(family)
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) (err error) {
+		switch action {
+		case "add":
+			_, err = grpcClient.Network().AddRule(ctx, req)
+		case "del":
+			_, err = grpcClient.Network().DelRule(ctx, req)
+		default:
+			return fmt.Errorf("invalid action: %s", action)
 		}
+
+		return err
+	})
+}
+
+func (c *client) ShowNeighbors(ctx context.Context, family, ifname string) error {
+	req := pb_agent.ListNeighborsRequest{
+		Family:   parseInetFamily(family),
+		LinkName: ifname,
 	}
 
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ListNeighbors(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) UpdateNeighbors(ctx context.Context, action, ipaddr, hwaddr, ifname string) error {
 	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) (err error) {
+		req := pb_agent.NeighborRequest{
+			LinkName: ifname,
+			Ip:       ipaddr,
+			HwAddr:   hwaddr,
+		}
+
 		switch action {
-		case "add":
-			_, err = grpcClient.Network().AddRoute(ctx, &req)
+		case "add", "replace":
+			_, err = grpcClient.Network().AddNeighbor(ctx, &req)
 		case "del":
-			_, err = grpcClient.Network().DelRoute(ctx, &req)
+			_, err = grpcClient.Network().DelNeighbor(ctx, &req)
 		default:
 			return fmt.Errorf("invalid action: %s", action)
 		}
@@ -89,13 +129,79 @@ func (c *client) UpdateRouteTable(ctx context.Context, action, dst, gw, ifname s
 	})
 }
 
-func (c *client) ShowInterfaces(ctx context.Context) error {
+func (c *client) FlushNeighbors(ctx context.Context, family, ifname string) error {
+	req := pb_agent.FlushNeighborsRequest{
+		Family:   parseInetFamily(family),
+		LinkName: ifname,
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().FlushNeighbors(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+// WatchNetwork prints the network events until interrupted.
+// objects may contain "link", "address", "route" and "neigh".
+// If empty, all types of events are printed.
+func (c *client) WatchNetwork(ctx context.Context, objects []string) error {
+	req := pb_agent.WatchNetworkRequest{}
+
+	for _, o := range objects {
+		switch o {
+		case "link":
+			req.Links = true
+		case "address", "addr":
+			req.Addrs = true
+		case "route":
+			req.Routes = true
+		case "neigh", "neighbor":
+			req.Neighbors = true
+		default:
+			return fmt.Errorf("unknown object: %s", o)
+		}
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		stream, err := grpcClient.Network().WatchNetwork(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+
+				return err
+			}
+
+			if err := PrintJSON(ev); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+func (c *client) ShowInterfaces(ctx context.Context, withStats bool) error {
 	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
 		resp, err := grpcClient.Network().GetInterfaces(ctx, new(empty.Empty))
 		if err != nil {
 			return err
 		}
 
+		if !withStats {
+			for _, iface := range resp.Interfaces {
+				iface.Stats = nil
+			}
+		}
+
 		return PrintJSON(resp)
 	})
 }
@@ -119,11 +225,56 @@ func (c *client) UpdateInterfaceLinkState(ctx context.Context, action, ifname st
 	})
 }
 
-func (c *client) UpdateInterfaceAddrList(ctx context.Context, action, ipcidr, ifname string) error {
+func (c *client) SetLinkAttributes(ctx context.Context, ifname string, args []string) error {
+	req, err := ParseLinkArgs(args)
+	if err != nil {
+		return err
+	}
+
+	req.LinkName = ifname
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().SetLinkAttributes(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) CreateLink(ctx context.Context, ifname string, args []string) error {
+	req, err := ParseCreateLinkArgs(args)
+	if err != nil {
+		return err
+	}
+
+	req.LinkName = ifname
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().CreateLink(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) DeleteLink(ctx context.Context, ifname string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		_, err := grpcClient.Network().DeleteLink(ctx, &pb_agent.DeleteLinkRequest{LinkName: ifname})
+
+		return err
+	})
+}
+
+func (c *client) UpdateInterfaceAddrList(ctx context.Context, action, ipcidr, ifname string, persist bool) error {
 	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) (err error) {
 		req := pb_agent.IPAddrRequest{
 			LinkName: ifname,
 			Addr:     ipcidr,
+			Persist:  persist,
 		}
 
 		switch action {
@@ -138,3 +289,21 @@ func (c *client) UpdateInterfaceAddrList(ctx context.Context, action, ipcidr, if
 		return err
 	})
 }
+
+func (c *client) ShowSockets(ctx context.Context, tcp, udp, unix, listeningOnly bool) error {
+	req := pb_agent.ListSocketsRequest{
+		Tcp:           tcp,
+		Udp:           udp,
+		Unix:          unix,
+		ListeningOnly: listeningOnly,
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ListSockets(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
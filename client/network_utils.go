@@ -1,10 +1,16 @@
 package client
 
 import (
+	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
+	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
+
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
 func ParseIPNet(s string) (*net.IPNet, error) {
@@ -18,3 +24,504 @@ func ParseIPNet(s string) (*net.IPNet, error) {
 
 	return netlink.ParseIPNet(s)
 }
+
+func parseInetFamily(family string) pb_types.InetFamily {
+	switch family {
+	case "4":
+		return pb_types.InetFamily_AF_INET
+	case "6":
+		return pb_types.InetFamily_AF_INET6
+	}
+
+	return pb_types.InetFamily_AF_UNSPECIFIED
+}
+
+var routeProtocols = map[string]uint32{
+	"redirect": unix.RTPROT_REDIRECT,
+	"kernel":   unix.RTPROT_KERNEL,
+	"boot":     unix.RTPROT_BOOT,
+	"static":   unix.RTPROT_STATIC,
+	"ra":       unix.RTPROT_RA,
+	"dhcp":     unix.RTPROT_DHCP,
+}
+
+// ParseRouteArgs converts the arguments in the ip-route(8) notation
+// into a route request:
+//
+//	[TYPE] PREFIX [via ADDR] [dev IFNAME] [src ADDR] [metric N] [table N]
+//	    [mtu N] [proto NAME|N] [onlink] [nexthop via ADDR dev IFNAME weight N] ...
+//
+// The family ("4", "6" or empty) is used to resolve the default prefix
+// when no gateway is specified.
+func ParseRouteArgs(family string, args []string) (*pb_agent.RouteRequest, error) {
+	req := pb_agent.RouteRequest{}
+
+	if len(args) > 0 {
+		if v, ok := pb_types.RouteType_value["RTN_"+strings.ToUpper(args[0])]; ok {
+			req.Type = pb_types.RouteType(v)
+			args = args[1:]
+		}
+	}
+
+	if len(args) == 0 {
+		return nil, fmt.Errorf("route prefix is not specified")
+	}
+
+	dst := args[0]
+	args = args[1:]
+
+	// Returns the value of the current keyword and shifts the arguments
+	value := func(keyword string) (string, error) {
+		if len(args) < 2 {
+			return "", fmt.Errorf("no value specified for %q", keyword)
+		}
+
+		v := args[1]
+		args = args[2:]
+
+		return v, nil
+	}
+
+	number := func(keyword string) (int32, error) {
+		v, err := value(keyword)
+		if err != nil {
+			return 0, err
+		}
+
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid value of %q: %s", keyword, v)
+		}
+
+		return int32(n), nil
+	}
+
+	var nh *pb_types.RouteNextHop
+	var err error
+
+	for len(args) > 0 {
+		switch args[0] {
+		case "via":
+			var v string
+			if v, err = value("via"); err != nil {
+				break
+			}
+
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid gateway address: %s", v)
+			}
+
+			if nh != nil {
+				nh.Gw = ip.String()
+			} else {
+				req.Gw = ip.String()
+			}
+		case "dev":
+			var v string
+			if v, err = value("dev"); err != nil {
+				break
+			}
+
+			if nh != nil {
+				nh.LinkName = v
+			} else {
+				req.LinkName = v
+			}
+		case "weight":
+			if nh == nil {
+				return nil, fmt.Errorf("weight is allowed only for nexthop")
+			}
+			nh.Weight, err = number("weight")
+		case "onlink":
+			if nh != nil {
+				nh.Onlink = true
+			} else {
+				req.Onlink = true
+			}
+			args = args[1:]
+		case "persist":
+			req.Persist = true
+			args = args[1:]
+		case "nexthop":
+			nh = new(pb_types.RouteNextHop)
+			req.Multipath = append(req.Multipath, nh)
+			args = args[1:]
+		case "src":
+			var v string
+			if v, err = value("src"); err != nil {
+				break
+			}
+
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid source address: %s", v)
+			}
+
+			req.Src = ip.String()
+		case "metric", "priority", "preference":
+			req.Priority, err = number(args[0])
+		case "table":
+			req.Table, err = number("table")
+		case "mtu":
+			req.MTU, err = number("mtu")
+		case "proto", "protocol":
+			var v string
+			if v, err = value(args[0]); err != nil {
+				break
+			}
+
+			if p, ok := routeProtocols[v]; ok {
+				req.Protocol = p
+			} else if p, err := strconv.ParseUint(v, 10, 8); err == nil {
+				req.Protocol = uint32(p)
+			} else {
+				return nil, fmt.Errorf("invalid route protocol: %s", v)
+			}
+		default:
+			return nil, fmt.Errorf("unknown route argument: %s", args[0])
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if dst == "default" {
+		// The family of the default route is determined by the gateway
+		gw := req.Gw
+		if len(gw) == 0 && len(req.Multipath) > 0 {
+			gw = req.Multipath[0].Gw
+		}
+
+		if ip := net.ParseIP(gw); ip != nil {
+			switch {
+			case family == "4" && ip.To4() == nil, family == "6" && ip.To4() != nil:
+				return nil, fmt.Errorf("gateway %s does not match the address family", gw)
+			case ip.To4() == nil:
+				family = "6"
+			default:
+				family = "4"
+			}
+		}
+
+		switch family {
+		case "4":
+			req.Dst = "0.0.0.0/0"
+		case "6":
+			req.Dst = "::/0"
+		default:
+			return nil, fmt.Errorf("address family of the default route is ambiguous: specify -4 or -6, or use 0.0.0.0/0 or ::/0")
+		}
+	} else {
+		dstAddr, err := ParseIPNet(dst)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Dst = dstAddr.String()
+	}
+
+	switch req.Type {
+	case pb_types.RouteType_RTN_UNSPEC, pb_types.RouteType_RTN_UNICAST:
+		if len(req.LinkName) == 0 && len(req.Gw) == 0 && len(req.Multipath) == 0 {
+			return nil, fmt.Errorf("either gateway or device must be specified")
+		}
+
+		if len(req.Gw) == 0 && len(req.Multipath) == 0 {
+			// link route
+			req.Scope = pb_types.RouteScope(netlink.SCOPE_LINK)
+		}
+	}
+
+	return &req, nil
+}
+
+// ParseRuleArgs converts the arguments in the ip-rule(8) notation
+// into a rule request:
+//
+//	[not] [from PREFIX] [to PREFIX] [iif IFNAME] [oif IFNAME]
+//	    [fwmark MARK[/MASK]] [priority N] [lookup|table N]
+func ParseRuleArgs(args []string) (*pb_agent.RuleRequest, error) {
+	req := pb_agent.RuleRequest{}
+
+	value := func() (string, error) {
+		if len(args) < 2 {
+			return "", fmt.Errorf("no value specified for %q", args[0])
+		}
+
+		return args[1], nil
+	}
+
+	for len(args) > 0 {
+		if args[0] == "not" {
+			req.Invert = true
+			args = args[1:]
+			continue
+		}
+
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+
+		switch args[0] {
+		case "from", "to":
+			if v != "all" {
+				n, err := ParseIPNet(v)
+				if err != nil {
+					return nil, err
+				}
+
+				if args[0] == "from" {
+					req.Src = n.String()
+				} else {
+					req.Dst = n.String()
+				}
+			}
+		case "iif", "dev":
+			req.Iif = v
+		case "oif":
+			req.Oif = v
+		case "fwmark":
+			mark, mask, hasMask := strings.Cut(v, "/")
+
+			n, err := strconv.ParseUint(mark, 0, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fwmark value: %s", v)
+			}
+			req.Fwmark = uint32(n)
+
+			if hasMask {
+				n, err := strconv.ParseUint(mask, 0, 32)
+				if err != nil {
+					return nil, fmt.Errorf("invalid fwmark mask: %s", v)
+				}
+				req.Fwmask = uint32(n)
+			}
+		case "priority", "pref", "preference":
+			n, err := strconv.ParseUint(v, 10, 31)
+			if err != nil {
+				return nil, fmt.Errorf("invalid priority value: %s", v)
+			}
+			req.Priority = int32(n)
+		case "lookup", "table":
+			switch v {
+			case "main":
+				req.Table = unix.RT_TABLE_MAIN
+			case "local":
+				req.Table = unix.RT_TABLE_LOCAL
+			case "default":
+				req.Table = unix.RT_TABLE_DEFAULT
+			default:
+				n, err := strconv.ParseUint(v, 10, 31)
+				if err != nil {
+					return nil, fmt.Errorf("invalid table value: %s", v)
+				}
+				req.Table = int32(n)
+			}
+		default:
+			return nil, fmt.Errorf("unknown rule argument: %s", args[0])
+		}
+
+		args = args[2:]
+	}
+
+	return &req, nil
+}
+
+// ParseLinkArgs converts the arguments in the ip-link(8) notation
+// into a request to change the link attributes:
+//
+//	[mtu N] [address HWADDR] [name NEWNAME] [txqueuelen N] [alias NAME]
+func ParseLinkArgs(args []string) (*pb_agent.SetLinkAttributesRequest, error) {
+	req := pb_agent.SetLinkAttributesRequest{}
+
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no link attributes specified")
+	}
+
+	for ; len(args) > 0; args = args[2:] {
+		if len(args) < 2 {
+			return nil, fmt.Errorf("no value specified for %q", args[0])
+		}
+
+		v := args[1]
+
+		switch args[0] {
+		case "mtu", "txqueuelen", "txqlen":
+			n, err := strconv.ParseUint(v, 10, 31)
+			if err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid value of %q: %s", args[0], v)
+			}
+
+			if args[0] == "mtu" {
+				req.MTU = int32(n)
+			} else {
+				req.Txqueuelen = int32(n)
+			}
+		case "address":
+			if _, err := net.ParseMAC(v); err != nil {
+				return nil, err
+			}
+			req.HwAddr = v
+		case "name":
+			req.NewName = v
+		case "alias":
+			// ip link set dev IFNAME alias ""
+			if len(v) == 0 {
+				req.ClearAlias = true
+			}
+			req.Alias = v
+		default:
+			return nil, fmt.Errorf("unknown link argument: %s", args[0])
+		}
+	}
+
+	return &req, nil
+}
+
+// ParseCreateLinkArgs converts the arguments in the ip-link(8) notation
+// into a request to create a virtual link:
+//
+//	[link PARENT] [mtu N] [address HWADDR] [up] type TYPE [OPTIONS]
+//
+// where TYPE and OPTIONS are:
+//
+//	vlan id N [protocol 802.1q|802.1ad]
+//	macvlan [mode private|vepa|bridge|passthru]
+//	bond [mode MODE] [miimon N] [members IFNAME,...]
+//	bridge [ports IFNAME,...]
+//	dummy
+//	wireguard
+func ParseCreateLinkArgs(args []string) (*pb_agent.CreateLinkRequest, error) {
+	req := pb_agent.CreateLinkRequest{}
+
+	var parent, linkType string
+
+	opts := make(map[string]string)
+
+	for len(args) > 0 {
+		if args[0] == "up" {
+			req.Up = true
+			args = args[1:]
+			continue
+		}
+
+		if len(args) < 2 {
+			return nil, fmt.Errorf("no value specified for %q", args[0])
+		}
+
+		k, v := args[0], args[1]
+		args = args[2:]
+
+		switch {
+		case k == "link":
+			parent = v
+		case k == "mtu":
+			n, err := strconv.ParseUint(v, 10, 31)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of %q: %s", k, v)
+			}
+			req.MTU = int32(n)
+		case k == "address":
+			if _, err := net.ParseMAC(v); err != nil {
+				return nil, err
+			}
+			req.HwAddr = v
+		case k == "type" && len(linkType) == 0:
+			linkType = v
+		case len(linkType) > 0:
+			// type-specific options
+			opts[k] = v
+		default:
+			return nil, fmt.Errorf("unknown link argument: %s", k)
+		}
+	}
+
+	number := func(k string) (int32, error) {
+		v, ok := opts[k]
+		if !ok {
+			return 0, nil
+		}
+
+		n, err := strconv.ParseUint(v, 10, 31)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value of %q: %s", k, v)
+		}
+
+		return int32(n), nil
+	}
+
+	list := func(k string) []string {
+		if v, ok := opts[k]; ok {
+			return strings.Split(v, ",")
+		}
+
+		return nil
+	}
+
+	known := make([]string, 0, 3)
+
+	switch linkType {
+	case "vlan":
+		id, err := number("id")
+		if err != nil {
+			return nil, err
+		}
+
+		req.Options = &pb_agent.CreateLinkRequest_Vlan_{
+			Vlan: &pb_agent.CreateLinkRequest_Vlan{Parent: parent, VlanId: id, Protocol: opts["protocol"]},
+		}
+
+		known = append(known, "id", "protocol")
+	case "macvlan":
+		req.Options = &pb_agent.CreateLinkRequest_Macvlan_{
+			Macvlan: &pb_agent.CreateLinkRequest_Macvlan{Parent: parent, Mode: opts["mode"]},
+		}
+
+		known = append(known, "mode")
+	case "bond":
+		miimon, err := number("miimon")
+		if err != nil {
+			return nil, err
+		}
+
+		req.Options = &pb_agent.CreateLinkRequest_Bond_{
+			Bond: &pb_agent.CreateLinkRequest_Bond{Mode: opts["mode"], Miimon: miimon, Members: list("members")},
+		}
+
+		known = append(known, "mode", "miimon", "members")
+	case "bridge":
+		req.Options = &pb_agent.CreateLinkRequest_Bridge_{
+			Bridge: &pb_agent.CreateLinkRequest_Bridge{Ports: list("ports")},
+		}
+
+		known = append(known, "ports")
+	case "dummy":
+		req.Options = &pb_agent.CreateLinkRequest_Dummy_{
+			Dummy: &pb_agent.CreateLinkRequest_Dummy{},
+		}
+	case "wireguard":
+		req.Options = &pb_agent.CreateLinkRequest_Wireguard_{
+			Wireguard: &pb_agent.CreateLinkRequest_Wireguard{},
+		}
+	case "":
+		return nil, fmt.Errorf("link type is not specified")
+	default:
+		return nil, fmt.Errorf("unsupported link type: %s", linkType)
+	}
+
+optsLoop:
+	for k := range opts {
+		for _, x := range known {
+			if k == x {
+				continue optsLoop
+			}
+		}
+
+		return nil, fmt.Errorf("unknown %s argument: %s", linkType, k)
+	}
+
+	return &req, nil
+}
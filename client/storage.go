@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
+
+	pb_storage "github.com/0xef53/phoenix-guest-agent/api/services/storage/v2"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+)
+
+func (c *client) ShowPhysicalVolumes(ctx context.Context) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Storage().GetPhysicalVolumes(ctx, new(empty.Empty))
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ShowVolumeGroups(ctx context.Context) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Storage().GetVolumeGroups(ctx, new(empty.Empty))
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ShowLogicalVolumes(ctx context.Context) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Storage().GetLogicalVolumes(ctx, new(empty.Empty))
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ResizePhysicalVolume(ctx context.Context, device string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		req := pb_storage.ResizePhysicalVolumeRequest{
+			Device: device,
+		}
+
+		_, err := grpcClient.Storage().ResizePhysicalVolume(ctx, &req)
+
+		return err
+	})
+}
+
+func (c *client) ExtendLogicalVolume(ctx context.Context, volume, size string, resizeFS bool) error {
+	parts := strings.SplitN(volume, "/", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return fmt.Errorf("invalid volume name (must be VG/LV): %s", volume)
+	}
+
+	var sizeBytes uint64
+
+	if len(size) > 0 {
+		if v, err := ParseSize(size); err == nil {
+			sizeBytes = v
+		} else {
+			return err
+		}
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		req := pb_storage.ExtendLogicalVolumeRequest{
+			VgName:    parts[0],
+			LvName:    parts[1],
+			SizeBytes: sizeBytes,
+			ResizeFs:  resizeFS,
+		}
+
+		resp, err := grpcClient.Storage().ExtendLogicalVolume(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) UnlockEncryptedVolume(ctx context.Context, device, name, keyfile string, readOnly bool) error {
+	var r io.Reader
+
+	if len(keyfile) == 0 || keyfile == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(keyfile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		stream, err := grpcClient.Storage().UnlockEncryptedVolume(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create new stream: %s", err)
+		}
+
+		req := pb_storage.UnlockEncryptedVolumeRequest{
+			Data: &pb_storage.UnlockEncryptedVolumeRequest_Info{
+				Info: &pb_storage.UnlockEncryptedVolumeRequest_VolumeInfo{
+					Device:   device,
+					Name:     name,
+					ReadOnly: readOnly,
+				},
+			},
+		}
+
+		if err := stream.Send(&req); err != nil {
+			return fmt.Errorf("initial request failed: %s, %s", err, stream.RecvMsg(nil))
+		}
+
+		buffer := make([]byte, 4096)
+
+		defer func() {
+			for i := range buffer {
+				buffer[i] = 0
+			}
+		}()
+
+		for {
+			n, err := r.Read(buffer)
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+
+				return fmt.Errorf("key read failed: %s", err)
+			}
+
+			req := pb_storage.UnlockEncryptedVolumeRequest{
+				Data: &pb_storage.UnlockEncryptedVolumeRequest_KeyData{
+					KeyData: buffer[:n],
+				},
+			}
+
+			if err := stream.Send(&req); err != nil {
+				return fmt.Errorf("key send failed: %s, %s", err, stream.RecvMsg(nil))
+			}
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return fmt.Errorf("final request failed: %s", err)
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) LockEncryptedVolume(ctx context.Context, name string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		req := pb_storage.LockEncryptedVolumeRequest{
+			Name: name,
+		}
+
+		_, err := grpcClient.Storage().LockEncryptedVolume(ctx, &req)
+
+		return err
+	})
+}
+
+func (c *client) ShowEncryptedVolumeStatus(ctx context.Context, name string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		req := pb_storage.GetEncryptedVolumeStatusRequest{
+			Name: name,
+		}
+
+		resp, err := grpcClient.Storage().GetEncryptedVolumeStatus(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
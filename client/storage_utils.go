@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseSize parses a size string with an optional binary suffix (K, M, G, T)
+// and returns the value in bytes.
+func ParseSize(s string) (uint64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+
+	var shift uint
+
+	switch {
+	case strings.HasSuffix(s, "K"):
+		shift = 10
+	case strings.HasSuffix(s, "M"):
+		shift = 20
+	case strings.HasSuffix(s, "G"):
+		shift = 30
+	case strings.HasSuffix(s, "T"):
+		shift = 40
+	}
+
+	if shift > 0 {
+		s = s[:len(s)-1]
+	}
+
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size value: %s", s)
+	}
+
+	if v > math.MaxUint64>>shift {
+		return 0, fmt.Errorf("size value is too large: %s", s)
+	}
+
+	return v << shift, nil
+}
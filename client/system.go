@@ -2,9 +2,13 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
 
+	pb_system "github.com/0xef53/phoenix-guest-agent/api/services/system/v2"
+
 	empty "github.com/golang/protobuf/ptypes/empty"
 )
 
@@ -26,3 +30,47 @@ func (c *client) ShutdownAgent(ctx context.Context) error {
 		return err
 	})
 }
+
+func (c *client) ShowSysctl(ctx context.Context, patterns []string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		req := pb_system.GetSysctlRequest{
+			Patterns: patterns,
+		}
+
+		resp, err := grpcClient.System().GetSysctl(ctx, &req)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range resp.Params {
+			fmt.Printf("%s = %s\n", p.Key, p.Value)
+		}
+
+		return nil
+	})
+}
+
+// SetSysctl changes the kernel parameters specified as KEY=VALUE.
+func (c *client) SetSysctl(ctx context.Context, args []string, persist bool) error {
+	req := pb_system.SetSysctlRequest{
+		Persist: persist,
+	}
+
+	for _, arg := range args {
+		k, v, ok := strings.Cut(arg, "=")
+		if !ok || len(strings.TrimSpace(k)) == 0 {
+			return fmt.Errorf("invalid argument, expected KEY=VALUE: %s", arg)
+		}
+
+		req.Params = append(req.Params, &pb_system.SysctlParam{
+			Key:   strings.TrimSpace(k),
+			Value: strings.TrimSpace(v),
+		})
+	}
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		_, err := grpcClient.System().SetSysctl(ctx, &req)
+
+		return err
+	})
+}
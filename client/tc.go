@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+
+	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
+
+	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+)
+
+func (c *client) ShowQdiscs(ctx context.Context, ifname string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ListQdiscs(ctx, &pb_agent.TrafficControlRequest{LinkName: ifname})
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ShowTrafficClasses(ctx context.Context, ifname string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ListTrafficClasses(ctx, &pb_agent.TrafficControlRequest{LinkName: ifname})
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) ShowTrafficFilters(ctx context.Context, ifname string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().ListTrafficFilters(ctx, &pb_agent.TrafficControlRequest{LinkName: ifname})
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp)
+	})
+}
+
+func (c *client) SetRootQdisc(ctx context.Context, ifname string, args []string) error {
+	req, err := ParseQdiscArgs(args)
+	if err != nil {
+		return err
+	}
+
+	req.LinkName = ifname
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		_, err := grpcClient.Network().SetRootQdisc(ctx, req)
+
+		return err
+	})
+}
+
+func (c *client) DeleteRootQdisc(ctx context.Context, ifname string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		_, err := grpcClient.Network().DeleteRootQdisc(ctx, &pb_agent.TrafficControlRequest{LinkName: ifname})
+
+		return err
+	})
+}
@@ -0,0 +1,258 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
+)
+
+var rateUnits = []struct {
+	suffix string
+	factor float64
+}{
+	// The longest suffixes go first
+	{"tbit", 1e12},
+	{"gbit", 1e9},
+	{"mbit", 1e6},
+	{"kbit", 1e3},
+	{"tbps", 8e12},
+	{"gbps", 8e9},
+	{"mbps", 8e6},
+	{"kbps", 8e3},
+	{"bit", 1},
+	{"bps", 8},
+}
+
+// ParseRate parses a rate in the tc(8) notation (e.g. 10mbit, 1.5gbit, 100kbps)
+// and returns the value in bits per second. A bare number means bits per second.
+func ParseRate(s string) (uint64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	factor := 1.0
+
+	for _, u := range rateUnits {
+		if strings.HasSuffix(s, u.suffix) {
+			s, factor = strings.TrimSuffix(s, u.suffix), u.factor
+			break
+		}
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid rate value: %s", s)
+	}
+
+	return uint64(v * factor), nil
+}
+
+func parseTcSize(s string) (uint32, error) {
+	// 32kb is the same as 32k
+	v, err := ParseSize(strings.TrimSuffix(strings.ToLower(s), "b"))
+	if err != nil || v > 1<<32-1 {
+		return 0, fmt.Errorf("invalid size value: %s", s)
+	}
+
+	return uint32(v), nil
+}
+
+func parseTcTime(s string) (uint32, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 || d.Microseconds() > 1<<32-1 {
+		return 0, fmt.Errorf("invalid time value: %s", s)
+	}
+
+	return uint32(d.Microseconds()), nil
+}
+
+func parsePercentage(s string) (float32, error) {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 32)
+	if err != nil || v < 0 || v > 100 {
+		return 0, fmt.Errorf("invalid percentage value: %s", s)
+	}
+
+	return float32(v), nil
+}
+
+// ParseQdiscArgs converts the arguments in the tc(8) notation
+// into a request to set the root qdisc:
+//
+//	tbf rate RATE burst SIZE [latency TIME | limit SIZE]
+//	htb rate RATE [ceil RATE]
+//	fq_codel [limit PACKETS] [flows N] [interval TIME] [noecn]
+//	netem [delay TIME [JITTER]] [loss PERCENT] [duplicate PERCENT]
+//	      [corrupt PERCENT] [reorder PERCENT] [limit PACKETS] [rate RATE]
+//
+// The times are in the Go notation, e.g. 100ms or 1.5s.
+func ParseQdiscArgs(args []string) (*pb_agent.SetRootQdiscRequest, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("qdisc kind is not specified")
+	}
+
+	req := pb_agent.SetRootQdiscRequest{}
+
+	kind, args := args[0], args[1:]
+
+	next := func(k string) (string, error) {
+		if len(args) == 0 {
+			return "", fmt.Errorf("no value specified for %q", k)
+		}
+
+		v := args[0]
+		args = args[1:]
+
+		return v, nil
+	}
+
+	var err error
+
+	switch kind {
+	case "tbf":
+		o := pb_types.TbfOptions{}
+
+		for err == nil && len(args) > 0 {
+			var v string
+
+			k := args[0]
+			args = args[1:]
+
+			if v, err = next(k); err != nil {
+				break
+			}
+
+			switch k {
+			case "rate":
+				o.Rate, err = ParseRate(v)
+			case "burst", "buffer", "maxburst":
+				o.Burst, err = parseTcSize(v)
+			case "limit":
+				o.Limit, err = parseTcSize(v)
+			case "latency":
+				o.LatencyUs, err = parseTcTime(v)
+			default:
+				err = fmt.Errorf("unknown tbf argument: %s", k)
+			}
+		}
+
+		req.Qdisc = &pb_agent.SetRootQdiscRequest_Tbf{Tbf: &o}
+	case "htb":
+		o := pb_types.HtbOptions{}
+
+		for err == nil && len(args) > 0 {
+			var v string
+
+			k := args[0]
+			args = args[1:]
+
+			if v, err = next(k); err != nil {
+				break
+			}
+
+			switch k {
+			case "rate":
+				o.Rate, err = ParseRate(v)
+			case "ceil":
+				o.Ceil, err = ParseRate(v)
+			default:
+				err = fmt.Errorf("unknown htb argument: %s", k)
+			}
+		}
+
+		req.Qdisc = &pb_agent.SetRootQdiscRequest_Htb{Htb: &o}
+	case "fq_codel":
+		o := pb_types.FqCodelOptions{}
+
+		for err == nil && len(args) > 0 {
+			var v string
+
+			k := args[0]
+			args = args[1:]
+
+			switch k {
+			case "noecn":
+				o.NoEcn = true
+				continue
+			case "ecn":
+				o.NoEcn = false
+				continue
+			}
+
+			if v, err = next(k); err != nil {
+				break
+			}
+
+			switch k {
+			case "limit":
+				var n uint64
+				n, err = strconv.ParseUint(v, 10, 32)
+				o.Limit = uint32(n)
+			case "flows":
+				var n uint64
+				n, err = strconv.ParseUint(v, 10, 32)
+				o.Flows = uint32(n)
+			case "interval":
+				o.IntervalUs, err = parseTcTime(v)
+			default:
+				err = fmt.Errorf("unknown fq_codel argument: %s", k)
+			}
+		}
+
+		req.Qdisc = &pb_agent.SetRootQdiscRequest_FqCodel{FqCodel: &o}
+	case "netem":
+		o := pb_types.NetemOptions{}
+
+		for err == nil && len(args) > 0 {
+			var v string
+
+			k := args[0]
+			args = args[1:]
+
+			if v, err = next(k); err != nil {
+				break
+			}
+
+			switch k {
+			case "delay":
+				if o.DelayUs, err = parseTcTime(v); err != nil {
+					break
+				}
+				// The optional jitter follows the delay
+				if len(args) > 0 {
+					if jitter, e := parseTcTime(args[0]); e == nil {
+						o.JitterUs = jitter
+						args = args[1:]
+					}
+				}
+			case "loss":
+				o.Loss, err = parsePercentage(v)
+			case "duplicate":
+				o.Duplicate, err = parsePercentage(v)
+			case "corrupt":
+				o.Corrupt, err = parsePercentage(v)
+			case "reorder":
+				o.Reorder, err = parsePercentage(v)
+			case "limit":
+				var n uint64
+				n, err = strconv.ParseUint(v, 10, 32)
+				o.Limit = uint32(n)
+			case "rate":
+				o.Rate, err = ParseRate(v)
+			default:
+				err = fmt.Errorf("unknown netem argument: %s", k)
+			}
+		}
+
+		req.Qdisc = &pb_agent.SetRootQdiscRequest_Netem{Netem: &o}
+	default:
+		return nil, fmt.Errorf("unsupported qdisc kind: %s", kind)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	grpc_interfaces "github.com/0xef53/phoenix-guest-agent/internal/grpc/interfaces"
+
+	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+)
+
+func (c *client) ShowWireguardDevice(ctx context.Context, ifname string) error {
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		resp, err := grpcClient.Network().GetWireguardDevice(ctx, &pb_agent.GetWireguardDeviceRequest{LinkName: ifname})
+		if err != nil {
+			return err
+		}
+
+		return PrintJSON(resp.Device)
+	})
+}
+
+func (c *client) ConfigureWireguard(ctx context.Context, ifname string, args []string) error {
+	req, err := ParseWireguardSetArgs(args)
+	if err != nil {
+		return err
+	}
+
+	req.LinkName = ifname
+
+	return c.executeGRPC(ctx, func(grpcClient *grpc_interfaces.Agent) error {
+		_, err := grpcClient.Network().ConfigureWireguard(ctx, req)
+
+		return err
+	})
+}
+
+// ParseWireguardSetArgs converts the arguments in the wg(8) notation
+// into a request to configure a WireGuard link:
+//
+//	[listen-port N] [fwmark N] [private-key FILE] [replace-peers]
+//	[peer KEY [remove] [update-only] [preshared-key FILE] [endpoint HOST:PORT]
+//	    [persistent-keepalive N|off] [allowed-ips PREFIX,...] ...]
+//
+// The keys are read from the files ("-" means stdin). As with wg(8),
+// the allowed IPs replace the current list of the peer.
+func ParseWireguardSetArgs(args []string) (*pb_agent.ConfigureWireguardRequest, error) {
+	req := pb_agent.ConfigureWireguardRequest{}
+
+	var peer *pb_agent.ConfigureWireguardRequest_Peer
+
+	for len(args) > 0 {
+		k := args[0]
+
+		// Flags without a value
+		switch {
+		case k == "replace-peers" && peer == nil:
+			req.ReplacePeers = true
+			args = args[1:]
+			continue
+		case k == "remove" && peer != nil:
+			peer.Remove = true
+			args = args[1:]
+			continue
+		case k == "update-only" && peer != nil:
+			peer.UpdateOnly = true
+			args = args[1:]
+			continue
+		}
+
+		if len(args) < 2 {
+			return nil, fmt.Errorf("no value specified for %q", k)
+		}
+
+		v := args[1]
+		args = args[2:]
+
+		switch {
+		case k == "peer":
+			peer = &pb_agent.ConfigureWireguardRequest_Peer{PublicKey: v}
+			req.Peers = append(req.Peers, peer)
+		case k == "listen-port" && peer == nil:
+			n, err := strconv.ParseUint(v, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of %q: %s", k, v)
+			}
+			req.ListenPort = uint32(n)
+		case k == "fwmark" && peer == nil:
+			n, err := strconv.ParseUint(v, 0, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of %q: %s", k, v)
+			}
+			req.Fwmark = uint32(n)
+		case k == "private-key" && peer == nil:
+			key, err := readKeyFile(v)
+			if err != nil {
+				return nil, err
+			}
+			req.PrivateKey = key
+		case k == "preshared-key" && peer != nil:
+			key, err := readKeyFile(v)
+			if err != nil {
+				return nil, err
+			}
+			peer.PresharedKey = key
+		case k == "endpoint" && peer != nil:
+			peer.Endpoint = v
+		case k == "persistent-keepalive" && peer != nil:
+			if v == "off" {
+				continue
+			}
+			n, err := strconv.ParseUint(v, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of %q: %s", k, v)
+			}
+			peer.PersistentKeepalive = uint32(n)
+		case k == "allowed-ips" && peer != nil:
+			peer.ReplaceAllowedIps = true
+
+			for _, s := range strings.Split(v, ",") {
+				if s = strings.TrimSpace(s); len(s) == 0 {
+					continue
+				}
+
+				ipnet, err := ParseIPNet(s)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value of %q: %s", k, s)
+				}
+
+				peer.AllowedIps = append(peer.AllowedIps, ipnet.String())
+			}
+		default:
+			return nil, fmt.Errorf("unknown wireguard argument: %s", k)
+		}
+	}
+
+	return &req, nil
+}
+
+func readKeyFile(fname string) (string, error) {
+	var b []byte
+	var err error
+
+	if fname == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(fname)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
@@ -15,9 +15,11 @@ import (
 	"github.com/0xef53/phoenix-guest-agent/services/interceptors"
 
 	_ "github.com/0xef53/phoenix-guest-agent/services/agent"
+	_ "github.com/0xef53/phoenix-guest-agent/services/diagnostics"
 	_ "github.com/0xef53/phoenix-guest-agent/services/filesystem"
 	_ "github.com/0xef53/phoenix-guest-agent/services/network"
 	_ "github.com/0xef53/phoenix-guest-agent/services/secure_shell"
+	_ "github.com/0xef53/phoenix-guest-agent/services/storage"
 	_ "github.com/0xef53/phoenix-guest-agent/services/system"
 
 	grpcserver "github.com/0xef53/go-grpc/server"
@@ -29,7 +31,9 @@ import (
 
 	"github.com/mdlayher/vsock"
 	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/sys/unix"
 )
 
 type Agent struct {
@@ -135,46 +139,71 @@ func (a *Agent) ListenAndServe(ctx context.Context) error {
 		}
 
 		// Additional TCP listeners in case the main transport is virtio sirial port
-		processed := make(map[string]struct{})
+		updates := make(chan netlink.AddrUpdate, 16)
+		done := make(chan struct{})
 
-		var attempt int
+		if err := netlink.AddrSubscribeWithOptions(updates, done, netlink.AddrSubscribeOptions{ListExisting: true}); err != nil {
+			log.Warnf("Could not subscribe to IPv6 link-local addresses: %s", err)
 
-		ticker := time.NewTicker(3 * time.Second)
-		defer ticker.Stop()
+			return
+		}
 
-		for {
-			addrs, err := getLinkLocalAddrs()
-			if err != nil {
-				log.Warnf("Could not get the list of IPv6 link-local adressess: %s", err)
+		// The updates channel is closed by the subscription goroutine
+		// only if the subscription has been started successfully
+		defer func() {
+			close(done)
 
-				break
+			// Unblock the subscription goroutine
+			for range updates {
 			}
+		}()
 
-			for _, addr := range addrs {
-				if _, ok := processed[addr]; !ok {
-					if tl, err := tls.Listen("tcp", net.JoinHostPort(addr, fmt.Sprintf("%d", core.GRPCPort)), tlsConfig); err == nil {
-						listeners <- tl
+		processed := make(map[string]struct{})
 
-						processed[addr] = struct{}{}
-					} else {
-						log.Errorf("Could not bind to %s: %s", addr, err)
-					}
-				}
-			}
+		// We wait for link-local addresses on network interfaces
+		// within about a minute
+		timer := time.NewTimer(time.Minute)
+		defer timer.Stop()
+
+		for {
+			var u netlink.AddrUpdate
+			var ok bool
 
 			select {
 			case <-ctx.Done():
 				return
-			case <-ticker.C:
+			case <-timer.C:
+				return
+			case u, ok = <-updates:
+				if !ok {
+					return
+				}
 			}
 
-			// We wait for link-local addresses on network interfaces
-			// within about 20*3 seconds
-			if attempt == 20 {
-				break
+			ip := u.LinkAddress.IP
+
+			// A tentative address cannot be bound until DAD is completed.
+			// The kernel sends one more notification after that.
+			if !u.NewAddr || ip.To4() != nil || !ip.IsLinkLocalUnicast() || u.Flags&unix.IFA_F_TENTATIVE != 0 {
+				continue
 			}
 
-			attempt++
+			link, err := netlink.LinkByIndex(u.LinkIndex)
+			if err != nil {
+				continue
+			}
+
+			addr := ip.String() + "%" + link.Attrs().Name
+
+			if _, ok := processed[addr]; !ok {
+				if tl, err := tls.Listen("tcp", net.JoinHostPort(addr, fmt.Sprintf("%d", core.GRPCPort)), tlsConfig); err == nil {
+					listeners <- tl
+
+					processed[addr] = struct{}{}
+				} else {
+					log.Errorf("Could not bind to %s: %s", addr, err)
+				}
+			}
 		}
 	}()
 
@@ -237,31 +266,3 @@ func newServer(ui []grpc.UnaryServerInterceptor, si []grpc.StreamServerIntercept
 
 	return grpc.NewServer(opts...)
 }
-
-func getLinkLocalAddrs() ([]string, error) {
-	var lladdrs []string
-
-	ifaces, err := net.Interfaces()
-	if err != nil {
-		return nil, err
-	}
-
-	for _, netif := range ifaces {
-		ifaddrs, err := netif.Addrs()
-		if err != nil {
-			return nil, err
-		}
-
-		for _, addr := range ifaddrs {
-			if ipnet, ok := addr.(*net.IPNet); ok && ipnet.IP.To4() == nil {
-				if ipnet.IP.IsLinkLocalUnicast() {
-					lladdrs = append(lladdrs, ipnet.IP.String()+"%"+netif.Name)
-
-					break
-				}
-			}
-		}
-	}
-
-	return lladdrs, nil
-}
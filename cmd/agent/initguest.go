@@ -0,0 +1,479 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/cloudinit"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// The directory with the per-instance "already applied" markers
+const initguestStateDir = "/var/lib/phoenix-ga/initguest"
+
+var hostnameRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+func initguestApply(force bool) error {
+	data, err := readCloudInitData()
+	if err != nil {
+		return err
+	}
+
+	if data.Meta == nil || len(data.Meta.InstanceID) == 0 {
+		return fmt.Errorf("instance-id is not specified in cloud-init meta-data")
+	}
+
+	marker := filepath.Join(initguestStateDir, strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, data.Meta.InstanceID))
+
+	if !force {
+		if _, err := os.Stat(marker); err == nil {
+			log.Infof("cloud-init data has already been applied for instance %s", data.Meta.InstanceID)
+
+			return nil
+		}
+	}
+
+	cc := new(cloudinit.CloudConfig)
+
+	switch {
+	case len(data.UserData) == 0:
+	case cloudinit.IsCloudConfig(data.UserData):
+		if cc, err = cloudinit.ParseCloudConfig(data.UserData); err != nil {
+			return err
+		}
+
+		for _, k := range cc.IgnoredKeys {
+			log.Warnf("user-data: ignoring unsupported key %q", k)
+		}
+	default:
+		log.Warnf("user-data is not in the cloud-config format, ignoring it")
+	}
+
+	if err := initguestSetHostname(data.Meta, cc); err != nil {
+		return fmt.Errorf("failed to set hostname: %w", err)
+	}
+
+	for _, u := range cc.Users {
+		if u.Default {
+			log.Warnf("users: skipping the \"default\" entry: there is no distribution default user")
+			continue
+		}
+
+		if err := initguestConfigureUser(u); err != nil {
+			return fmt.Errorf("failed to configure user %s: %w", u.Name, err)
+		}
+	}
+
+	// The deferred files are written after the others, as in cloud-init
+	// where they are written in the final stage. Both are written after
+	// the users are created, so the owner of any file can be a new user
+	for _, deferred := range []bool{false, true} {
+		for _, f := range cc.WriteFiles {
+			if f.Defer != deferred {
+				continue
+			}
+
+			if err := initguestWriteFile(f); err != nil {
+				return fmt.Errorf("failed to write %s: %w", f.Path, err)
+			}
+		}
+	}
+
+	// The marker is created before running the commands,
+	// so that they are never run twice for the same instance
+	if err := os.MkdirAll(initguestStateDir, 0700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(marker, []byte(time.Now().Format(time.RFC3339)+"\n"), 0600); err != nil {
+		return err
+	}
+
+	var failed int
+
+	for _, c := range cc.RunCmd {
+		log.Debugf("running command: %s", c)
+
+		var cmd *exec.Cmd
+
+		if len(c.Args) > 0 {
+			cmd = exec.Command(c.Args[0], c.Args[1:]...)
+		} else {
+			cmd = exec.Command("/bin/sh", "-c", c.Shell)
+		}
+
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		if err := cmd.Run(); err != nil {
+			log.Errorf("runcmd: %q failed: %s", c.String(), err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d runcmd commands failed", failed, len(cc.RunCmd))
+	}
+
+	return nil
+}
+
+func initguestSetHostname(md *cloudinit.MetaData, cc *cloudinit.CloudConfig) error {
+	if cc.PreserveHostname {
+		return nil
+	}
+
+	var name string
+
+	for _, x := range []string{cc.Hostname, cc.FQDN, md.LocalHostname} {
+		if len(x) > 0 {
+			// Only the short name is used as the system hostname
+			name = strings.SplitN(x, ".", 2)[0]
+			break
+		}
+	}
+
+	if len(name) == 0 {
+		return nil
+	}
+
+	if !hostnameRe.MatchString(name) {
+		return fmt.Errorf("invalid hostname: %q", name)
+	}
+
+	log.Debugf("setting hostname to %s", name)
+
+	if err := unix.Sethostname([]byte(name)); err != nil {
+		return os.NewSyscallError("sethostname", err)
+	}
+
+	return os.WriteFile("/etc/hostname", []byte(name+"\n"), 0644)
+}
+
+func initguestConfigureUser(u *cloudinit.UserConfig) error {
+	if _, err := user.Lookup(u.Name); err == nil {
+		if len(u.Groups) > 0 {
+			log.Debugf("adding existing user %s to groups: %s", u.Name, u.Groups)
+
+			if err := runCommand("usermod", "--append", "--groups", strings.Join(u.Groups, ","), u.Name); err != nil {
+				return err
+			}
+		}
+	} else {
+		var unknownErr user.UnknownUserError
+
+		if !errors.As(err, &unknownErr) {
+			return err
+		}
+
+		args := []string{"--create-home"}
+
+		if len(u.Gecos) > 0 {
+			args = append(args, "--comment", u.Gecos)
+		}
+		if len(u.HomeDir) > 0 {
+			args = append(args, "--home-dir", u.HomeDir)
+		}
+		if len(u.Shell) > 0 {
+			args = append(args, "--shell", u.Shell)
+		}
+		if len(u.Groups) > 0 {
+			args = append(args, "--groups", strings.Join(u.Groups, ","))
+		}
+
+		log.Debugf("creating user %s", u.Name)
+
+		if err := runCommand("useradd", append(args, u.Name)...); err != nil {
+			return err
+		}
+	}
+
+	if len(u.Sudo) > 0 {
+		var buf bytes.Buffer
+
+		buf.WriteString("# Created by phoenix-guest-agent from cloud-init user-data\n")
+
+		for _, rule := range u.Sudo {
+			if strings.ContainsAny(rule, "\r\n") {
+				return fmt.Errorf("invalid sudoers rule: %q", rule)
+			}
+
+			fmt.Fprintf(&buf, "%s %s\n", u.Name, rule)
+		}
+
+		if err := writeSudoersFile(u.Name, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if len(u.SSHAuthorizedKeys) > 0 {
+		pw, err := user.Lookup(u.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := addAuthorizedKeys(pw, u.SSHAuthorizedKeys); err != nil {
+			return fmt.Errorf("failed to add ssh authorized keys: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// sudo skips the files in /etc/sudoers.d whose names
+// contain a dot or end with a tilde
+var sudoersUnsafeChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// writeSudoersFile validates the rules using visudo and installs them
+// into /etc/sudoers.d, since a single malformed file breaks sudo entirely.
+func writeSudoersFile(username string, data []byte) error {
+	if _, err := exec.LookPath("visudo"); err != nil {
+		return fmt.Errorf("unable to validate sudoers rules: %w", err)
+	}
+
+	if err := os.MkdirAll("/etc/sudoers.d", 0750); err != nil {
+		return err
+	}
+
+	fname := filepath.Join("/etc/sudoers.d", "90-phoenix-ga-"+sudoersUnsafeChars.ReplaceAllString(username, "_"))
+
+	// The dot in the name prevents sudo from reading the temporary file
+	tmpfile := fname + ".pga-tmp"
+
+	if err := os.WriteFile(tmpfile, data, 0440); err != nil {
+		return err
+	}
+
+	if err := runCommand("visudo", "-c", "-q", "-f", tmpfile); err != nil {
+		os.Remove(tmpfile)
+
+		return fmt.Errorf("invalid sudoers rules: %w", err)
+	}
+
+	if err := os.Rename(tmpfile, fname); err != nil {
+		os.Remove(tmpfile)
+
+		return err
+	}
+
+	return nil
+}
+
+// addAuthorizedKeys adds the missing keys to ~/.ssh/authorized_keys of the user.
+// The home directory belongs to the user, so neither ~/.ssh nor authorized_keys
+// are allowed to be symlinks or hard links: otherwise we could be tricked into
+// writing to an arbitrary file and giving it to the user.
+func addAuthorizedKeys(pw *user.User, keys []string) error {
+	uid, _ := strconv.Atoi(pw.Uid)
+	gid, _ := strconv.Atoi(pw.Gid)
+
+	homefd, err := unix.Open(pw.HomeDir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return &os.PathError{Op: "open", Path: pw.HomeDir, Err: err}
+	}
+	defer unix.Close(homefd)
+
+	sshdir := filepath.Join(pw.HomeDir, ".ssh")
+
+	if err := unix.Mkdirat(homefd, ".ssh", 0700); err != nil && err != unix.EEXIST {
+		return &os.PathError{Op: "mkdir", Path: sshdir, Err: err}
+	}
+
+	sshfd, err := unix.Openat(homefd, ".ssh", unix.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+	if err != nil {
+		if err == unix.ELOOP {
+			return fmt.Errorf("refusing to use symlink: %s", sshdir)
+		}
+		return &os.PathError{Op: "open", Path: sshdir, Err: err}
+	}
+	defer unix.Close(sshfd)
+
+	if err := unix.Fchown(sshfd, uid, gid); err != nil {
+		return &os.PathError{Op: "chown", Path: sshdir, Err: err}
+	}
+
+	fname := filepath.Join(sshdir, "authorized_keys")
+
+	fd, err := unix.Openat(sshfd, "authorized_keys", unix.O_RDWR|unix.O_CREAT|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0600)
+	if err != nil {
+		if err == unix.ELOOP {
+			return fmt.Errorf("refusing to use symlink: %s", fname)
+		}
+		return &os.PathError{Op: "open", Path: fname, Err: err}
+	}
+
+	f := os.NewFile(uintptr(fd), fname)
+	defer f.Close()
+
+	var st unix.Stat_t
+
+	if err := unix.Fstat(fd, &st); err != nil {
+		return &os.PathError{Op: "stat", Path: fname, Err: err}
+	}
+
+	if st.Mode&unix.S_IFMT != unix.S_IFREG || st.Nlink != 1 {
+		return fmt.Errorf("refusing to use non-regular or hard linked file: %s", fname)
+	}
+
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+
+	for scanner.Scan() {
+		existing[strings.TrimSpace(scanner.Text())] = struct{}{}
+	}
+
+	var add []byte
+
+	if len(b) > 0 && !bytes.HasSuffix(b, []byte("\n")) {
+		add = append(add, '\n')
+	}
+
+	for _, k := range keys {
+		k = strings.TrimSpace(k)
+
+		if _, ok := existing[k]; ok || len(k) == 0 {
+			continue
+		}
+
+		add = append(add, k+"\n"...)
+		existing[k] = struct{}{}
+	}
+
+	// The file offset is at the end after reading
+	if _, err := f.Write(add); err != nil {
+		return err
+	}
+
+	if err := f.Chmod(0600); err != nil {
+		return err
+	}
+
+	if err := f.Chown(uid, gid); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func initguestWriteFile(f *cloudinit.WriteFileConfig) error {
+	if !filepath.IsAbs(f.Path) {
+		return fmt.Errorf("path is not absolute")
+	}
+
+	b, err := f.Data()
+	if err != nil {
+		return err
+	}
+
+	mode, err := f.Mode()
+	if err != nil {
+		return err
+	}
+
+	uid, gid, err := lookupOwner(f.Owner)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("writing file %s (mode = %04o, owner = %d:%d, append = %t)", f.Path, mode, uid, gid, f.Append)
+
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0755); err != nil {
+		return err
+	}
+
+	if f.Append {
+		fd, err := os.OpenFile(f.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, os.FileMode(mode))
+		if err != nil {
+			return err
+		}
+
+		if _, err := fd.Write(b); err != nil {
+			fd.Close()
+			return err
+		}
+
+		if err := fd.Close(); err != nil {
+			return err
+		}
+	} else {
+		tmpfile := f.Path + ".pga-tmp"
+
+		if err := os.WriteFile(tmpfile, b, os.FileMode(mode)); err != nil {
+			return err
+		}
+
+		if err := os.Rename(tmpfile, f.Path); err != nil {
+			os.Remove(tmpfile)
+			return err
+		}
+	}
+
+	// The mode passed to open(2) is affected by umask
+	if err := os.Chmod(f.Path, os.FileMode(mode)); err != nil {
+		return err
+	}
+
+	return os.Chown(f.Path, uid, gid)
+}
+
+// lookupOwner resolves the owner in the "user[:group]" form.
+// The empty string means root.
+func lookupOwner(owner string) (int, int, error) {
+	if len(owner) == 0 {
+		return 0, 0, nil
+	}
+
+	parts := strings.SplitN(owner, ":", 2)
+
+	pw, err := user.Lookup(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	uid, _ := strconv.Atoi(pw.Uid)
+	gid, _ := strconv.Atoi(pw.Gid)
+
+	if len(parts) == 2 && len(parts[1]) > 0 {
+		gr, err := user.LookupGroup(parts[1])
+		if err != nil {
+			return 0, 0, err
+		}
+
+		gid, _ = strconv.Atoi(gr.Gid)
+	}
+
+	return uid, gid, nil
+}
+
+func runCommand(name string, args ...string) error {
+	if out, err := exec.Command(name, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed (%s): %s", name, err, bytes.TrimSpace(out))
+	}
+
+	return nil
+}
@@ -52,6 +52,7 @@ func main() {
 				&cli.BoolFlag{Name: "legacy", Usage: "use legacy mode instead of VM sockets"},
 				&cli.StringFlag{Name: "path", Aliases: []string{"p"}, Value: core.DefaultGuestSerialPort, Usage: "path to the virtio serial port"},
 				&cli.StringFlag{Name: "cert-dir", Sources: cli.EnvVars("CERTDIR"), Usage: "directory with agent certificates"},
+				&cli.StringSliceFlag{Name: "sysctl-allow", Value: core.DefaultSysctlAllowlist, Usage: "sysctl `KEY` (glob pattern) that can be changed remotely (can be specified multiple times)"},
 			},
 			Action: runAgent,
 		},
@@ -61,10 +62,22 @@ func main() {
 			Usage: "configure/deconfigure network interfaces",
 			Flags: []cli.Flag{
 				&cli.StringFlag{Name: "configure-iface", Usage: "bring the interface up and add IP addresses"},
-				&cli.StringFlag{Name: "deconfigure-iface", Usage: "flush all IP addresses and bring the interface down"},
+				&cli.BoolFlag{Name: "all", Usage: "configure all physical interfaces found in network-config"},
+				&cli.StringFlag{Name: "deconfigure-iface", Usage: "release the DHCP leases and remove the addresses and routes configured from network-config"},
+				&cli.BoolFlag{Name: "persist", Usage: "save the resulting interface configuration to the guest network configuration"},
+				&cli.BoolFlag{Name: "with-dhcp", Usage: "use the built-in DHCP client for dhcp4/dhcp6 and as a fallback when there is no cloud-init datasource"},
 			},
 			Action: runNetInit,
 		},
+		// INITGUEST
+		{
+			Name:  "initguest",
+			Usage: "apply cloud-init meta-data and user-data: hostname, users, files and commands",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{Name: "force", Usage: "apply even if it has already been applied for the current instance"},
+			},
+			Action: runInitGuest,
+		},
 		// VERSION
 		{
 			Name:  "version",
@@ -92,9 +105,16 @@ func runAgent(ctx context.Context, c *cli.Command) error {
 			WithoutTCP: c.Bool("without-tcp"),
 			LegacyMode: c.Bool("legacy"),
 			SerialPort: c.String("path"),
+
+			SysctlAllowlist: c.StringSlice("sysctl-allow"),
 		},
 	}
 
+	// When running without the "serve" command
+	if agent.SysctlAllowlist == nil {
+		agent.SysctlAllowlist = core.DefaultSysctlAllowlist
+	}
+
 	if v := c.String("cert-dir"); len(v) != 0 {
 		agent.crtStore = cert.Dir(v)
 	} else {
@@ -137,10 +157,37 @@ func runNetInit(_ context.Context, c *cli.Command) error {
 
 	switch {
 	case c.IsSet("configure-iface"):
-		return netinitConfigureInterface(c.String("configure-iface"))
+		ifname, err := netinitConfigureInterface(c.String("configure-iface"), c.Bool("with-dhcp"))
+		if err != nil {
+			return err
+		}
+
+		if c.Bool("persist") {
+			return core.PersistInterfaceConfig(ifname)
+		}
+	case c.Bool("all"):
+		configured, err := netinitConfigureAll(c.Bool("with-dhcp"))
+
+		if c.Bool("persist") {
+			for _, ifname := range configured {
+				if err := core.PersistInterfaceConfig(ifname); err != nil {
+					return err
+				}
+			}
+		}
+
+		return err
 	case c.IsSet("deconfigure-iface"):
 		return netinitDeconfigureInterface(c.String("deconfigure-iface"))
 	}
 
 	return nil
 }
+
+func runInitGuest(_ context.Context, c *cli.Command) error {
+	if c.IsSet("verbose") {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	return initguestApply(c.Bool("force"))
+}
@@ -1,33 +1,342 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/0xef53/phoenix-guest-agent/core"
 	"github.com/0xef53/phoenix-guest-agent/internal/cloudinit"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
-func netinitConfigureInterface(ifname string) error {
-	if found, err := cloudinit.IsNoCloudMarkerPresent(); err == nil {
+// readCloudInitData reads the data of the cloud-init datasource.
+// The datasource volume is used only if the hypervisor explicitly
+// points to it, since any block device may be labelled CIDATA or config-2.
+func readCloudInitData() (*cloudinit.Data, error) {
+	data, err := cloudinit.ReadData()
+	if err != nil {
+		return nil, err
+	}
+
+	var isMarkerPresent func() (bool, error)
+
+	switch data.Source {
+	case cloudinit.NoCloud:
+		isMarkerPresent = cloudinit.IsNoCloudMarkerPresent
+	case cloudinit.ConfigDrive:
+		isMarkerPresent = cloudinit.IsConfigDriveMarkerPresent
+	default:
+		return nil, fmt.Errorf("unknown cloud-init datasource: %s", data.Source)
+	}
+
+	if found, err := isMarkerPresent(); err == nil {
 		if !found {
-			return fmt.Errorf("unable to find the cloud-init NoCloud datasource marker")
+			return nil, fmt.Errorf("unable to find the cloud-init %s datasource marker", data.Source)
 		}
 	} else {
-		return err
+		return nil, err
 	}
 
+	return data, nil
+}
+
+var errNoLinkConfig = errors.New("unable to find configuration")
+
+// How long to wait for a DHCP lease
+const dhcpTimeout = 30 * time.Second
+
+// readNetworkConfig reads the network configuration from the cloud-init datasource.
+func readNetworkConfig() (*cloudinit.NetworkConfig, error) {
+	data, err := readCloudInitData()
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Network == nil {
+		return nil, fmt.Errorf("cloud-init network-config is empty")
+	}
+
+	for _, w := range data.Network.Warnings {
+		log.Warnf("network-config: %s", w)
+	}
+
+	return data.Network, nil
+}
+
+// netinitConfigureInterface configures the physical link and returns
+// its name, which may be changed by the set-name option.
+// If withDHCP is set and there is no cloud-init datasource,
+// the link is configured using the built-in DHCP client.
+func netinitConfigureInterface(ifname string, withDHCP bool) (string, error) {
 	link, err := netlink.LinkByName(ifname)
 	if err != nil {
-		return os.NewSyscallError("rtnetlink: not found", err)
+		return "", os.NewSyscallError("rtnetlink: not found", err)
 	}
 
 	if link.Type() != "device" {
-		return fmt.Errorf("not a physical device: %s", ifname)
+		return "", fmt.Errorf("not a physical device: %s", ifname)
+	}
+
+	netconf, err := readNetworkConfig()
+	if err != nil {
+		if withDHCP {
+			log.Warnf("%s: falling back to DHCP", err)
+
+			return ifname, netinitConfigureDHCP(ifname)
+		}
+
+		return "", err
+	}
+
+	return netinitConfigurePhysicalLink(netconf, link, withDHCP)
+}
+
+// netinitConfigureAll configures every physical link that has a configuration
+// in network-config. The links without configuration are skipped.
+// If withDHCP is set and there is no cloud-init datasource,
+// all physical links are configured using the built-in DHCP client.
+// It returns the names of the configured links.
+func netinitConfigureAll(withDHCP bool) ([]string, error) {
+	netconf, err := readNetworkConfig()
+	if err != nil {
+		if !withDHCP {
+			return nil, err
+		}
+
+		log.Warnf("%s: falling back to DHCP", err)
+	}
+
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	var configured []string
+	var failed int
+
+	for _, link := range links {
+		if link.Type() != "device" || link.Attrs().Flags&net.FlagLoopback != 0 {
+			continue
+		}
+
+		var ifname string
+
+		if netconf != nil {
+			ifname, err = netinitConfigurePhysicalLink(netconf, link, withDHCP)
+		} else {
+			ifname, err = link.Attrs().Name, netinitConfigureDHCP(link.Attrs().Name)
+		}
+
+		switch {
+		case errors.Is(err, errNoLinkConfig):
+			log.Debugf("skipping %s: %s", link.Attrs().Name, err)
+		case err != nil:
+			log.Errorf("failed to configure %s: %s", link.Attrs().Name, err)
+			failed++
+		default:
+			configured = append(configured, ifname)
+		}
+	}
+
+	if failed > 0 {
+		return configured, fmt.Errorf("failed to configure %d interface(s)", failed)
+	}
+
+	return configured, nil
+}
+
+func netinitConfigurePhysicalLink(netconf *cloudinit.NetworkConfig, link netlink.Link, withDHCP bool) (string, error) {
+	ifname := link.Attrs().Name
+
+	// Cloud-Init conf
+	log.Debugf("looking for cloud-init configuration for %s (mac = %s)", ifname, link.Attrs().HardwareAddr)
+
+	id, iconf := netconf.MatchEthernet(ifname, link.Attrs().HardwareAddr.String(), getLinkDriver(ifname))
+	if iconf == nil {
+		return "", fmt.Errorf("%w for %s", errNoLinkConfig, ifname)
+	}
+
+	log.Debugf("using configuration %q for %s", id, ifname)
+
+	if len(iconf.SetName) > 0 && iconf.SetName != ifname {
+		log.Debugf("renaming interface %s to %s", ifname, iconf.SetName)
+
+		if err := core.SetInterfaceLinkDown(ifname); err != nil {
+			return "", fmt.Errorf("failed to change the link state: %w", err)
+		}
+
+		if err := core.SetLinkAttributes(ifname, &core.LinkAttrs{Name: iconf.SetName}); err != nil {
+			return "", fmt.Errorf("failed to rename %s: %w", ifname, err)
+		}
+
+		ifname = iconf.SetName
+	}
+
+	if err := netinitConfigureLink(ifname, &iconf.DeviceConfig, withDHCP); err != nil {
+		return "", err
+	}
+
+	if ns := netconf.Nameservers; ns != nil {
+		log.Debugf("configuring global nameservers: %s (search: %s)", ns.Addresses, ns.Search)
+
+		if err := updateResolvConf(ifname, ns.Addresses, ns.Search); err != nil {
+			return "", fmt.Errorf("failed to configure nameservers: %w", err)
+		}
+	}
+
+	if err := netinitConfigureVirtualLinks(netconf, id, ifname, make(map[string]struct{}), withDHCP); err != nil {
+		return "", err
+	}
+
+	return ifname, nil
+}
+
+// netinitConfigureVirtualLinks creates and configures the vlans on top of
+// the link with the given ID and the bonds/bridges the link is a member of.
+// Then it does the same for every such virtual link.
+func netinitConfigureVirtualLinks(netconf *cloudinit.NetworkConfig, id, ifname string, seen map[string]struct{}, withDHCP bool) error {
+	if _, ok := seen[id]; ok {
+		return fmt.Errorf("circular dependency between the virtual links: %s", id)
+	}
+	seen[id] = struct{}{}
+
+	var configured []string
+
+	for name, v := range netconf.Vlans {
+		if v.Link != id {
+			continue
+		}
+
+		attrs := core.VirtualLinkAttrs{
+			Name:   name,
+			Type:   "vlan",
+			Parent: ifname,
+			VlanID: v.ID,
+		}
+
+		if err := netinitCreateLink(&attrs); err != nil {
+			return err
+		}
+
+		if err := netinitConfigureLink(name, &v.DeviceConfig, withDHCP); err != nil {
+			return err
+		}
+
+		configured = append(configured, name)
+	}
+
+	for name, b := range netconf.Bonds {
+		if !contains(b.Interfaces, id) {
+			continue
+		}
+
+		attrs := core.VirtualLinkAttrs{
+			Name: name,
+			Type: "bond",
+		}
+
+		if p := b.Parameters; p != nil {
+			attrs.BondMode = p.Mode
+			attrs.MiiMon = p.MiiMonitorInterval
+			attrs.LacpRate = p.LacpRate
+			attrs.XmitHashPolicy = p.TransmitHashPolicy
+			attrs.UpDelay = p.UpDelay
+			attrs.DownDelay = p.DownDelay
+		}
+
+		if err := netinitCreateLink(&attrs); err != nil {
+			return err
+		}
+
+		log.Debugf("adding %s to bond %s", ifname, name)
+
+		if err := core.SetLinkMaster(ifname, name); err != nil {
+			return fmt.Errorf("failed to add %s to bond %s: %w", ifname, name, err)
+		}
+
+		if err := netinitConfigureLink(name, &b.DeviceConfig, withDHCP); err != nil {
+			return err
+		}
+
+		configured = append(configured, name)
+	}
+
+	for name, b := range netconf.Bridges {
+		if !contains(b.Interfaces, id) {
+			continue
+		}
+
+		attrs := core.VirtualLinkAttrs{
+			Name: name,
+			Type: "bridge",
+			STP:  b.Parameters.STPEnabled(),
+		}
+
+		if b.Parameters != nil {
+			attrs.ForwardDelay = b.Parameters.ForwardDelay
+		}
+
+		if err := netinitCreateLink(&attrs); err != nil {
+			return err
+		}
+
+		log.Debugf("adding %s to bridge %s", ifname, name)
+
+		if err := core.SetLinkMaster(ifname, name); err != nil {
+			return fmt.Errorf("failed to add %s to bridge %s: %w", ifname, name, err)
+		}
+
+		if err := netinitConfigureLink(name, &b.DeviceConfig, withDHCP); err != nil {
+			return err
+		}
+
+		configured = append(configured, name)
+	}
+
+	for _, name := range configured {
+		// For the virtual links the ID is the interface name
+		if err := netinitConfigureVirtualLinks(netconf, name, name, seen, withDHCP); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// netinitCreateLink creates the virtual link unless it already exists.
+func netinitCreateLink(attrs *core.VirtualLinkAttrs) error {
+	if link, err := netlink.LinkByName(attrs.Name); err == nil {
+		if link.Type() != attrs.Type {
+			return fmt.Errorf("link %s already exists and has type %q instead of %q", attrs.Name, link.Type(), attrs.Type)
+		}
+
+		return nil
+	}
+
+	log.Debugf("creating %s link %s", attrs.Type, attrs.Name)
+
+	if err := core.CreateLink(attrs); err != nil {
+		return fmt.Errorf("failed to create link %s: %w", attrs.Name, err)
+	}
+
+	return nil
+}
+
+// netinitConfigureLink brings the link up and applies the device configuration:
+// link attributes, IP addresses, gateways, routes, routing policy and nameservers.
+// If withDHCP is set, the built-in DHCP client is used for dhcp4/dhcp6.
+func netinitConfigureLink(ifname string, iconf *cloudinit.DeviceConfig, withDHCP bool) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink: not found", err)
 	}
 
 	assigned, err := func() (map[string]struct{}, error) {
@@ -48,29 +357,22 @@ func netinitConfigureInterface(ifname string) error {
 		return fmt.Errorf("unable to get a list of assigned IP addresses: %s", ifname)
 	}
 
-	// Cloud-Init conf
-	log.Debugf("looking for cloud-init configuration for %s (mac = %s)", ifname, link.Attrs().HardwareAddr)
+	attrs := core.LinkAttrs{}
 
-	iconf, err := func() (*cloudinit.EthernetConfig, error) {
-		data, err := cloudinit.ReadData()
-		if err != nil {
-			return nil, err
-		}
+	if iconf.MTU > 0 && iconf.MTU != link.Attrs().MTU {
+		attrs.MTU = iconf.MTU
+	}
 
-		if data.Network == nil {
-			return nil, fmt.Errorf("cloud-init network-config is empty")
-		}
+	if len(iconf.MacAddress) > 0 && !strings.EqualFold(iconf.MacAddress, link.Attrs().HardwareAddr.String()) {
+		attrs.HwAddr = iconf.MacAddress
+	}
 
-		for _, v := range data.Network.Ethernets {
-			if v.Match.MacAddress == link.Attrs().HardwareAddr.String() {
-				return &v, nil
-			}
-		}
+	if attrs.MTU > 0 || len(attrs.HwAddr) > 0 {
+		log.Debugf("changing link attributes of %s (mtu = %d, mac = %s)", ifname, attrs.MTU, attrs.HwAddr)
 
-		return nil, fmt.Errorf("unable to find configuration for %s by mac-address", ifname)
-	}()
-	if err != nil {
-		return err
+		if err := core.SetLinkAttributes(ifname, &attrs); err != nil {
+			return fmt.Errorf("failed to change link attributes: %w", err)
+		}
 	}
 
 	log.Debugf("bringing interface %s up", ifname)
@@ -79,6 +381,26 @@ func netinitConfigureInterface(ifname string) error {
 		return fmt.Errorf("failed to change the link state: %w", err)
 	}
 
+	if iconf.DHCP4 {
+		if withDHCP {
+			if err := netinitAcquireLease(ifname, 4, dhcpTimeout); err != nil {
+				return err
+			}
+		} else {
+			log.Warnf("dhcp4 is enabled for %s, but the built-in DHCP client is disabled: leaving it to the guest network service", ifname)
+		}
+	}
+
+	if iconf.DHCP6 {
+		if withDHCP {
+			if err := netinitAcquireLease(ifname, 6, dhcpTimeout); err != nil {
+				return err
+			}
+		} else {
+			log.Warnf("dhcp6 is enabled for %s, but the built-in DHCP client is disabled: only SLAAC addresses will be configured", ifname)
+		}
+	}
+
 	var ip4addrs, ip6addrs []*net.IPNet
 
 	// IP addresses
@@ -96,7 +418,7 @@ func netinitConfigureInterface(ifname string) error {
 			ip6addrs = append(ip6addrs, ipnet)
 		}
 
-		if _, ok := assigned[ipstr]; ok {
+		if _, ok := assigned[ipnet.String()]; ok {
 			log.Debugf("address already assigned: %s", ipstr)
 		} else {
 			log.Debugf("assigning an IP address: %s", ipstr)
@@ -113,11 +435,23 @@ func netinitConfigureInterface(ifname string) error {
 
 		if ones, _ := ip4addrs[0].Mask.Size(); ones == 32 {
 			// link route
-			if err := core.UpdateRouteTable("replace", link, iconf.Gateway4+"/32", "", "", netlink.SCOPE_LINK, 0); err != nil {
+			attrs := core.RouteAttrs{
+				LinkName: ifname,
+				Dst:      iconf.Gateway4 + "/32",
+				Scope:    netlink.SCOPE_LINK,
+			}
+
+			if err := core.UpdateRouteTable("replace", &attrs); err != nil {
 				return fmt.Errorf("failed to add a link route (%s via device %s): %w", iconf.Gateway4, ifname, err)
 			}
 		}
-		if err := core.UpdateRouteTable("replace", link, "0.0.0.0/0", "", iconf.Gateway4, 0, 0); err != nil {
+		attrs := core.RouteAttrs{
+			LinkName: ifname,
+			Dst:      "0.0.0.0/0",
+			Gw:       iconf.Gateway4,
+		}
+
+		if err := core.UpdateRouteTable("replace", &attrs); err != nil {
 			return fmt.Errorf("failed to add default route: %w", err)
 		}
 	}
@@ -126,7 +460,13 @@ func netinitConfigureInterface(ifname string) error {
 	if len(ip6addrs) > 0 && len(iconf.Gateway6) > 0 {
 		log.Debugf("configuring an IPv6 gateway via %s", iconf.Gateway6)
 
-		if err := core.UpdateRouteTable("replace", link, "::/0", "", iconf.Gateway6, 0, 0); err != nil {
+		attrs := core.RouteAttrs{
+			LinkName: ifname,
+			Dst:      "::/0",
+			Gw:       iconf.Gateway6,
+		}
+
+		if err := core.UpdateRouteTable("replace", &attrs); err != nil {
 			return fmt.Errorf("failed to add default route: %w", err)
 		}
 	}
@@ -135,17 +475,63 @@ func netinitConfigureInterface(ifname string) error {
 	for _, r := range iconf.Routes {
 		log.Debugf("adding a route: %s via %s", r.To, r.Via)
 
-		if err := core.UpdateRouteTable("add", link, r.To, "", r.Via, 0, 0); err != nil {
+		attrs := core.RouteAttrs{
+			LinkName: ifname,
+			Dst:      r.Destination(),
+			Gw:       r.Via,
+			Src:      r.From,
+			Priority: r.Metric,
+			Table:    r.Table,
+			MTU:      r.MTU,
+			OnLink:   r.OnLink,
+			Type:     routeTypes[r.Type],
+			Scope:    routeScopes[r.Scope],
+		}
+
+		if err := core.UpdateRouteTable("replace", &attrs); err != nil {
 			log.Errorf("failed to add route: %s", err)
 		}
 	}
 
+	// Routing policy
+	for _, p := range iconf.RoutingPolicy {
+		log.Debugf("adding a routing policy rule: from %s to %s lookup %d", p.From, p.To, p.Table)
+
+		attrs := core.RuleAttrs{
+			Priority: p.Priority,
+			Src:      p.From,
+			Dst:      p.To,
+			Mark:     p.Mark,
+			Table:    p.Table,
+		}
+
+		if err := core.UpdateRuleList("add", &attrs); err != nil && !errors.Is(err, unix.EEXIST) {
+			log.Errorf("failed to add routing policy rule: %s", err)
+		}
+	}
+
+	// Nameservers
+	if ns := iconf.Nameservers; ns != nil && (len(ns.Addresses) > 0 || len(ns.Search) > 0) {
+		log.Debugf("configuring nameservers for %s: %s (search: %s)", ifname, ns.Addresses, ns.Search)
+
+		if err := updateResolvConf(ifname, ns.Addresses, ns.Search); err != nil {
+			return fmt.Errorf("failed to configure nameservers: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func netinitDeconfigureInterface(ifname string) error {
 	link, err := netlink.LinkByName(ifname)
 	if err != nil {
+		if errors.As(err, new(netlink.LinkNotFoundError)) {
+			// E.g. the device has been unplugged
+			log.Debugf("interface %s not found, nothing to do", ifname)
+
+			return nil
+		}
+
 		return os.NewSyscallError("rtnetlink: not found", err)
 	}
 
@@ -153,22 +539,164 @@ func netinitDeconfigureInterface(ifname string) error {
 		return fmt.Errorf("not a physical device: %s", ifname)
 	}
 
+	// Must be done while the link is still up
+	if err := core.ReleaseDHCPLeases(ifname); err != nil {
+		return fmt.Errorf("failed to release DHCP leases: %w", err)
+	}
+
+	netconf, err := readNetworkConfig()
+	if err != nil {
+		log.Debugf("%s: leaving the rest of %s configuration as is", err, ifname)
+
+		return nil
+	}
+
+	_, iconf := netconf.MatchEthernet(ifname, link.Attrs().HardwareAddr.String(), getLinkDriver(ifname))
+	if iconf == nil {
+		log.Debugf("no cloud-init configuration for %s: leaving it as is", ifname)
+
+		return nil
+	}
+
+	return netinitDeconfigureLink(link, &iconf.DeviceConfig)
+}
+
+// netinitDeconfigureLink removes the routing policy rules, routes and IP addresses
+// added by netinitConfigureLink. The foreign ones are left alone, and the link
+// is brought down only if no other addresses remain on it.
+func netinitDeconfigureLink(link netlink.Link, iconf *cloudinit.DeviceConfig) error {
+	ifname := link.Attrs().Name
+
+	// The objects may have already been removed by someone else
+	ignoreMissing := func(err error) error {
+		if errors.Is(err, unix.ESRCH) || errors.Is(err, unix.ENOENT) || errors.Is(err, unix.EADDRNOTAVAIL) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, p := range iconf.RoutingPolicy {
+		log.Debugf("removing a routing policy rule: from %s to %s lookup %d", p.From, p.To, p.Table)
+
+		attrs := core.RuleAttrs{
+			Priority: p.Priority,
+			Src:      p.From,
+			Dst:      p.To,
+			Mark:     p.Mark,
+			Table:    p.Table,
+		}
+
+		if err := ignoreMissing(core.UpdateRuleList("del", &attrs)); err != nil {
+			return fmt.Errorf("failed to remove routing policy rule: %w", err)
+		}
+	}
+
+	routes := make([]*core.RouteAttrs, 0, len(iconf.Routes)+3)
+
+	for _, r := range iconf.Routes {
+		routes = append(routes, &core.RouteAttrs{
+			LinkName: ifname,
+			Dst:      r.Destination(),
+			Gw:       r.Via,
+			Src:      r.From,
+			Priority: r.Metric,
+			Table:    r.Table,
+			Type:     routeTypes[r.Type],
+			Scope:    routeScopes[r.Scope],
+		})
+	}
+
+	if len(iconf.Gateway4) > 0 {
+		routes = append(routes,
+			&core.RouteAttrs{LinkName: ifname, Dst: "0.0.0.0/0", Gw: iconf.Gateway4},
+			&core.RouteAttrs{LinkName: ifname, Dst: iconf.Gateway4 + "/32", Scope: netlink.SCOPE_LINK},
+		)
+	}
+
+	if len(iconf.Gateway6) > 0 {
+		routes = append(routes, &core.RouteAttrs{LinkName: ifname, Dst: "::/0", Gw: iconf.Gateway6})
+	}
+
+	for _, attrs := range routes {
+		log.Debugf("removing a route: %s via %s", attrs.Dst, attrs.Gw)
+
+		if err := ignoreMissing(core.UpdateRouteTable("del", attrs)); err != nil {
+			return fmt.Errorf("failed to remove route: %w", err)
+		}
+	}
+
+	for _, ipstr := range iconf.Addresses {
+		log.Debugf("removing an IP address: %s", ipstr)
+
+		if err := ignoreMissing(core.UpdateAddrList("del", ifname, ipstr)); err != nil {
+			return fmt.Errorf("failed to remove: %w", err)
+		}
+	}
+
+	remaining, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	for _, addr := range remaining {
+		if addr.IP.To4() != nil || !addr.IP.IsLinkLocalUnicast() {
+			log.Debugf("interface %s still has foreign addresses, leaving it up", ifname)
+
+			return nil
+		}
+	}
+
 	log.Debugf("bringing interface %s down", ifname)
 
 	if err := core.SetInterfaceLinkDown(ifname); err != nil {
 		return fmt.Errorf("failed to change the link state: %w", err)
 	}
 
-	assigned, err := netlink.AddrList(link, 0)
-	if err != nil {
+	return nil
+}
+
+// netinitConfigureDHCP brings the link up and configures it using
+// the built-in DHCP client. DHCPv6 is often not available,
+// so it is tried for a shorter time and its failure is not an error.
+func netinitConfigureDHCP(ifname string) error {
+	log.Debugf("bringing interface %s up", ifname)
+
+	if err := core.SetInterfaceLinkUp(ifname); err != nil {
+		return fmt.Errorf("failed to change the link state: %w", err)
+	}
+
+	if err := netinitAcquireLease(ifname, 4, dhcpTimeout); err != nil {
 		return err
 	}
 
-	for _, addr := range assigned {
-		log.Debugf("removing an IP address: %s", addr.IPNet)
+	if err := netinitAcquireLease(ifname, 6, dhcpTimeout/3); err != nil {
+		log.Warnf("%s", err)
+	}
 
-		if err := core.UpdateAddrList("del", ifname, addr.IPNet.String()); err != nil {
-			return fmt.Errorf("failed to remove: %w", err)
+	return nil
+}
+
+// netinitAcquireLease obtains a lease using the built-in DHCP client
+// and configures the nameservers. The lease is then renewed by the agent.
+func netinitAcquireLease(ifname string, family int, timeout time.Duration) error {
+	log.Debugf("DHCPv%d: requesting a lease for %s", family, ifname)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	lease, err := core.AcquireDHCPLease(ctx, ifname, family)
+	if err != nil {
+		return fmt.Errorf("DHCPv%d: failed to obtain a lease for %s: %w", family, ifname, err)
+	}
+
+	log.Infof("DHCPv%d: %s configured on %s", family, lease.Addr, ifname)
+
+	if len(lease.DNS) > 0 || len(lease.Search) > 0 {
+		log.Debugf("configuring nameservers for %s: %s (search: %s)", ifname, lease.DNS, lease.Search)
+
+		if err := updateResolvConf(ifname, lease.DNS, lease.Search); err != nil {
+			return fmt.Errorf("failed to configure nameservers: %w", err)
 		}
 	}
 
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+var routeTypes = map[string]int{
+	"":            0,
+	"unicast":     unix.RTN_UNICAST,
+	"blackhole":   unix.RTN_BLACKHOLE,
+	"unreachable": unix.RTN_UNREACHABLE,
+	"prohibit":    unix.RTN_PROHIBIT,
+}
+
+var routeScopes = map[string]netlink.Scope{
+	"":       netlink.SCOPE_UNIVERSE,
+	"global": netlink.SCOPE_UNIVERSE,
+	"link":   netlink.SCOPE_LINK,
+	"host":   netlink.SCOPE_HOST,
+}
+
+// getLinkDriver returns the name of the kernel driver of the physical device
+// or an empty string if it cannot be determined.
+func getLinkDriver(ifname string) string {
+	target, err := os.Readlink(filepath.Join("/sys/class/net", ifname, "device/driver"))
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Base(target)
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateResolvConf configures the nameservers and the search domains.
+// If systemd-resolved manages /etc/resolv.conf, they are set for the interface
+// using resolvectl. Otherwise the missing entries are added to /etc/resolv.conf.
+func updateResolvConf(ifname string, servers, search []string) error {
+	if target, err := os.Readlink("/etc/resolv.conf"); err == nil && strings.Contains(target, "/run/systemd/resolve/") {
+		if len(servers) > 0 {
+			if out, err := exec.Command("resolvectl", append([]string{"dns", ifname}, servers...)...).CombinedOutput(); err != nil {
+				return fmt.Errorf("resolvectl dns failed (%s): %s", err, bytes.TrimSpace(out))
+			}
+		}
+
+		if len(search) > 0 {
+			if out, err := exec.Command("resolvectl", append([]string{"domain", ifname}, search...)...).CombinedOutput(); err != nil {
+				return fmt.Errorf("resolvectl domain failed (%s): %s", err, bytes.TrimSpace(out))
+			}
+		}
+
+		return nil
+	}
+
+	b, err := os.ReadFile("/etc/resolv.conf")
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	newb := mergeResolvConf(b, servers, search)
+
+	if bytes.Equal(b, newb) {
+		return nil
+	}
+
+	// Written in place so as not to replace the symlink if any
+	return os.WriteFile("/etc/resolv.conf", newb, 0644)
+}
+
+// mergeResolvConf adds the nameservers and the search domains
+// that are missing in the resolv.conf data.
+func mergeResolvConf(b []byte, servers, search []string) []byte {
+	var lines, curServers, curSearch []string
+
+	searchIdx := -1
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+
+		if len(fields) > 1 {
+			switch fields[0] {
+			case "nameserver":
+				curServers = append(curServers, fields[1])
+			case "search", "domain":
+				if searchIdx == -1 {
+					searchIdx = len(lines)
+				}
+				curSearch = append(curSearch, fields[1:]...)
+				// Will be replaced by the merged line
+				continue
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	for _, s := range search {
+		if !contains(curSearch, s) {
+			curSearch = append(curSearch, s)
+		}
+	}
+
+	if len(curSearch) > 0 {
+		searchLine := "search " + strings.Join(curSearch, " ")
+
+		if searchIdx == -1 {
+			lines = append(lines, searchLine)
+		} else {
+			lines = append(lines[:searchIdx], append([]string{searchLine}, lines[searchIdx:]...)...)
+		}
+	}
+
+	for _, s := range servers {
+		if !contains(curServers, s) {
+			lines = append(lines, "nameserver "+s)
+			curServers = append(curServers, s)
+		}
+	}
+
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
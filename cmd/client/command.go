@@ -3,11 +3,16 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/0xef53/phoenix-guest-agent/cert"
 	"github.com/0xef53/phoenix-guest-agent/client"
+
+	pb_diagnostics "github.com/0xef53/phoenix-guest-agent/api/services/diagnostics/v2"
 )
 
 func ExecuteCommand(args []string) error {
@@ -59,16 +64,295 @@ func ExecuteCommand(args []string) error {
 		return client.ShowRouteList(ctx, "4")
 	case argsMatch("ip -6 r|ro|route l|list", args):
 		return client.ShowRouteList(ctx, "6")
+	case argsMatch("ip ru|rule l|list|s|show", args):
+		return client.ShowRuleList(ctx, "")
+	case argsMatch("ip -4|-6 ru|rule l|list|s|show", args):
+		return client.ShowRuleList(ctx, args[1][1:])
+	case len(args) > 2 && argsMatch("ip ru|rule add|del", args[:3]):
+		return client.UpdateRuleList(ctx, args[2], "", args[3:])
+	case len(args) > 3 && argsMatch("ip -4|-6 ru|rule add|del", args[:4]):
+		return client.UpdateRuleList(ctx, args[3], args[1][1:], args[4:])
+	case argsMatch("ip n|neigh|neighbor s|show|l|list", args):
+		return client.ShowNeighbors(ctx, "", "")
+	case argsMatch("ip -4|-6 n|neigh|neighbor s|show|l|list", args):
+		return client.ShowNeighbors(ctx, args[1][1:], "")
+	case argsMatch("ip n|neigh|neighbor s|show|l|list dev IFNAME", args, 4):
+		return client.ShowNeighbors(ctx, "", args[4])
+	case argsMatch("ip -4|-6 n|neigh|neighbor s|show|l|list dev IFNAME", args, 5):
+		return client.ShowNeighbors(ctx, args[1][1:], args[5])
+	case argsMatch("ip n|neigh|neighbor add|replace ADDR lladdr HWADDR dev IFNAME", args, 3, 5, 7):
+		return client.UpdateNeighbors(ctx, args[2], args[3], args[5], args[7])
+	case argsMatch("ip n|neigh|neighbor del ADDR dev IFNAME", args, 3, 5):
+		return client.UpdateNeighbors(ctx, args[2], args[3], "", args[5])
+	case argsMatch("ip n|neigh|neighbor flush dev IFNAME", args, 4):
+		return client.FlushNeighbors(ctx, "", args[4])
+	case argsMatch("ip -4|-6 n|neigh|neighbor flush dev IFNAME", args, 5):
+		return client.FlushNeighbors(ctx, args[1][1:], args[5])
+	case len(args) > 1 && argsMatch("ip monitor", args[:2]):
+		return client.WatchNetwork(ctx, args[2:])
 	case argsMatch("ip a|addr s|show", args):
-		return client.ShowInterfaces(ctx)
+		return client.ShowInterfaces(ctx, false)
+	case argsMatch("ip -s a|addr s|show", args):
+		return client.ShowInterfaces(ctx, true)
 	case argsMatch("ip addr add|del ADDR dev IFNAME", args, 3, 5):
-		return client.UpdateInterfaceAddrList(ctx, args[2], args[3], args[5])
+		return client.UpdateInterfaceAddrList(ctx, args[2], args[3], args[5], false)
+	case argsMatch("ip addr add|del ADDR dev IFNAME persist", args, 3, 5):
+		return client.UpdateInterfaceAddrList(ctx, args[2], args[3], args[5], true)
 	case argsMatch("ip l|link set up|down dev IFNAME", args, 5):
 		return client.UpdateInterfaceLinkState(ctx, args[3], args[5])
-	case argsMatch("ip r|ro|route add|del PREFIX via ADDR dev IFNAME", args, 3, 5, 7):
-		return client.UpdateRouteTable(ctx, args[2], args[3], args[5], args[7])
-	case argsMatch("ip r|ro|route add|del PREFIX dev IFNAME", args, 3, 5):
-		return client.UpdateRouteTable(ctx, args[2], args[3], "", args[5])
+	case len(args) > 4 && argsMatch("ip l|link set dev IFNAME", args[:5], 4):
+		return client.SetLinkAttributes(ctx, args[4], args[5:])
+	case len(args) > 3 && argsMatch("ip l|link add IFNAME", args[:4], 3):
+		return client.CreateLink(ctx, args[3], args[4:])
+	case argsMatch("ip l|link del|delete IFNAME", args, 3):
+		return client.DeleteLink(ctx, args[3])
+	case argsMatch("ip l|link del|delete dev IFNAME", args, 4):
+		return client.DeleteLink(ctx, args[4])
+	case len(args) > 3 && argsMatch("ip r|ro|route add|del|replace", args[:3]):
+		return client.UpdateRouteTable(ctx, args[2], "", args[3:])
+	case len(args) > 4 && argsMatch("ip -4|-6 r|ro|route add|del|replace", args[:4]):
+		return client.UpdateRouteTable(ctx, args[3], args[1][1:], args[4:])
+	case len(args) > 1 && args[0] == "netconfig" && args[1] == "apply":
+		var timeout uint
+
+		applyCmd := flag.NewFlagSet("", flag.ExitOnError)
+		applyCmd.UintVar(&timeout, "timeout", timeout, "seconds to wait for confirmation before rolling back (default 120)")
+		applyCmd.Parse(args[2:])
+
+		if applyCmd.NArg() != 1 {
+			break
+		}
+
+		return client.ApplyNetworkConfig(ctx, applyCmd.Arg(0), uint32(timeout))
+	case argsMatch("netconfig confirm ID", args, 2):
+		return client.ConfirmNetworkConfig(ctx, args[2])
+	case argsMatch("firewall show", args):
+		return client.ShowFirewallRuleset(ctx)
+	case len(args) > 1 && args[0] == "firewall" && args[1] == "apply":
+		var timeout uint
+
+		applyCmd := flag.NewFlagSet("", flag.ExitOnError)
+		applyCmd.UintVar(&timeout, "timeout", timeout, "seconds to wait for confirmation before rolling back (default 120)")
+		applyCmd.Parse(args[2:])
+
+		if applyCmd.NArg() != 1 {
+			break
+		}
+
+		return client.ApplyFirewallRuleset(ctx, applyCmd.Arg(0), uint32(timeout))
+	case argsMatch("firewall confirm ID", args, 2):
+		return client.ConfirmFirewallRuleset(ctx, args[2])
+	case argsMatch("wg show IFNAME", args, 2):
+		return client.ShowWireguardDevice(ctx, args[2])
+	case len(args) > 3 && argsMatch("wg set IFNAME", args[:3], 2):
+		return client.ConfigureWireguard(ctx, args[2], args[3:])
+	case argsMatch("tc qdisc show dev IFNAME", args, 4):
+		return client.ShowQdiscs(ctx, args[4])
+	case argsMatch("tc class show dev IFNAME", args, 4):
+		return client.ShowTrafficClasses(ctx, args[4])
+	case argsMatch("tc filter show dev IFNAME", args, 4):
+		return client.ShowTrafficFilters(ctx, args[4])
+	case len(args) > 6 && argsMatch("tc qdisc replace dev IFNAME root", args[:6], 4):
+		return client.SetRootQdisc(ctx, args[4], args[6:])
+	case argsMatch("tc qdisc del dev IFNAME root", args, 4):
+		return client.DeleteRootQdisc(ctx, args[4])
+	case args[0] == "ss":
+		var tcp, udp, unix, listening bool
+
+		ssCmd := flag.NewFlagSet("", flag.ExitOnError)
+		ssCmd.BoolVar(&tcp, "t", tcp, "show TCP sockets")
+		ssCmd.BoolVar(&udp, "u", udp, "show UDP sockets")
+		ssCmd.BoolVar(&unix, "x", unix, "show UNIX sockets")
+		ssCmd.BoolVar(&listening, "l", listening, "show only listening sockets")
+		ssCmd.Parse(args[1:])
+
+		if ssCmd.NArg() != 0 {
+			break
+		}
+
+		return client.ShowSockets(ctx, tcp, udp, unix, listening)
+
+	// storage
+	case argsMatch("lvm pvs", args):
+		return client.ShowPhysicalVolumes(ctx)
+	case argsMatch("lvm vgs", args):
+		return client.ShowVolumeGroups(ctx)
+	case argsMatch("lvm lvs", args):
+		return client.ShowLogicalVolumes(ctx)
+	case argsMatch("lvm pvresize DEVICE", args, 2):
+		return client.ResizePhysicalVolume(ctx, args[2])
+	case len(args) > 1 && args[0] == "lvm" && args[1] == "lvextend":
+		var size string
+		var resizeFS bool
+
+		lvcmd := flag.NewFlagSet("", flag.ExitOnError)
+		lvcmd.StringVar(&size, "L", size, "new size of the volume (all free space if not set)")
+		lvcmd.BoolVar(&resizeFS, "r", resizeFS, "resize the underlying filesystem together with the volume")
+		lvcmd.Parse(args[2:])
+
+		if lvcmd.NArg() != 1 {
+			break
+		}
+
+		return client.ExtendLogicalVolume(ctx, lvcmd.Arg(0), size, resizeFS)
+	case len(args) > 1 && args[0] == "luks" && args[1] == "open":
+		var keyfile string
+		var readOnly bool
+
+		luksCmd := flag.NewFlagSet("", flag.ExitOnError)
+		luksCmd.StringVar(&keyfile, "key-file", "-", "read the key from a file (\"-\" means stdin)")
+		luksCmd.BoolVar(&readOnly, "readonly", readOnly, "set up a read-only mapping")
+		luksCmd.Parse(args[2:])
+
+		if luksCmd.NArg() != 2 {
+			break
+		}
+
+		return client.UnlockEncryptedVolume(ctx, luksCmd.Arg(0), luksCmd.Arg(1), keyfile, readOnly)
+	case argsMatch("luks close NAME", args, 2):
+		return client.LockEncryptedVolume(ctx, args[2])
+	case argsMatch("luks status NAME", args, 2):
+		return client.ShowEncryptedVolumeStatus(ctx, args[2])
+
+	// sysctl
+	case len(args) > 1 && args[0] == "sysctl":
+		var write, persist bool
+
+		sysctlCmd := flag.NewFlagSet("", flag.ExitOnError)
+		sysctlCmd.BoolVar(&write, "w", write, "change the values of the keys specified as KEY=VALUE")
+		sysctlCmd.BoolVar(&persist, "p", persist, "save the changed values to /etc/sysctl.d/99-phoenix-ga.conf")
+		sysctlCmd.Parse(args[1:])
+
+		if sysctlCmd.NArg() == 0 {
+			break
+		}
+
+		if write {
+			return client.SetSysctl(ctx, sysctlCmd.Args(), persist)
+		}
+
+		return client.ShowSysctl(ctx, sysctlCmd.Args())
+
+	// diagnostics
+	case args[0] == "ping":
+		var v4, v6 bool
+		var count, size, ttl uint
+		var interval, timeout time.Duration
+
+		pingCmd := flag.NewFlagSet("", flag.ExitOnError)
+		pingCmd.BoolVar(&v4, "4", v4, "use IPv4 only")
+		pingCmd.BoolVar(&v6, "6", v6, "use IPv6 only")
+		pingCmd.UintVar(&count, "c", count, "number of echo requests (default 4)")
+		pingCmd.DurationVar(&interval, "i", interval, "interval between the requests (default 1s)")
+		pingCmd.DurationVar(&timeout, "W", timeout, "time to wait for each reply (default 2s)")
+		pingCmd.UintVar(&size, "s", size, "number of data bytes to send (default 56)")
+		pingCmd.UintVar(&ttl, "t", ttl, "IP time to live")
+		pingCmd.Parse(args[1:])
+
+		if pingCmd.NArg() != 1 {
+			break
+		}
+
+		return client.Ping(ctx, &pb_diagnostics.PingRequest{
+			Host:       pingCmd.Arg(0),
+			Family:     ipFamily(v4, v6),
+			Count:      uint32(count),
+			IntervalMs: uint32(interval.Milliseconds()),
+			TimeoutMs:  uint32(timeout.Milliseconds()),
+			Size:       uint32(size),
+			Ttl:        uint32(ttl),
+		})
+	case argsMatch("lookup NAME", args, 1):
+		return client.LookupDNS(ctx, args[1], "")
+	case argsMatch("lookup NAME TYPE", args, 1, 2):
+		return client.LookupDNS(ctx, args[1], args[2])
+	case args[0] == "connect":
+		var v4, v6, udp bool
+		var count uint
+		var interval, timeout time.Duration
+
+		connectCmd := flag.NewFlagSet("", flag.ExitOnError)
+		connectCmd.BoolVar(&v4, "4", v4, "use IPv4 only")
+		connectCmd.BoolVar(&v6, "6", v6, "use IPv6 only")
+		connectCmd.BoolVar(&udp, "u", udp, "send UDP datagrams instead of TCP connection attempts")
+		connectCmd.UintVar(&count, "c", count, "number of attempts (default 4)")
+		connectCmd.DurationVar(&interval, "i", interval, "interval between the attempts (default 1s)")
+		connectCmd.DurationVar(&timeout, "W", timeout, "time to wait for each attempt (default 3s)")
+		connectCmd.Parse(args[1:])
+
+		if connectCmd.NArg() != 2 {
+			break
+		}
+
+		port, err := strconv.ParseUint(connectCmd.Arg(1), 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid port: %s", connectCmd.Arg(1))
+		}
+
+		network := "tcp"
+		if udp {
+			network = "udp"
+		}
+
+		return client.ProbeConnect(ctx, &pb_diagnostics.ProbeConnectRequest{
+			Network:    network,
+			Host:       connectCmd.Arg(0),
+			Port:       uint32(port),
+			Family:     ipFamily(v4, v6),
+			Count:      uint32(count),
+			IntervalMs: uint32(interval.Milliseconds()),
+			TimeoutMs:  uint32(timeout.Milliseconds()),
+		})
+	case args[0] == "traceroute":
+		var v4, v6 bool
+		var maxHops, queries, port uint
+		var timeout time.Duration
+
+		traceCmd := flag.NewFlagSet("", flag.ExitOnError)
+		traceCmd.BoolVar(&v4, "4", v4, "use IPv4 only")
+		traceCmd.BoolVar(&v6, "6", v6, "use IPv6 only")
+		traceCmd.UintVar(&maxHops, "m", maxHops, "max number of hops (default 30)")
+		traceCmd.UintVar(&queries, "q", queries, "number of probes per hop (default 3)")
+		traceCmd.UintVar(&port, "p", port, "destination port of the first probe (default 33434)")
+		traceCmd.DurationVar(&timeout, "w", timeout, "time to wait for each probe (default 1s)")
+		traceCmd.Parse(args[1:])
+
+		if traceCmd.NArg() != 1 {
+			break
+		}
+
+		return client.Traceroute(ctx, &pb_diagnostics.TracerouteRequest{
+			Host:      traceCmd.Arg(0),
+			Family:    ipFamily(v4, v6),
+			Port:      uint32(port),
+			MaxHops:   uint32(maxHops),
+			Queries:   uint32(queries),
+			TimeoutMs: uint32(timeout.Milliseconds()),
+		})
+	case args[0] == "capture":
+		var output string
+		var snaplen, count, duration uint
+
+		captureCmd := flag.NewFlagSet("", flag.ExitOnError)
+		captureCmd.StringVar(&output, "w", "-", "write the packets in pcapng format to a file (\"-\" means stdout)")
+		captureCmd.UintVar(&snaplen, "s", snaplen, "snapshot length (default 262144)")
+		captureCmd.UintVar(&count, "c", count, "stop after capturing this number of packets")
+		captureCmd.UintVar(&duration, "G", duration, "stop after this number of seconds (default 60 unless -c is set)")
+		captureCmd.Parse(args[1:])
+
+		if captureCmd.NArg() < 1 {
+			break
+		}
+
+		req := pb_diagnostics.CapturePacketsRequest{
+			LinkName:   captureCmd.Arg(0),
+			BpfFilter:  strings.Join(captureCmd.Args()[1:], " "),
+			Snaplen:    uint32(snaplen),
+			MaxPackets: uint32(count),
+			Duration:   uint32(duration),
+		}
+
+		return client.CapturePackets(ctx, &req, output)
 
 	// file system
 	case args[0] == "fs-sync":
@@ -122,6 +406,17 @@ func ExecuteCommand(args []string) error {
 
 // argsMatch checks whether s1 and s2 length and values match.
 // Values at positions in ignore are skipped from comparison.
+func ipFamily(v4, v6 bool) uint32 {
+	switch {
+	case v4 && !v6:
+		return 4
+	case v6 && !v4:
+		return 6
+	}
+
+	return 0
+}
+
 func argsMatch(s1 string, s2 []string, ignore ...int) bool {
 	f1 := strings.Fields(s1)
 	if len(f1) != len(s2) {
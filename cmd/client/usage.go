@@ -26,24 +26,183 @@ var usageContent = [][]string{
 		"start SSH connection to the built-in PGA Secure Shell Server",
 	},
 	{
-		"ip addr show",
+		"ip [-s] addr show",
 		"print summary info about network interfaces",
+		"(with -s the RX/TX counters are printed too)",
 	},
 	{
-		"ip addr add|del ADDR dev IFNAME",
+		"ip addr add|del ADDR dev IFNAME [persist]",
 		"add or remove IPv4/IPv6 address",
+		"(with persist the change is also saved to the guest network configuration;",
+		"the family of the default PREFIX without gateway must be given with -4 or -6)",
 	},
 	{
 		"ip link set up|down dev IFNAME",
 		"bring interface link up or down",
 	},
+	{
+		"ip link set dev IFNAME [mtu N] [address HWADDR] [name NEWNAME] [txqueuelen N] [alias NAME]",
+		"change interface link attributes (the link must be down to be renamed, an empty alias removes it)",
+	},
+	{
+		"ip link add IFNAME [link PARENT] [mtu N] [address HWADDR] [up] type vlan|macvlan|bond|bridge|dummy|wireguard [OPTIONS]",
+		"create virtual link, where OPTIONS are:",
+		"  vlan: id N [protocol 802.1q|802.1ad]",
+		"  macvlan: [mode private|vepa|bridge|passthru]",
+		"  bond: [mode MODE] [miimon N] [members IFNAME,...]",
+		"  bridge: [ports IFNAME,...]",
+	},
+	{
+		"ip link del IFNAME",
+		"remove virtual link",
+	},
 	{
 		"ip [-4|-6] route list",
 		"print the routing table entries",
 	},
 	{
-		"ip route add|del PREFIX via GWADDR dev IFNAME",
-		"add or remove route",
+		"ip [-4|-6] route add|del|replace [TYPE] PREFIX [via GWADDR] [dev IFNAME] [src ADDR] [metric N] [table N] [mtu N] [proto NAME|N] [onlink] [persist] [nexthop via GWADDR dev IFNAME weight N ...]",
+		"add, remove or replace route (TYPE is one of unicast, blackhole, unreachable, prohibit)",
+		"(with persist the change is also saved to the guest network configuration;",
+		"the family of the default PREFIX without gateway must be given with -4 or -6)",
+	},
+	{
+		"ip [-4|-6] rule list",
+		"print the routing policy rules",
+	},
+	{
+		"ip [-4|-6] rule add|del [not] [from PREFIX] [to PREFIX] [iif IFNAME] [oif IFNAME] [fwmark MARK[/MASK]] [priority N] [lookup TABLE]",
+		"add or remove routing policy rule",
+	},
+	{
+		"ip [-4|-6] neigh show [dev IFNAME]",
+		"print the neighbor (ARP/NDP) table entries",
+	},
+	{
+		"ip neigh add|replace ADDR lladdr HWADDR dev IFNAME",
+		"add or replace permanent neighbor entry",
+	},
+	{
+		"ip neigh del ADDR dev IFNAME",
+		"remove neighbor entry",
+	},
+	{
+		"ip [-4|-6] neigh flush dev IFNAME",
+		"remove all dynamic neighbor entries of the interface",
+	},
+	{
+		"ip monitor [link] [address] [route] [neigh]",
+		"watch for the network changes and print them as they happen",
+	},
+	{
+		"netconfig apply [--timeout SEC] FILE|-",
+		"apply the desired network state (links, addresses, routes, rules) from JSON file;",
+		"the previous state is restored unless confirmed within the timeout (the pending change",
+		"is lost if the agent restarts); only the routes over the listed links",
+		"and the rules added by this command are replaced",
+	},
+	{
+		"netconfig confirm ID",
+		"confirm the applied network state",
+	},
+	{
+		"firewall show",
+		"print the nftables ruleset in JSON format",
+	},
+	{
+		"firewall apply [--timeout SEC] FILE|-",
+		"replace the agent-owned nftables table (inet phoenix) with the ruleset from JSON file;",
+		"the previous table is restored unless confirmed within the timeout",
+	},
+	{
+		"firewall confirm ID",
+		"confirm the applied firewall ruleset",
+	},
+	{
+		"wg show IFNAME",
+		"print the WireGuard link configuration with the handshake and transfer stats of the peers",
+	},
+	{
+		"wg set IFNAME [listen-port N] [fwmark N] [private-key FILE|-] [replace-peers] [peer KEY [remove] [update-only] [preshared-key FILE|-] [endpoint HOST:PORT] [persistent-keepalive N|off] [allowed-ips PREFIX,...] ...]",
+		"change the WireGuard link configuration like wg(8); the keys are in base64 and read from files;",
+		"a large set of peers is applied in several steps, so replace-peers is not atomic",
+	},
+	{
+		"tc qdisc|class|filter show dev IFNAME",
+		"print the queueing disciplines, classes or filters of the link with their stats",
+	},
+	{
+		"tc qdisc replace dev IFNAME root tbf rate RATE burst SIZE [latency TIME|limit SIZE]",
+		"limit the egress rate with a token bucket filter; RATE is like 10mbit, TIME is like 50ms",
+	},
+	{
+		"tc qdisc replace dev IFNAME root htb rate RATE [ceil RATE]",
+		"limit the egress rate with a single htb class",
+	},
+	{
+		"tc qdisc replace dev IFNAME root fq_codel [limit N] [flows N] [interval TIME] [noecn]",
+		"set the fq_codel root qdisc",
+	},
+	{
+		"tc qdisc replace dev IFNAME root netem [delay TIME [JITTER]] [loss PCT] [duplicate PCT] [corrupt PCT] [reorder PCT] [limit N] [rate RATE]",
+		"emulate delay and packet loss for testing",
+	},
+	{
+		"tc qdisc del dev IFNAME root",
+		"restore the default root qdisc of the link",
+	},
+	{
+		"ss [-t] [-u] [-x] [-l]",
+		"print TCP, UDP or UNIX sockets with the processes using them (all types if none is specified)",
+	},
+	{
+		"lvm pvs|vgs|lvs",
+		"print LVM physical volumes, volume groups or logical volumes",
+	},
+	{
+		"lvm pvresize DEVICE",
+		"resize LVM physical volume to the size of the underlying device",
+	},
+	{
+		"lvm lvextend [-L SIZE[K|M|G|T]] [-r] VG/LV",
+		"extend LVM logical volume (with -r the filesystem is resized too)",
+	},
+	{
+		"luks open [--readonly] [--key-file FILE|-] DEVICE NAME",
+		"unlock LUKS device with a key passed from the host (only via VM sockets)",
+	},
+	{
+		"luks close|status NAME",
+		"lock LUKS device or print its status",
+	},
+	{
+		"sysctl KEY|PATTERN ...",
+		"print kernel parameters, e.g. net.ipv6.conf.*.accept_ra or vm",
+	},
+	{
+		"sysctl -w [-p] KEY=VALUE ...",
+		"change kernel parameters allowed by the agent (with -p they are also saved to sysctl.d)",
+	},
+	{
+		"ping [-4|-6] [-c COUNT] [-i INTERVAL] [-W TIMEOUT] [-s SIZE] [-t TTL] HOST",
+		"send ICMP echo requests from the guest and print the round-trip times",
+	},
+	{
+		"lookup NAME [A|AAAA|CNAME|MX|NS|TXT|SRV|PTR]",
+		"resolve the name using the guest resolver configuration",
+	},
+	{
+		"connect [-4|-6] [-u] [-c COUNT] [-i INTERVAL] [-W TIMEOUT] HOST PORT",
+		"try to connect from the guest to the TCP (or UDP with -u) port and print the timings",
+	},
+	{
+		"traceroute [-4|-6] [-m HOPS] [-q PROBES] [-p PORT] [-w TIMEOUT] HOST",
+		"print the route from the guest to the host using UDP probes",
+	},
+	{
+		"capture [-w FILE|-] [-s SNAPLEN] [-c COUNT] [-G SEC] IFNAME [FILTER ...]",
+		"capture packets on the guest link and write them in pcapng format, e.g. to pipe into wireshark -k -i -;",
+		"the filter is in pcap-filter(7) syntax and requires tcpdump in the guest",
 	},
 	{
 		"ls [-l] [-d] FILE|DIRECTORY",
@@ -0,0 +1,428 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/dhcp"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// DHCPLeaseDir is the directory with the leases obtained by the built-in DHCP client.
+// The agent keeps renewing them while it runs.
+const DHCPLeaseDir = "/run/phoenix-ga/dhcp"
+
+// The bounds of the exponential backoff between the failed DHCP requests
+const (
+	dhcpMinRetryInterval = 10 * time.Second
+	dhcpMaxRetryInterval = 5 * time.Minute
+)
+
+// AcquireDHCPLease obtains a new lease for the interface, applies it
+// and saves it to DHCPLeaseDir. The nameservers are not configured.
+func AcquireDHCPLease(ctx context.Context, ifname string, family int) (*dhcp.Lease, error) {
+	lease, err := dhcp.Acquire(ctx, family, ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("DHCPv%d: obtained %s on %s from %s (lease time = %s)", family, lease.Addr, ifname, lease.ServerID, lease.LeaseTime)
+
+	if err := ApplyDHCPLease(lease); err != nil {
+		return nil, err
+	}
+
+	if err := dhcp.SaveLease(DHCPLeaseDir, lease); err != nil {
+		return nil, fmt.Errorf("failed to save lease: %w", err)
+	}
+
+	return lease, nil
+}
+
+// ApplyDHCPLease configures the leased address, the MTU and the routes.
+// The address lifetime is set to the remaining lease time, so that the kernel
+// removes it even if the lease is not renewed for whatever reason.
+func ApplyDHCPLease(lease *dhcp.Lease) error {
+	link, err := netlink.LinkByName(lease.Interface)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if lease.MTU > 0 && lease.MTU != link.Attrs().MTU {
+		if err := SetLinkAttributes(lease.Interface, &LinkAttrs{MTU: lease.MTU}); err != nil {
+			return fmt.Errorf("failed to set MTU: %w", err)
+		}
+	}
+
+	ip, ipnet, err := ParseCIDR(lease.Addr)
+	if err != nil {
+		return err
+	}
+
+	ipnet.IP = ip
+
+	lft := int(time.Until(lease.ExpireTime()) / time.Second)
+	if lft <= 0 {
+		return fmt.Errorf("lease for %s has expired", lease.Addr)
+	}
+
+	addr := netlink.Addr{
+		IPNet:       ipnet,
+		ValidLft:    lft,
+		PreferedLft: lft,
+	}
+
+	if err := netlink.AddrReplace(link, &addr); err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	// The classless static routes take precedence over
+	// the routers option (RFC 3442)
+	routes := lease.Routes
+
+	if len(routes) == 0 && len(lease.Routers) > 0 {
+		routes = []*dhcp.Route{{Dst: "0.0.0.0/0", Gw: lease.Routers[0]}}
+	}
+
+	for _, r := range routes {
+		attrs := RouteAttrs{
+			LinkName: lease.Interface,
+			Dst:      r.Dst,
+			Protocol: unix.RTPROT_DHCP,
+		}
+
+		if gw := net.ParseIP(r.Gw); gw == nil || gw.IsUnspecified() {
+			attrs.Scope = netlink.SCOPE_LINK
+		} else {
+			attrs.Gw = r.Gw
+
+			// The gateway may be outside the leased network, e.g. with /32
+			attrs.OnLink = !ipnet.Contains(gw)
+		}
+
+		if err := UpdateRouteTable("replace", &attrs); err != nil {
+			return fmt.Errorf("failed to add route to %s via %s: %w", r.Dst, r.Gw, err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseDHCPLeases releases the leases obtained for the interface
+// and removes the leased addresses.
+func ReleaseDHCPLeases(ifname string) error {
+	for _, family := range []int{4, 6} {
+		fname := dhcp.LeasePath(DHCPLeaseDir, ifname, family)
+
+		lease, err := dhcp.LoadLease(fname)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return err
+		}
+
+		log.Debugf("DHCPv%d: releasing %s on %s", family, lease.Addr, ifname)
+
+		if err := dhcp.Release(lease); err != nil {
+			log.Warnf("DHCPv%d: failed to release %s: %s", family, lease.Addr, err)
+		}
+
+		if err := removeDHCPLease(fname, lease); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeDHCPLease(fname string, lease *dhcp.Lease) error {
+	// Removed first to stop the renewal
+	if err := os.Remove(fname); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return removeDHCPLeaseAddr(lease)
+}
+
+func removeDHCPLeaseAddr(lease *dhcp.Lease) error {
+	err := UpdateAddrList("del", lease.Interface, lease.Addr)
+	if err != nil && !errors.Is(err, unix.EADDRNOTAVAIL) && !errors.As(err, new(netlink.LinkNotFoundError)) {
+		return err
+	}
+
+	return nil
+}
+
+// DHCPLeaseKeeper renews the leases found in DHCPLeaseDir.
+// The directory is rescanned periodically, because the leases
+// can be obtained by netinit after the agent has started.
+type DHCPLeaseKeeper struct {
+	mu     sync.Mutex
+	active map[string]struct{}
+}
+
+func (k *DHCPLeaseKeeper) Run(ctx context.Context, interval time.Duration) {
+	k.active = make(map[string]struct{})
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		files, _ := filepath.Glob(filepath.Join(DHCPLeaseDir, "*.lease"))
+
+		for _, fname := range files {
+			k.mu.Lock()
+
+			if _, ok := k.active[fname]; !ok {
+				k.active[fname] = struct{}{}
+
+				go func() {
+					k.maintain(ctx, fname)
+
+					k.mu.Lock()
+					delete(k.active, fname)
+					k.mu.Unlock()
+				}()
+			}
+
+			k.mu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// maintain renews the lease at T1, rebinds it at T2 and tries
+// to obtain a new one when the lease has expired or has been declined.
+// Other failures are retried with backoff while the lease is valid.
+// It returns when the lease file is removed or the interface disappears.
+func (k *DHCPLeaseKeeper) maintain(ctx context.Context, fname string) {
+	backoff := dhcpMinRetryInterval
+
+	for {
+		lease, err := dhcp.LoadLease(fname)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				log.Errorf("DHCP: %s", err)
+			}
+
+			return
+		}
+
+		logger := log.WithField("iface", lease.Interface)
+
+		now := time.Now()
+
+		var next time.Time
+
+		switch {
+		case now.Before(lease.RenewTime()):
+			next = lease.RenewTime()
+		case now.Before(lease.ExpireTime()):
+			// Renewal in progress or interrupted by a restart
+		default:
+			next = now
+		}
+
+		if !next.IsZero() {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Until(next)):
+			}
+
+			// Could be released in the meantime
+			if lease, err = dhcp.LoadLease(fname); err != nil {
+				continue
+			}
+
+			now = time.Now()
+		}
+
+		var newLease *dhcp.Lease
+		var expired bool
+
+		switch {
+		case now.Before(lease.RebindTime()):
+			logger.Debugf("DHCPv%d: renewing %s", lease.Family, lease.Addr)
+
+			newLease, err = extendDHCPLease(ctx, lease, dhcp.Renew, lease.RebindTime())
+		case now.Before(lease.ExpireTime()):
+			logger.Debugf("DHCPv%d: rebinding %s", lease.Family, lease.Addr)
+
+			newLease, err = extendDHCPLease(ctx, lease, dhcp.Rebind, lease.ExpireTime())
+		default:
+			expired = true
+			err = fmt.Errorf("lease for %s has expired", lease.Addr)
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		switch {
+		case err == nil:
+		case errors.Is(err, context.DeadlineExceeded):
+			// Go to the next stage
+			continue
+		case !expired && !errors.Is(err, dhcp.ErrNAK):
+			// The address is still valid, so it is kept and the request
+			// is retried, but no later than the lease expires
+			delay := min(backoff, time.Until(lease.ExpireTime()))
+
+			logger.Warnf("DHCPv%d: %s (retrying in %s)", lease.Family, err, delay.Round(time.Second))
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			backoff = min(2*backoff, dhcpMaxRetryInterval)
+
+			continue
+		default:
+			logger.Warnf("DHCPv%d: %s", lease.Family, err)
+
+			if err := removeDHCPLeaseAddr(lease); err != nil {
+				logger.Errorf("DHCPv%d: failed to remove %s: %s", lease.Family, lease.Addr, err)
+			}
+
+			// The lease file is kept but marked as expired, so that
+			// the acquisition is resumed after the agent restart
+			if _, err := os.Stat(fname); err == nil && now.Before(lease.ExpireTime()) {
+				lease.LeaseTime, lease.T1, lease.T2 = 0, 0, 0
+
+				if err := dhcp.SaveLease(DHCPLeaseDir, lease); err != nil {
+					logger.Errorf("DHCPv%d: failed to save lease: %s", lease.Family, err)
+				}
+			}
+
+			// Start over with a new lease
+			if !k.reacquire(ctx, fname, lease) {
+				return
+			}
+
+			backoff = dhcpMinRetryInterval
+
+			continue
+		}
+
+		backoff = dhcpMinRetryInterval
+
+		// Released while the request was in progress
+		if _, err := os.Stat(fname); os.IsNotExist(err) {
+			return
+		}
+
+		if err := ApplyDHCPLease(newLease); err != nil {
+			logger.Errorf("DHCPv%d: failed to apply the renewed lease: %s", lease.Family, err)
+		}
+
+		// The server may assign a different address on renewal
+		if newLease.Addr != lease.Addr {
+			logger.Infof("DHCPv%d: address changed from %s to %s", lease.Family, lease.Addr, newLease.Addr)
+
+			if err := removeDHCPLeaseAddr(lease); err != nil {
+				logger.Errorf("DHCPv%d: failed to remove %s: %s", lease.Family, lease.Addr, err)
+			}
+		}
+
+		if err := dhcp.SaveLease(DHCPLeaseDir, newLease); err != nil {
+			logger.Errorf("DHCPv%d: failed to save lease: %s", lease.Family, err)
+
+			return
+		}
+	}
+}
+
+// reacquire tries to obtain a new lease with a capped exponential backoff
+// until it succeeds or the context is done. It returns false if the lease
+// has been released or the interface has disappeared in the meantime.
+func (k *DHCPLeaseKeeper) reacquire(ctx context.Context, fname string, lease *dhcp.Lease) bool {
+	logger := log.WithField("iface", lease.Interface)
+
+	backoff := dhcpMinRetryInterval
+
+	for {
+		if _, err := os.Stat(fname); os.IsNotExist(err) {
+			return false
+		}
+
+		if _, err := netlink.LinkByName(lease.Interface); errors.As(err, new(netlink.LinkNotFoundError)) {
+			logger.Warnf("DHCPv%d: interface not found, forgetting the lease", lease.Family)
+
+			if err := os.Remove(fname); err != nil && !os.IsNotExist(err) {
+				logger.Errorf("DHCPv%d: %s", lease.Family, err)
+			}
+
+			return false
+		}
+
+		err := func() error {
+			acquireCtx, cancel := context.WithTimeout(ctx, time.Minute)
+			defer cancel()
+
+			newLease, err := dhcp.Acquire(acquireCtx, lease.Family, lease.Interface)
+			if err != nil {
+				return err
+			}
+
+			// Released while the request was in progress
+			if _, err := os.Stat(fname); os.IsNotExist(err) {
+				return nil
+			}
+
+			if err := ApplyDHCPLease(newLease); err != nil {
+				return err
+			}
+
+			if err := dhcp.SaveLease(DHCPLeaseDir, newLease); err != nil {
+				return fmt.Errorf("failed to save lease: %w", err)
+			}
+
+			logger.Infof("DHCPv%d: obtained a new lease: %s", lease.Family, newLease.Addr)
+
+			return nil
+		}()
+		if err == nil {
+			return true
+		}
+
+		if ctx.Err() != nil {
+			return false
+		}
+
+		logger.Errorf("DHCPv%d: failed to obtain a new lease (retrying in %s): %s", lease.Family, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		backoff = min(2*backoff, dhcpMaxRetryInterval)
+	}
+}
+
+func extendDHCPLease(ctx context.Context, lease *dhcp.Lease, fn func(context.Context, *dhcp.Lease) (*dhcp.Lease, error), deadline time.Time) (*dhcp.Lease, error) {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	return fn(ctx, lease)
+}
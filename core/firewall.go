@@ -0,0 +1,102 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/nftables"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ErrFirewallRulesetPending  = errors.New("another firewall ruleset is waiting for confirmation")
+	ErrFirewallRulesetNotFound = errors.New("no such firewall ruleset waiting for confirmation")
+)
+
+// GetFirewallRuleset returns the whole nftables ruleset of the guest in the JSON format.
+func (s *Server) GetFirewallRuleset(ctx context.Context) ([]byte, error) {
+	return nftables.ListRuleset(ctx)
+}
+
+// ApplyFirewallRuleset atomically replaces the content of the agent table
+// with the ruleset in the nftables JSON format and returns the ID of the change.
+// Unless the change is confirmed using ConfirmFirewallRuleset within the timeout,
+// the previous content of the table is restored.
+func (s *Server) ApplyFirewallRuleset(ctx context.Context, ruleset []byte, timeout time.Duration) (string, error) {
+	s.fwMu.Lock()
+	defer s.fwMu.Unlock()
+
+	if s.fw != nil {
+		return "", ErrFirewallRulesetPending
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultFirewallRulesetTimeout
+	}
+
+	snapshot, err := nftables.SaveTable(ctx, &FirewallTable)
+	if err != nil {
+		return "", err
+	}
+
+	// Nothing is changed on failure, because the ruleset
+	// is applied as a single transaction
+	if err := nftables.ApplyTable(ctx, &FirewallTable, ruleset); err != nil {
+		return "", err
+	}
+
+	id := uuid.New().String()
+
+	s.fw = &pendingFirewallRuleset{
+		id:       id,
+		snapshot: snapshot,
+		timer: time.AfterFunc(timeout, func() {
+			s.rollbackFirewallRuleset(id)
+		}),
+	}
+
+	log.WithField("id", id).Infof("Firewall ruleset applied to table %s, waiting %s for confirmation", &FirewallTable, timeout)
+
+	return id, nil
+}
+
+// ConfirmFirewallRuleset makes the change with the given ID permanent.
+func (s *Server) ConfirmFirewallRuleset(ctx context.Context, id string) error {
+	s.fwMu.Lock()
+	defer s.fwMu.Unlock()
+
+	if s.fw == nil || s.fw.id != id {
+		return ErrFirewallRulesetNotFound
+	}
+
+	s.fw.timer.Stop()
+	s.fw = nil
+
+	log.WithField("id", id).Info("Firewall ruleset confirmed")
+
+	return nil
+}
+
+func (s *Server) rollbackFirewallRuleset(id string) {
+	s.fwMu.Lock()
+	defer s.fwMu.Unlock()
+
+	// Already confirmed
+	if s.fw == nil || s.fw.id != id {
+		return
+	}
+
+	log.WithField("id", id).Warn("Firewall ruleset was not confirmed in time. Rolling back")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := nftables.RestoreTable(ctx, &FirewallTable, s.fw.snapshot); err != nil {
+		log.WithField("id", id).Errorf("Firewall ruleset rollback failed: %s", err)
+	}
+
+	s.fw = nil
+}
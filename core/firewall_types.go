@@ -0,0 +1,21 @@
+package core
+
+import (
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/nftables"
+)
+
+const (
+	DefaultFirewallRulesetTimeout = 120 * time.Second
+)
+
+// FirewallTable is the nftables table owned by the agent.
+// The rest of the guest ruleset is never changed.
+var FirewallTable = nftables.Table{Family: "inet", Name: "phoenix"}
+
+type pendingFirewallRuleset struct {
+	id       string
+	snapshot string // empty if the table did not exist
+	timer    *time.Timer
+}
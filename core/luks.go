@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/cryptsetup"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ErrInvalidDevicePath  = errors.New("invalid device path")
+	ErrInvalidMappingName = errors.New("invalid mapping name")
+	ErrEmptyKey           = errors.New("empty key")
+)
+
+func (s *Server) UnlockEncryptedVolume(ctx context.Context, device, name string, key []byte, readOnly bool) (*EncryptedVolumeStatus, error) {
+	if !strings.HasPrefix(device, "/dev/") {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDevicePath, device)
+	}
+
+	if err := validateMapperName(name); err != nil {
+		return nil, err
+	}
+
+	if len(key) == 0 {
+		return nil, ErrEmptyKey
+	}
+
+	log.WithField("device", device).WithField("name", name).Info("Unlocking encrypted volume")
+
+	if err := cryptsetup.Open(ctx, device, name, key, readOnly); err != nil {
+		return nil, err
+	}
+
+	return cryptsetup.GetStatus(ctx, name)
+}
+
+func (s *Server) LockEncryptedVolume(ctx context.Context, name string) error {
+	if err := validateMapperName(name); err != nil {
+		return err
+	}
+
+	log.WithField("name", name).Info("Locking encrypted volume")
+
+	return cryptsetup.Close(ctx, name)
+}
+
+func (s *Server) GetEncryptedVolumeStatus(ctx context.Context, name string) (*EncryptedVolumeStatus, error) {
+	if err := validateMapperName(name); err != nil {
+		return nil, err
+	}
+
+	return cryptsetup.GetStatus(ctx, name)
+}
+
+func validateMapperName(name string) error {
+	if len(name) == 0 || strings.ContainsAny(name, "/ ") || name == "." || name == ".." {
+		return fmt.Errorf("%w: %q", ErrInvalidMappingName, name)
+	}
+
+	return nil
+}
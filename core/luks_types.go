@@ -0,0 +1,7 @@
+package core
+
+import (
+	"github.com/0xef53/phoenix-guest-agent/internal/cryptsetup"
+)
+
+type EncryptedVolumeStatus = cryptsetup.Status
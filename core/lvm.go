@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/lvm"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *Server) GetPhysicalVolumes(ctx context.Context) ([]*PhysicalVolume, error) {
+	return lvm.ListPhysicalVolumes(ctx)
+}
+
+func (s *Server) GetVolumeGroups(ctx context.Context) ([]*VolumeGroup, error) {
+	return lvm.ListVolumeGroups(ctx)
+}
+
+func (s *Server) GetLogicalVolumes(ctx context.Context) ([]*LogicalVolume, error) {
+	return lvm.ListLogicalVolumes(ctx)
+}
+
+func (s *Server) ResizePhysicalVolume(ctx context.Context, device string) error {
+	if !strings.HasPrefix(device, "/dev/") {
+		return fmt.Errorf("invalid device path: %s", device)
+	}
+
+	log.WithField("device", device).Info("Resizing LVM physical volume")
+
+	return lvm.ResizePhysicalVolume(ctx, device)
+}
+
+func (s *Server) ExtendLogicalVolume(ctx context.Context, vgname, lvname string, sizeBytes uint64, resizeFS bool) (*LogicalVolume, error) {
+	if len(vgname) == 0 || len(lvname) == 0 {
+		return nil, fmt.Errorf("volume group and logical volume names must be specified")
+	}
+
+	log.WithField("volume", vgname+"/"+lvname).Info("Extending LVM logical volume")
+
+	if err := lvm.ExtendLogicalVolume(ctx, vgname, lvname, sizeBytes, resizeFS); err != nil {
+		return nil, err
+	}
+
+	lvs, err := lvm.ListLogicalVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lv := range lvs {
+		if lv.VGName == vgname && lv.Name == lvname {
+			return lv, nil
+		}
+	}
+
+	return nil, fmt.Errorf("logical volume not found after resize: %s/%s", vgname, lvname)
+}
@@ -0,0 +1,11 @@
+package core
+
+import (
+	"github.com/0xef53/phoenix-guest-agent/internal/lvm"
+)
+
+type PhysicalVolume = lvm.PhysicalVolume
+
+type VolumeGroup = lvm.VolumeGroup
+
+type LogicalVolume = lvm.LogicalVolume
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ErrNetworkConfigPending  = errors.New("another network configuration is waiting for confirmation")
+	ErrNetworkConfigNotFound = errors.New("no such network configuration waiting for confirmation")
+)
+
+// ApplyNetworkConfig brings the network state in line with cfg and
+// returns the ID of the change. Unless the change is confirmed
+// using ConfirmNetworkConfig within the timeout, the previous state
+// is restored. It works like "netplan try".
+//
+// The pending change is kept only in memory, so if the agent
+// is restarted before the timeout expires, the change stays in effect
+// as if it had been confirmed.
+func (s *Server) ApplyNetworkConfig(ctx context.Context, cfg *NetworkConfig, timeout time.Duration) (string, error) {
+	s.netconfMu.Lock()
+	defer s.netconfMu.Unlock()
+
+	if s.netconf != nil {
+		return "", ErrNetworkConfigPending
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultNetworkConfigTimeout
+	}
+
+	desired, err := newNetworkState(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	snapshot, err := captureNetworkState(desired.links)
+	if err != nil {
+		return "", err
+	}
+
+	if err := applyNetworkState(desired); err != nil {
+		log.Errorf("Unable to apply network configuration: %s. Rolling back", err)
+
+		if err := applyNetworkState(snapshot); err != nil {
+			log.Errorf("Network configuration rollback failed: %s", err)
+		}
+
+		return "", err
+	}
+
+	id := uuid.New().String()
+
+	s.netconf = &pendingNetworkConfig{
+		id:       id,
+		snapshot: snapshot,
+		timer: time.AfterFunc(timeout, func() {
+			s.rollbackNetworkConfig(id)
+		}),
+	}
+
+	log.WithField("id", id).Infof("Network configuration applied, waiting %s for confirmation", timeout)
+
+	return id, nil
+}
+
+// ConfirmNetworkConfig makes the change with the given ID permanent.
+func (s *Server) ConfirmNetworkConfig(ctx context.Context, id string) error {
+	s.netconfMu.Lock()
+	defer s.netconfMu.Unlock()
+
+	if s.netconf == nil || s.netconf.id != id {
+		return ErrNetworkConfigNotFound
+	}
+
+	s.netconf.timer.Stop()
+	s.netconf = nil
+
+	log.WithField("id", id).Info("Network configuration confirmed")
+
+	return nil
+}
+
+func (s *Server) rollbackNetworkConfig(id string) {
+	s.netconfMu.Lock()
+	defer s.netconfMu.Unlock()
+
+	// Already confirmed
+	if s.netconf == nil || s.netconf.id != id {
+		return
+	}
+
+	log.WithField("id", id).Warn("Network configuration was not confirmed in time. Rolling back")
+
+	if err := applyNetworkState(s.netconf.snapshot); err != nil {
+		log.WithField("id", id).Errorf("Network configuration rollback failed: %s", err)
+	}
+
+	s.netconf = nil
+}
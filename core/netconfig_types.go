@@ -0,0 +1,57 @@
+package core
+
+import (
+	"time"
+
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	DefaultNetworkConfigTimeout = 120 * time.Second
+
+	// NetworkConfigRuleProtocol is the rtnetlink protocol used to tag
+	// the policy rules added by ApplyNetworkConfig (requires Linux 4.17+).
+	NetworkConfigRuleProtocol = 167
+)
+
+// NetworkConfig describes the desired network state.
+//
+// Only the listed links are taken into account. Their addresses,
+// the routes over them and the policy rules previously added
+// by ApplyNetworkConfig are brought in line with the config:
+// missing ones are added, extra ones are removed. Other routes
+// and rules of the system are left untouched.
+type NetworkConfig struct {
+	Links  []*NetworkLinkConfig
+	Routes []*RouteAttrs
+	Rules  []*RuleAttrs
+}
+
+type NetworkLinkConfig struct {
+	Name  string
+	MTU   int    // 0 means "leave as is"
+	State string // "up", "down" or empty to leave as is
+	Addrs []string
+}
+
+// networkState is a netlink representation of NetworkConfig
+// that is also used to store the rollback snapshot.
+type networkState struct {
+	links  []*linkState
+	routes []*netlink.Route
+	rules  []*netlink.Rule
+}
+
+type linkState struct {
+	index int
+	name  string
+	mtu   int
+	state string
+	addrs []*netlink.Addr
+}
+
+type pendingNetworkConfig struct {
+	id       string
+	snapshot *networkState
+	timer    *time.Timer
+}
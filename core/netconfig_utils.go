@@ -0,0 +1,409 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func newNetworkState(cfg *NetworkConfig) (*networkState, error) {
+	st := networkState{
+		links:  make([]*linkState, 0, len(cfg.Links)),
+		routes: make([]*netlink.Route, 0, len(cfg.Routes)),
+		rules:  make([]*netlink.Rule, 0, len(cfg.Rules)),
+	}
+
+	indexes := make(map[int]struct{}, len(cfg.Links))
+
+	for _, lc := range cfg.Links {
+		link, err := netlink.LinkByName(lc.Name)
+		if err != nil {
+			return nil, os.NewSyscallError("rtnetlink", err)
+		}
+
+		switch lc.State {
+		case "", "up", "down":
+		default:
+			return nil, fmt.Errorf("invalid link state: %s", lc.State)
+		}
+
+		ls := linkState{
+			index: link.Attrs().Index,
+			name:  lc.Name,
+			mtu:   lc.MTU,
+			state: lc.State,
+			addrs: make([]*netlink.Addr, 0, len(lc.Addrs)),
+		}
+
+		for _, s := range lc.Addrs {
+			ip, ipnet, err := ParseCIDR(s)
+			if err != nil {
+				return nil, err
+			}
+
+			ipnet.IP = ip
+
+			ls.addrs = append(ls.addrs, &netlink.Addr{IPNet: ipnet})
+		}
+
+		indexes[ls.index] = struct{}{}
+
+		st.links = append(st.links, &ls)
+	}
+
+	for _, attrs := range cfg.Routes {
+		r, err := newNetlinkRoute(attrs)
+		if err != nil {
+			return nil, err
+		}
+
+		// Otherwise the route cannot be found when rolling back
+		if !isManagedRoute(r, indexes) {
+			return nil, fmt.Errorf("route %s must use one of the configured links and the boot or static protocol", attrs.Dst)
+		}
+
+		st.routes = append(st.routes, r)
+	}
+
+	for _, attrs := range cfg.Rules {
+		if attrs.Priority <= 0 || attrs.Table == 0 {
+			return nil, fmt.Errorf("rule priority and lookup table must be specified explicitly")
+		}
+
+		r, err := newNetlinkRule(attrs)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Protocol = NetworkConfigRuleProtocol
+
+		st.rules = append(st.rules, r)
+	}
+
+	return &st, nil
+}
+
+// captureNetworkState returns the current state of the given links,
+// the routes over them and the policy rules added by ApplyNetworkConfig.
+func captureNetworkState(links []*linkState) (*networkState, error) {
+	st := networkState{
+		links: make([]*linkState, 0, len(links)),
+	}
+
+	indexes := make(map[int]struct{}, len(links))
+
+	for _, x := range links {
+		link, err := netlink.LinkByIndex(x.index)
+		if err != nil {
+			return nil, os.NewSyscallError("rtnetlink", err)
+		}
+
+		ls := linkState{
+			index: x.index,
+			name:  link.Attrs().Name,
+			mtu:   link.Attrs().MTU,
+			state: "down",
+		}
+
+		if link.Attrs().Flags&net.FlagUp != 0 {
+			ls.state = "up"
+		}
+
+		if ls.addrs, err = managedAddrList(link); err != nil {
+			return nil, err
+		}
+
+		indexes[x.index] = struct{}{}
+
+		st.links = append(st.links, &ls)
+	}
+
+	var err error
+
+	if st.routes, err = managedRouteList(indexes); err != nil {
+		return nil, err
+	}
+
+	if st.rules, err = managedRuleList(); err != nil {
+		return nil, err
+	}
+
+	return &st, nil
+}
+
+// applyNetworkState brings the system in line with st. It doesn't stop
+// at the first error so that a rollback restores as much as possible.
+func applyNetworkState(st *networkState) error {
+	var errs []error
+
+	indexes := make(map[int]struct{}, len(st.links))
+
+	for _, ls := range st.links {
+		indexes[ls.index] = struct{}{}
+
+		if err := applyLinkState(ls); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Routes
+	if current, err := managedRouteList(indexes); err == nil {
+		desired := make(map[string]struct{}, len(st.routes))
+
+		for _, r := range st.routes {
+			desired[routeKey(r)] = struct{}{}
+		}
+
+		for _, r := range current {
+			if _, ok := desired[routeKey(r)]; !ok {
+				if err := netlink.RouteDel(r); err != nil && !errors.Is(err, unix.ESRCH) {
+					errs = append(errs, fmt.Errorf("unable to remove route %s: %w", r, os.NewSyscallError("rtnetlink", err)))
+				}
+			}
+		}
+	} else {
+		errs = append(errs, err)
+	}
+
+	for _, r := range st.routes {
+		if err := netlink.RouteReplace(r); err != nil {
+			errs = append(errs, fmt.Errorf("unable to set route %s: %w", r, os.NewSyscallError("rtnetlink", err)))
+		}
+	}
+
+	// Policy rules
+	if current, err := managedRuleList(); err == nil {
+		existing := make(map[string]struct{}, len(current))
+		desired := make(map[string]struct{}, len(st.rules))
+
+		for _, r := range st.rules {
+			desired[ruleKey(r)] = struct{}{}
+		}
+
+		for _, r := range current {
+			existing[ruleKey(r)] = struct{}{}
+
+			if _, ok := desired[ruleKey(r)]; !ok {
+				if err := netlink.RuleDel(r); err != nil {
+					errs = append(errs, fmt.Errorf("unable to remove rule %s: %w", r, os.NewSyscallError("rtnetlink", err)))
+				}
+			}
+		}
+
+		for _, r := range st.rules {
+			if _, ok := existing[ruleKey(r)]; !ok {
+				if err := netlink.RuleAdd(r); err != nil {
+					errs = append(errs, fmt.Errorf("unable to add rule %s: %w", r, os.NewSyscallError("rtnetlink", err)))
+				}
+			}
+		}
+	} else {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func applyLinkState(ls *linkState) error {
+	link, err := netlink.LinkByIndex(ls.index)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if ls.mtu > 0 && ls.mtu != link.Attrs().MTU {
+		if err := netlink.LinkSetMTU(link, ls.mtu); err != nil {
+			return fmt.Errorf("unable to set MTU of %s: %w", ls.name, os.NewSyscallError("rtnetlink", err))
+		}
+	}
+
+	switch ls.state {
+	case "up":
+		err = netlink.LinkSetUp(link)
+	case "down":
+		err = netlink.LinkSetDown(link)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to change the link state of %s: %w", ls.name, os.NewSyscallError("rtnetlink", err))
+	}
+
+	current, err := managedAddrList(link)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]struct{}, len(ls.addrs))
+
+	// New addresses first so as not to lose connectivity in between
+	for _, a := range ls.addrs {
+		desired[a.IPNet.String()] = struct{}{}
+
+		if err := netlink.AddrReplace(link, a); err != nil {
+			return fmt.Errorf("unable to assign %s to %s: %w", a.IPNet, ls.name, os.NewSyscallError("rtnetlink", err))
+		}
+	}
+
+	for _, a := range current {
+		if _, ok := desired[a.IPNet.String()]; !ok {
+			if err := netlink.AddrDel(link, a); err != nil {
+				return fmt.Errorf("unable to remove %s from %s: %w", a.IPNet, ls.name, os.NewSyscallError("rtnetlink", err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// managedAddrList returns the link addresses except the IPv6 link-local ones
+// that are maintained by the kernel.
+func managedAddrList(link netlink.Link) ([]*netlink.Addr, error) {
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	res := make([]*netlink.Addr, 0, len(addrs))
+
+	for _, a := range addrs {
+		if a.IP.To4() == nil && a.IP.IsLinkLocalUnicast() {
+			continue
+		}
+
+		res = append(res, &netlink.Addr{IPNet: a.IPNet, Label: a.Label, Peer: a.Peer, Broadcast: a.Broadcast})
+	}
+
+	return res, nil
+}
+
+// managedRouteList returns the routes from all tables except the local one
+// that were added by an administrator (i.e. with the boot or static protocol)
+// and use only the given links.
+func managedRouteList(indexes map[int]struct{}) ([]*netlink.Route, error) {
+	routes, err := netlink.RouteListFiltered(netlink.FAMILY_ALL, &netlink.Route{Table: unix.RT_TABLE_UNSPEC}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	res := make([]*netlink.Route, 0, len(routes))
+
+	for i := range routes {
+		if r := &routes[i]; isManagedRoute(r, indexes) {
+			res = append(res, r)
+		}
+	}
+
+	return res, nil
+}
+
+func isManagedRoute(r *netlink.Route, indexes map[int]struct{}) bool {
+	if r.Table == unix.RT_TABLE_LOCAL {
+		return false
+	}
+
+	switch r.Protocol {
+	case unix.RTPROT_UNSPEC, unix.RTPROT_BOOT, unix.RTPROT_STATIC:
+	default:
+		return false
+	}
+
+	if len(r.MultiPath) > 0 {
+		for _, nh := range r.MultiPath {
+			if _, ok := indexes[nh.LinkIndex]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// Blackhole, unreachable, etc. are not bound to any link
+	// and therefore are not managed
+	if r.LinkIndex == 0 {
+		return false
+	}
+
+	_, ok := indexes[r.LinkIndex]
+
+	return ok
+}
+
+// managedRuleList returns the policy rules tagged with NetworkConfigRuleProtocol.
+func managedRuleList() ([]*netlink.Rule, error) {
+	rules, err := netlink.RuleList(netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	res := make([]*netlink.Rule, 0, len(rules))
+
+	for i := range rules {
+		if r := &rules[i]; r.Protocol == NetworkConfigRuleProtocol {
+			res = append(res, r)
+		}
+	}
+
+	return res, nil
+}
+
+// routeKey returns a string that identifies the route.
+// The kernel defaults are applied to the unset fields.
+func routeKey(r *netlink.Route) string {
+	family := r.Family
+	if r.Dst != nil {
+		if r.Dst.IP.To4() != nil {
+			family = netlink.FAMILY_V4
+		} else {
+			family = netlink.FAMILY_V6
+		}
+	}
+
+	dst := "default"
+	if r.Dst != nil {
+		if ones, _ := r.Dst.Mask.Size(); ones > 0 {
+			dst = r.Dst.String()
+		}
+	}
+
+	table := r.Table
+	if table == 0 {
+		table = unix.RT_TABLE_MAIN
+	}
+
+	rtype := r.Type
+	if rtype == 0 {
+		rtype = unix.RTN_UNICAST
+	}
+
+	prio := r.Priority
+	if prio == 0 && family == netlink.FAMILY_V6 {
+		prio = 1024
+	}
+
+	hops := make([]string, 0, len(r.MultiPath))
+
+	for _, nh := range r.MultiPath {
+		hops = append(hops, fmt.Sprintf("%d/%s/%d", nh.LinkIndex, nh.Gw, nh.Hops))
+	}
+
+	return fmt.Sprintf("%d %d %s %d %d %d %s %s [%s]", family, table, dst, rtype, prio, r.LinkIndex, r.Gw, r.Src, strings.Join(hops, " "))
+}
+
+// ruleKey returns a string that identifies the policy rule.
+func ruleKey(r *netlink.Rule) string {
+	var mask uint32 = 0xffffffff
+
+	if r.Mask != nil {
+		mask = *r.Mask
+	}
+
+	// The kernel reports a zero mask for the rules without fwmark
+	if r.Mark == 0 && (r.Mask == nil || *r.Mask == 0) {
+		mask = 0
+	}
+
+	return fmt.Sprintf("%d %d %s %s %s %s %d/%d %d %t", r.Family, r.Priority, r.Src, r.Dst, r.IifName, r.OifName, r.Mark, mask, r.Table, r.Invert)
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/netdiag"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	maxDiagProbes   = 1000
+	maxDiagTimeout  = 30 * time.Second
+	minPingInterval = 200 * time.Millisecond
+	maxPingSize     = 65507
+	maxTracertHops  = 64
+	maxTracertProbe = 10
+)
+
+func (s *Server) LookupDNS(ctx context.Context, name, qtype string) (*DNSLookupResult, error) {
+	if len(name) == 0 {
+		return nil, fmt.Errorf("empty name")
+	}
+
+	return netdiag.Lookup(ctx, name, qtype)
+}
+
+// Ping sends ICMP echo requests from the guest network namespace
+// and calls fn for each reply. The replies are not waited for
+// longer than opts.Timeout.
+func (s *Server) Ping(ctx context.Context, opts *PingOptions, fn func(*PingReply) error) (*PingStats, error) {
+	o := netdiag.PingOptions{
+		Count:    withDefault(opts.Count, 4),
+		Interval: withDefault(opts.Interval, time.Second),
+		Timeout:  withDefault(opts.Timeout, 2*time.Second),
+		Size:     withDefault(opts.Size, 56),
+		TTL:      opts.TTL,
+	}
+
+	switch {
+	case o.Count < 0 || o.Count > maxDiagProbes:
+		return nil, fmt.Errorf("count must be in the range 1-%d (or 0 for the default)", maxDiagProbes)
+	case o.Interval < minPingInterval:
+		return nil, fmt.Errorf("interval must not be less than %s", minPingInterval)
+	case o.Timeout < 0 || o.Timeout > maxDiagTimeout:
+		return nil, fmt.Errorf("timeout must not exceed %s", maxDiagTimeout)
+	case o.Size < 0 || o.Size > maxPingSize:
+		return nil, fmt.Errorf("size must be in the range 1-%d (or 0 for the default)", maxPingSize)
+	case o.TTL < 0 || o.TTL > 255:
+		return nil, fmt.Errorf("ttl must be in the range 1-255 (or 0 for the system default)")
+	}
+
+	addr, err := netdiag.Resolve(ctx, opts.Host, opts.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Addr = addr
+
+	log.WithField("addr", addr).Debugf("Diagnostics: ping %s (count = %d)", opts.Host, o.Count)
+
+	return netdiag.Ping(ctx, &o, fn)
+}
+
+// ProbeConnect makes the TCP or UDP connection attempts and calls fn
+// for each of them.
+func (s *Server) ProbeConnect(ctx context.Context, opts *ConnectOptions, fn func(*ConnectProbe) error) (*ConnectStats, error) {
+	o := netdiag.ConnectOptions{
+		Network:  withDefault(opts.Network, "tcp"),
+		Port:     opts.Port,
+		Count:    withDefault(opts.Count, 4),
+		Interval: withDefault(opts.Interval, time.Second),
+		Timeout:  withDefault(opts.Timeout, 3*time.Second),
+	}
+
+	switch {
+	case o.Network != "tcp" && o.Network != "udp":
+		return nil, fmt.Errorf("unsupported network: %s", o.Network)
+	case o.Port <= 0 || o.Port > 65535:
+		return nil, fmt.Errorf("port must be in the range 1-65535")
+	case o.Count < 0 || o.Count > maxDiagProbes:
+		return nil, fmt.Errorf("count must be in the range 1-%d (or 0 for the default)", maxDiagProbes)
+	case o.Interval < 0:
+		return nil, fmt.Errorf("negative interval")
+	case o.Timeout < 0 || o.Timeout > maxDiagTimeout:
+		return nil, fmt.Errorf("timeout must not exceed %s", maxDiagTimeout)
+	}
+
+	addr, err := netdiag.Resolve(ctx, opts.Host, opts.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Addr = addr
+
+	log.WithField("addr", addr).Debugf("Diagnostics: %s connect to %s:%d (count = %d)", o.Network, opts.Host, o.Port, o.Count)
+
+	return netdiag.Connect(ctx, &o, fn)
+}
+
+// Traceroute traces the path to the host using UDP probes
+// and calls fn for each hop.
+func (s *Server) Traceroute(ctx context.Context, opts *TracerouteOptions, fn func(*TracerouteHop) error) (*TracerouteStats, error) {
+	o := netdiag.TracerouteOptions{
+		Port:    withDefault(opts.Port, 33434),
+		MaxHops: withDefault(opts.MaxHops, 30),
+		Queries: withDefault(opts.Queries, 3),
+		Timeout: withDefault(opts.Timeout, time.Second),
+	}
+
+	switch {
+	case o.MaxHops < 0 || o.MaxHops > maxTracertHops:
+		return nil, fmt.Errorf("max hops must be in the range 1-%d (or 0 for the default)", maxTracertHops)
+	case o.Queries < 0 || o.Queries > maxTracertProbe:
+		return nil, fmt.Errorf("number of probes per hop must be in the range 1-%d (or 0 for the default)", maxTracertProbe)
+	case o.Timeout < 0 || o.Timeout > maxDiagTimeout:
+		return nil, fmt.Errorf("timeout must not exceed %s", maxDiagTimeout)
+	case o.Port < 0 || o.Port+o.MaxHops*o.Queries > 65535:
+		return nil, fmt.Errorf("port is out of range")
+	}
+
+	addr, err := netdiag.Resolve(ctx, opts.Host, opts.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Addr = addr
+
+	log.WithField("addr", addr).Debugf("Diagnostics: traceroute to %s (max hops = %d)", opts.Host, o.MaxHops)
+
+	return netdiag.Traceroute(ctx, &o, fn)
+}
+
+func withDefault[T comparable](v, def T) T {
+	var zero T
+
+	if v == zero {
+		return def
+	}
+
+	return v
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/netdiag"
+)
+
+type DNSLookupResult = netdiag.LookupResult
+
+type PingReply = netdiag.PingReply
+
+type PingStats = netdiag.PingStats
+
+type ConnectProbe = netdiag.ConnectProbe
+
+type ConnectStats = netdiag.ConnectStats
+
+type TracerouteHop = netdiag.TracerouteHop
+
+type TracerouteProbe = netdiag.TracerouteProbe
+
+type TracerouteStats = netdiag.TracerouteStats
+
+// PingOptions describes the ICMP echo test.
+// The zero values are replaced with the defaults.
+type PingOptions struct {
+	Host     string
+	Family   int // 0, 4 or 6
+	Count    int
+	Interval time.Duration
+	Timeout  time.Duration
+	Size     int
+	TTL      int
+}
+
+// ConnectOptions describes the TCP or UDP connect test.
+// The zero values are replaced with the defaults.
+type ConnectOptions struct {
+	Network  string // tcp or udp
+	Host     string
+	Port     int
+	Family   int // 0, 4 or 6
+	Count    int
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// TracerouteOptions describes the UDP traceroute.
+// The zero values are replaced with the defaults.
+type TracerouteOptions struct {
+	Host    string
+	Family  int // 0, 4 or 6
+	Port    int
+	MaxHops int
+	Queries int
+	Timeout time.Duration
+}
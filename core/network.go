@@ -2,45 +2,42 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"os"
 
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
-func (s *Server) GetRouteList(ctx context.Context, family InetFamily) ([]*RouteInfo, error) {
-	tmp, err := netlink.RouteList(nil, int(family))
+var (
+	ErrLinkIsUp            = errors.New("link must be down to perform this operation")
+	ErrUnsupportedLinkType = errors.New("unsupported link type")
+)
+
+func (s *Server) GetRouteList(ctx context.Context, family InetFamily, table int) ([]*RouteInfo, error) {
+	var tmp []netlink.Route
+	var err error
+
+	if table == 0 {
+		tmp, err = netlink.RouteList(nil, int(family))
+	} else {
+		tmp, err = netlink.RouteListFiltered(int(family), &netlink.Route{Table: table}, netlink.RT_FILTER_TABLE)
+	}
 	if err != nil {
 		return nil, os.NewSyscallError("rtnetlink", err)
 	}
 
+	names, err := getLinkNames()
+	if err != nil {
+		return nil, err
+	}
+
 	routes := make([]*RouteInfo, 0, len(tmp))
 
 	for _, x := range tmp {
-		link, _ := net.InterfaceByIndex(x.LinkIndex)
-
-		r := RouteInfo{
-			LinkIndex: x.LinkIndex,
-			LinkName:  link.Name,
-			Scope:     x.Scope,
-			Table:     x.Table,
-		}
-
-		if x.Src != nil {
-			r.Src = x.Src.String()
-		}
-
-		if x.Dst == nil {
-			r.Dst = "0.0.0.0"
-		} else {
-			r.Dst = x.Dst.String()
-		}
-
-		if x.Gw != nil {
-			r.Gw = x.Gw.String()
-		}
-
-		routes = append(routes, &r)
+		routes = append(routes, routeToRouteInfo(&x, names))
 	}
 
 	return routes, nil
@@ -54,60 +51,245 @@ func (s *Server) DelRoute(ctx context.Context, attrs *RouteAttrs) (*RouteInfo, e
 	return s.updateRouteTable(ctx, "del", attrs)
 }
 
+func (s *Server) ReplaceRoute(ctx context.Context, attrs *RouteAttrs) (*RouteInfo, error) {
+	return s.updateRouteTable(ctx, "replace", attrs)
+}
+
 func (s *Server) updateRouteTable(_ context.Context, action string, attrs *RouteAttrs) (*RouteInfo, error) {
-	link, err := netlink.LinkByName(attrs.LinkName)
+	r, err := newNetlinkRoute(attrs)
 	if err != nil {
-		return nil, os.NewSyscallError("rtnetlink", err)
+		return nil, err
 	}
 
-	if err := UpdateRouteTable(action, link, attrs.Dst, attrs.Src, attrs.Gw, attrs.Scope, attrs.Table); err != nil {
+	if err := routeUpdate(action, r); err != nil {
 		return nil, err
 	}
 
-	return &RouteInfo{
-		LinkIndex: link.Attrs().Index,
-		LinkName:  attrs.LinkName,
-		Src:       attrs.Src,
-		Dst:       attrs.Dst,
-		Gw:        attrs.Gw,
-		Table:     attrs.Table,
-		Scope:     attrs.Scope,
-	}, nil
+	names, err := getLinkNames()
+	if err != nil {
+		return nil, err
+	}
+
+	return routeToRouteInfo(r, names), nil
 }
 
-func (s *Server) GetInterfaces(ctx context.Context) ([]*InterfaceInfo, error) {
-	tmp, err := net.Interfaces()
+func (s *Server) GetRuleList(ctx context.Context, family InetFamily) ([]*RuleInfo, error) {
+	tmp, err := netlink.RuleList(int(family))
 	if err != nil {
-		return nil, err
+		return nil, os.NewSyscallError("rtnetlink", err)
 	}
 
-	ifs := make([]*InterfaceInfo, 0, len(tmp))
+	rules := make([]*RuleInfo, 0, len(tmp))
 
 	for _, x := range tmp {
-		addrs, err := x.Addrs()
+		rules = append(rules, ruleToRuleInfo(&x))
+	}
+
+	return rules, nil
+}
+
+func (s *Server) AddRule(ctx context.Context, attrs *RuleAttrs) error {
+	if attrs.Table == 0 {
+		return fmt.Errorf("lookup table is not specified")
+	}
+
+	return UpdateRuleList("add", attrs)
+}
+
+func (s *Server) DelRule(ctx context.Context, attrs *RuleAttrs) error {
+	return UpdateRuleList("del", attrs)
+}
+
+func (s *Server) GetNeighborList(ctx context.Context, family InetFamily, ifname string) ([]*NeighborInfo, error) {
+	var linkIndex int
+
+	if len(ifname) > 0 {
+		link, err := netlink.LinkByName(ifname)
 		if err != nil {
-			return nil, err
+			return nil, os.NewSyscallError("rtnetlink", err)
 		}
 
-		strAddrs := make([]string, 0, len(addrs))
+		linkIndex = link.Attrs().Index
+	}
+
+	tmp, err := netlink.NeighList(linkIndex, int(family))
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	names, err := getLinkNames()
+	if err != nil {
+		return nil, err
+	}
 
-		for _, v := range addrs {
-			strAddrs = append(strAddrs, v.(*net.IPNet).String())
+	neighs := make([]*NeighborInfo, 0, len(tmp))
+
+	for _, x := range tmp {
+		// Skip the service entries without an address (e.g. multicast on bridges)
+		if x.IP == nil {
+			continue
 		}
 
-		ifs = append(ifs, &InterfaceInfo{
-			Index:  x.Index,
-			Name:   x.Name,
-			Flags:  x.Flags,
-			MTU:    x.MTU,
-			HwAddr: x.HardwareAddr.String(),
-			Addrs:  strAddrs,
-		})
+		neighs = append(neighs, neighToNeighborInfo(&x, names))
+	}
+
+	return neighs, nil
+}
+
+// AddNeighbor adds a permanent neighbor entry or replaces
+// an existing one with the same address.
+func (s *Server) AddNeighbor(ctx context.Context, ifname, ipaddr, hwaddr string) error {
+	n, err := newNetlinkNeigh(ifname, ipaddr)
+	if err != nil {
+		return err
+	}
+
+	if n.HardwareAddr, err = net.ParseMAC(hwaddr); err != nil {
+		return err
+	}
+
+	n.State = netlink.NUD_PERMANENT
+
+	if err := netlink.NeighSet(n); err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	return nil
+}
+
+func (s *Server) DelNeighbor(ctx context.Context, ifname, ipaddr string) error {
+	n, err := newNetlinkNeigh(ifname, ipaddr)
+	if err != nil {
+		return err
+	}
+
+	if err := netlink.NeighDel(n); err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	return nil
+}
+
+// FlushNeighbors removes all dynamic neighbor entries of the interface
+// and returns the number of removed entries. Like "ip neigh flush",
+// it leaves permanent and NOARP entries intact.
+func (s *Server) FlushNeighbors(ctx context.Context, family InetFamily, ifname string) (int, error) {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return 0, os.NewSyscallError("rtnetlink", err)
+	}
+
+	tmp, err := netlink.NeighList(link.Attrs().Index, int(family))
+	if err != nil {
+		return 0, os.NewSyscallError("rtnetlink", err)
+	}
+
+	var count int
+
+	for _, x := range tmp {
+		if x.IP == nil || x.State&(netlink.NUD_PERMANENT|netlink.NUD_NOARP) != 0 {
+			continue
+		}
+
+		if err := netlink.NeighDel(&x); err != nil {
+			// The entry could be removed by the kernel in the meantime
+			if errors.Is(err, unix.ENOENT) {
+				continue
+			}
+
+			return count, os.NewSyscallError("rtnetlink", err)
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+func (s *Server) GetInterfaces(ctx context.Context) ([]*InterfaceInfo, error) {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	names := make(map[int]string, len(links))
+
+	for _, link := range links {
+		names[link.Attrs().Index] = link.Attrs().Name
+	}
+
+	ifs := make([]*InterfaceInfo, 0, len(links))
+
+	for _, link := range links {
+		iface, err := linkToInterfaceInfo(link, names)
+		if err != nil {
+			return nil, err
+		}
+
+		ifs = append(ifs, iface)
 	}
 
 	return ifs, nil
 }
 
+func (s *Server) SetLinkAttributes(ctx context.Context, ifname string, attrs *LinkAttrs) (*InterfaceInfo, error) {
+	if err := SetLinkAttributes(ifname, attrs); err != nil {
+		return nil, err
+	}
+
+	if len(attrs.Name) > 0 {
+		ifname = attrs.Name
+	}
+
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	names, err := getLinkNames()
+	if err != nil {
+		return nil, err
+	}
+
+	return linkToInterfaceInfo(link, names)
+}
+
+func (s *Server) CreateLink(ctx context.Context, attrs *VirtualLinkAttrs) (*InterfaceInfo, error) {
+	if err := CreateLink(attrs); err != nil {
+		return nil, err
+	}
+
+	link, err := netlink.LinkByName(attrs.Name)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	names, err := getLinkNames()
+	if err != nil {
+		return nil, err
+	}
+
+	return linkToInterfaceInfo(link, names)
+}
+
+// DeleteLink removes the virtual link. Physical devices cannot be removed.
+func (s *Server) DeleteLink(ctx context.Context, ifname string) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if !isVirtualLinkType(link.Type()) {
+		return fmt.Errorf("%w: %s is of type %q", ErrUnsupportedLinkType, ifname, link.Type())
+	}
+
+	if err := netlink.LinkDel(link); err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	return nil
+}
+
 func (s *Server) SetInterfaceLinkUp(ctx context.Context, ifname string) error {
 	if err := SetInterfaceLinkUp(ifname); err != nil {
 		return err
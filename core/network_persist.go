@@ -0,0 +1,197 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/dhcp"
+	"github.com/0xef53/phoenix-guest-agent/internal/netpersist"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func (s *Server) PersistInterfaceConfig(ctx context.Context, ifname string) error {
+	return PersistInterfaceConfig(ifname)
+}
+
+// PersistInterfaceConfig saves the current runtime configuration of the interface
+// (MTU, static addresses, routes and the policy rules that look up their tables)
+// into the configuration of the network backend
+// used by the guest (netplan, NetworkManager, systemd-networkd or ifupdown),
+// so that it survives a reboot. DHCP and IPv6 autoconfiguration remain enabled
+// if the interface has any dynamic addresses or routes. The other definitions
+// of the interface in the backend configuration are disabled.
+func PersistInterfaceConfig(ifname string) error {
+	backend, err := netpersist.DetectBackend()
+	if err != nil {
+		return err
+	}
+
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	iface := netpersist.Interface{
+		Name: link.Attrs().Name,
+		MTU:  link.Attrs().MTU,
+	}
+
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	for _, a := range addrs {
+		// The addresses obtained via DHCP/SLAAC have a limited lifetime
+		if a.Flags&unix.IFA_F_PERMANENT == 0 {
+			if a.IP.To4() != nil {
+				iface.DHCP4 = true
+			} else {
+				iface.DHCP6 = true
+			}
+			continue
+		}
+
+		if a.IP.To4() == nil && a.IP.IsLinkLocalUnicast() {
+			continue
+		}
+
+		iface.Addrs = append(iface.Addrs, a.IPNet.String())
+	}
+
+	if err := detectDynamicConfig(link, &iface); err != nil {
+		return err
+	}
+
+	routes, err := managedRouteList(map[int]struct{}{link.Attrs().Index: {}})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range routes {
+		if r.LinkIndex != link.Attrs().Index {
+			if len(r.MultiPath) > 0 {
+				log.Warnf("Multipath routes cannot be persisted, skipping: %s", r)
+			}
+			continue
+		}
+
+		ri := routeToRouteInfo(r, nil)
+
+		pr := netpersist.Route{
+			Dst:    ri.Dst,
+			Gw:     ri.Gw,
+			Metric: ri.Priority,
+			OnLink: ri.OnLink,
+		}
+
+		if ri.Table != unix.RT_TABLE_MAIN {
+			pr.Table = ri.Table
+		}
+
+		iface.Routes = append(iface.Routes, &pr)
+	}
+
+	if iface.Rules, err = persistentRuleList(iface.Routes); err != nil {
+		return err
+	}
+
+	fname, modified, err := netpersist.Write(backend, &iface)
+	for _, f := range modified {
+		log.WithField("backend", backend).Infof("Previous configuration of %s disabled in %s", ifname, f)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to persist %s configuration: %w", ifname, err)
+	}
+
+	log.WithField("backend", backend).Infof("Configuration of %s saved to %s", ifname, fname)
+
+	return nil
+}
+
+// detectDynamicConfig looks for the routes installed by DHCP clients
+// or obtained from the router advertisements, and for the leases
+// of the built-in DHCP client.
+func detectDynamicConfig(link netlink.Link, iface *netpersist.Interface) error {
+	routes, err := netlink.RouteList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	for _, r := range routes {
+		switch r.Protocol {
+		case unix.RTPROT_DHCP, unix.RTPROT_RA:
+			if r.Family == unix.AF_INET6 {
+				iface.DHCP6 = true
+			} else {
+				iface.DHCP4 = true
+			}
+		}
+	}
+
+	// The lease file is kept while the address is being reacquired
+	for family, enabled := range map[int]*bool{4: &iface.DHCP4, 6: &iface.DHCP6} {
+		if _, err := os.Stat(dhcp.LeasePath(DHCPLeaseDir, iface.Name, family)); err == nil {
+			*enabled = true
+		}
+	}
+
+	return nil
+}
+
+// persistentRuleList returns the policy rules that look up the non-main
+// tables of the given routes, without them these routes are useless.
+func persistentRuleList(routes []*netpersist.Route) ([]*netpersist.Rule, error) {
+	tables := make(map[int]struct{})
+
+	for _, r := range routes {
+		if r.Table > 0 {
+			tables[r.Table] = struct{}{}
+		}
+	}
+
+	if len(tables) == 0 {
+		return nil, nil
+	}
+
+	rules, err := netlink.RuleList(netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	res := make([]*netpersist.Rule, 0, len(rules))
+
+	for _, r := range rules {
+		if _, ok := tables[r.Table]; !ok {
+			continue
+		}
+
+		// Not all backends support these selectors
+		if len(r.IifName) > 0 || len(r.OifName) > 0 || r.Invert || (r.Mask != nil && *r.Mask != 0 && *r.Mask != 0xffffffff) {
+			log.Warnf("Policy rule cannot be persisted, skipping: %s", r)
+			continue
+		}
+
+		pr := netpersist.Rule{
+			Priority: r.Priority,
+			Mark:     r.Mark,
+			Table:    r.Table,
+			IPv6:     r.Family == netlink.FAMILY_V6,
+		}
+
+		if r.Src != nil {
+			pr.From = r.Src.String()
+		}
+		if r.Dst != nil {
+			pr.To = r.Dst.String()
+		}
+
+		res = append(res, &pr)
+	}
+
+	return res, nil
+}
@@ -23,22 +23,169 @@ type RouteInfo struct {
 	Src       string
 	Gw        string
 	Table     int
+	Priority  int
+	Protocol  int
+	Type      int
+	MTU       int
+	OnLink    bool
+	MultiPath []*RouteNextHop
 }
 
 type RouteAttrs struct {
-	LinkName string
-	Scope    netlink.Scope
+	LinkName  string
+	Scope     netlink.Scope
+	Dst       string
+	Src       string
+	Gw        string
+	Table     int
+	Priority  int
+	Protocol  int
+	Type      int
+	MTU       int
+	OnLink    bool
+	MultiPath []*RouteNextHop
+}
+
+type RouteNextHop struct {
+	LinkIndex int
+	LinkName  string
+	Gw        string
+	Weight    int
+	OnLink    bool
+}
+
+type RuleInfo struct {
+	Priority int
+	Family   InetFamily
+	Src      string
 	Dst      string
+	IifName  string
+	OifName  string
+	Mark     uint32
+	Mask     uint32
+	Table    int
+	Invert   bool
+}
+
+type RuleAttrs struct {
+	Priority int // 0 means "assigned by kernel" or "any" for deletion
+	Family   InetFamily
 	Src      string
-	Gw       string
+	Dst      string
+	IifName  string
+	OifName  string
+	Mark     uint32
+	Mask     uint32
 	Table    int
+	Invert   bool
+}
+
+type NeighborInfo struct {
+	LinkIndex int
+	LinkName  string
+	Family    InetFamily
+	IP        string
+	HwAddr    string
+	State     string
+	Router    bool
+}
+
+type NetworkWatchOptions struct {
+	Links     bool
+	Addrs     bool
+	Routes    bool
+	Neighbors bool
+}
+
+// NetworkEvent describes a change of a link, an address, a route or
+// a neighbor entry. Only one of the fields is set depending on the type.
+type NetworkEvent struct {
+	Deleted  bool
+	Link     *InterfaceInfo
+	Addr     *AddrInfo
+	Route    *RouteInfo
+	Neighbor *NeighborInfo
+}
+
+type AddrInfo struct {
+	LinkIndex int
+	LinkName  string
+	Addr      string
+	Scope     int
+	Flags     int
 }
 
 type InterfaceInfo struct {
-	Index  int
+	Index      int
+	Name       string
+	HwAddr     string
+	Flags      net.Flags
+	MTU        int
+	Addrs      []string
+	OperState  string
+	LinkType   string
+	MasterName string
+	Carrier    bool
+	Speed      int // Mbit/s, 0 if unknown
+	Duplex     string
+	Stats      *InterfaceStats
+	TxQLen     int
+	Alias      string
+}
+
+// VirtualLinkAttrs describes a virtual link to be created.
+// The type-specific fields are ignored for other link types.
+type VirtualLinkAttrs struct {
 	Name   string
-	HwAddr string
-	Flags  net.Flags
+	Type   string // one of "vlan", "bridge", "bond", "macvlan", "dummy", "wireguard"
 	MTU    int
-	Addrs  []string
+	HwAddr string
+	Up     bool
+
+	// vlan, macvlan
+	Parent string
+
+	// vlan
+	VlanID       int
+	VlanProtocol string
+
+	// macvlan
+	MacvlanMode string
+
+	// bond
+	BondMode       string
+	MiiMon         int
+	LacpRate       string
+	XmitHashPolicy string
+	UpDelay        int
+	DownDelay      int
+
+	// bridge
+	STP          bool
+	ForwardDelay int // seconds
+
+	// Bridge ports or bond members
+	Ports []string
+}
+
+// LinkAttrs describes the link attributes to be changed.
+// Zero values leave the corresponding attributes as is.
+type LinkAttrs struct {
+	MTU        int
+	HwAddr     string
+	Name       string
+	TxQLen     int
+	Alias      string
+	ClearAlias bool // removes the alias, since an empty Alias means "leave as is"
+}
+
+type InterfaceStats struct {
+	RxBytes   uint64
+	RxPackets uint64
+	RxErrors  uint64
+	RxDropped uint64
+	TxBytes   uint64
+	TxPackets uint64
+	TxErrors  uint64
+	TxDropped uint64
 }
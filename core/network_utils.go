@@ -4,37 +4,36 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
-func UpdateRouteTable(action string, link netlink.Link, dst, src, gw string, scope netlink.Scope, table int) error {
-	dstNet, err := netlink.ParseIPNet(dst)
+func UpdateRouteTable(action string, attrs *RouteAttrs) error {
+	r, err := newNetlinkRoute(attrs)
 	if err != nil {
 		return err
 	}
 
-	r := netlink.Route{
-		LinkIndex: link.Attrs().Index,
-		Dst:       dstNet,
-		Src:       net.ParseIP(src),
-		Gw:        net.ParseIP(gw),
-		Scope:     scope,
-		Table:     table,
-	}
+	return routeUpdate(action, r)
+}
 
+func routeUpdate(action string, r *netlink.Route) error {
 	switch action {
 	case "add":
-		if err := netlink.RouteAdd(&r); err != nil {
+		if err := netlink.RouteAdd(r); err != nil {
 			return os.NewSyscallError("rtnetlink", err)
 		}
 	case "replace":
-		if err := netlink.RouteReplace(&r); err != nil {
+		if err := netlink.RouteReplace(r); err != nil {
 			return os.NewSyscallError("rtnetlink", err)
 		}
 	case "del":
-		if err := netlink.RouteDel(&r); err != nil {
+		if err := netlink.RouteDel(r); err != nil {
 			return os.NewSyscallError("rtnetlink", err)
 		}
 	default:
@@ -44,6 +43,675 @@ func UpdateRouteTable(action string, link netlink.Link, dst, src, gw string, sco
 	return nil
 }
 
+func newNetlinkRoute(attrs *RouteAttrs) (*netlink.Route, error) {
+	dstNet, err := netlink.ParseIPNet(attrs.Dst)
+	if err != nil {
+		return nil, err
+	}
+
+	r := netlink.Route{
+		Dst:      dstNet,
+		Src:      net.ParseIP(attrs.Src),
+		Gw:       net.ParseIP(attrs.Gw),
+		Scope:    attrs.Scope,
+		Table:    attrs.Table,
+		Priority: attrs.Priority,
+		Protocol: netlink.RouteProtocol(attrs.Protocol),
+		Type:     attrs.Type,
+		MTU:      attrs.MTU,
+	}
+
+	if dstNet.IP.To4() != nil {
+		r.Family = netlink.FAMILY_V4
+	} else {
+		r.Family = netlink.FAMILY_V6
+	}
+
+	// Routes like blackhole/unreachable have no output device
+	if len(attrs.LinkName) > 0 {
+		link, err := netlink.LinkByName(attrs.LinkName)
+		if err != nil {
+			return nil, os.NewSyscallError("rtnetlink", err)
+		}
+
+		r.LinkIndex = link.Attrs().Index
+	}
+
+	if attrs.OnLink {
+		r.Flags = int(netlink.FLAG_ONLINK)
+	}
+
+	for _, nh := range attrs.MultiPath {
+		hop := netlink.NexthopInfo{
+			Gw: net.ParseIP(nh.Gw),
+		}
+
+		if len(nh.LinkName) > 0 {
+			link, err := netlink.LinkByName(nh.LinkName)
+			if err != nil {
+				return nil, os.NewSyscallError("rtnetlink", err)
+			}
+
+			hop.LinkIndex = link.Attrs().Index
+		}
+
+		// The kernel stores the weight as "hops" that is weight - 1
+		if nh.Weight > 1 {
+			hop.Hops = nh.Weight - 1
+		}
+
+		if nh.OnLink {
+			hop.Flags = int(netlink.FLAG_ONLINK)
+		}
+
+		r.MultiPath = append(r.MultiPath, &hop)
+	}
+
+	return &r, nil
+}
+
+func routeToRouteInfo(x *netlink.Route, names map[int]string) *RouteInfo {
+	r := RouteInfo{
+		LinkIndex: x.LinkIndex,
+		LinkName:  names[x.LinkIndex],
+		Scope:     x.Scope,
+		Table:     x.Table,
+		Priority:  x.Priority,
+		Protocol:  int(x.Protocol),
+		Type:      x.Type,
+		MTU:       x.MTU,
+		OnLink:    x.Flags&int(netlink.FLAG_ONLINK) != 0,
+	}
+
+	if x.Src != nil {
+		r.Src = x.Src.String()
+	}
+
+	switch {
+	case x.Dst != nil:
+		r.Dst = x.Dst.String()
+	case x.Family == netlink.FAMILY_V6:
+		r.Dst = "::/0"
+	default:
+		r.Dst = "0.0.0.0/0"
+	}
+
+	if x.Gw != nil {
+		r.Gw = x.Gw.String()
+	}
+
+	for _, nh := range x.MultiPath {
+		hop := RouteNextHop{
+			LinkIndex: nh.LinkIndex,
+			LinkName:  names[nh.LinkIndex],
+			Weight:    nh.Hops + 1,
+			OnLink:    nh.Flags&int(netlink.FLAG_ONLINK) != 0,
+		}
+
+		if nh.Gw != nil {
+			hop.Gw = nh.Gw.String()
+		}
+
+		r.MultiPath = append(r.MultiPath, &hop)
+	}
+
+	return &r
+}
+
+// getLinkNames returns a map of link indexes to link names.
+func getLinkNames() (map[int]string, error) {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	names := make(map[int]string, len(links))
+
+	for _, link := range links {
+		names[link.Attrs().Index] = link.Attrs().Name
+	}
+
+	return names, nil
+}
+
+func UpdateRuleList(action string, attrs *RuleAttrs) error {
+	r, err := newNetlinkRule(attrs)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "add":
+		if err := netlink.RuleAdd(r); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	case "del":
+		if err := netlink.RuleDel(r); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	default:
+		return fmt.Errorf("unknown action: %s", action)
+	}
+
+	return nil
+}
+
+func newNetlinkRule(attrs *RuleAttrs) (*netlink.Rule, error) {
+	r := netlink.NewRule()
+
+	r.Family = int(attrs.Family)
+	r.Table = attrs.Table
+	r.IifName = attrs.IifName
+	r.OifName = attrs.OifName
+	r.Mark = attrs.Mark
+	r.Invert = attrs.Invert
+
+	if attrs.Priority > 0 {
+		r.Priority = attrs.Priority
+	}
+
+	if attrs.Mask != 0 {
+		mask := attrs.Mask
+		r.Mask = &mask
+	}
+
+	if len(attrs.Src) > 0 {
+		n, err := netlink.ParseIPNet(attrs.Src)
+		if err != nil {
+			return nil, err
+		}
+		r.Src = n
+	}
+
+	if len(attrs.Dst) > 0 {
+		n, err := netlink.ParseIPNet(attrs.Dst)
+		if err != nil {
+			return nil, err
+		}
+		r.Dst = n
+	}
+
+	if r.Family == 0 {
+		switch {
+		case r.Src != nil && r.Src.IP.To4() == nil, r.Dst != nil && r.Dst.IP.To4() == nil:
+			r.Family = netlink.FAMILY_V6
+		default:
+			r.Family = netlink.FAMILY_V4
+		}
+	}
+
+	return r, nil
+}
+
+func ruleToRuleInfo(x *netlink.Rule) *RuleInfo {
+	r := RuleInfo{
+		Family:  InetFamily(x.Family),
+		IifName: x.IifName,
+		OifName: x.OifName,
+		Mark:    x.Mark,
+		Table:   x.Table,
+		Invert:  x.Invert,
+	}
+
+	// The kernel omits the priority attribute for the rule with priority 0
+	if x.Priority > 0 {
+		r.Priority = x.Priority
+	}
+
+	if x.Mask != nil {
+		r.Mask = *x.Mask
+	}
+
+	if x.Src != nil {
+		r.Src = x.Src.String()
+	}
+
+	if x.Dst != nil {
+		r.Dst = x.Dst.String()
+	}
+
+	return &r
+}
+
+func newNetlinkNeigh(ifname, ipaddr string) (*netlink.Neigh, error) {
+	ip := net.ParseIP(ipaddr)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address: %s", ipaddr)
+	}
+
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	n := netlink.Neigh{
+		LinkIndex: link.Attrs().Index,
+		IP:        ip,
+	}
+
+	if ip.To4() != nil {
+		n.Family = netlink.FAMILY_V4
+	} else {
+		n.Family = netlink.FAMILY_V6
+	}
+
+	return &n, nil
+}
+
+func neighToNeighborInfo(x *netlink.Neigh, names map[int]string) *NeighborInfo {
+	n := NeighborInfo{
+		LinkIndex: x.LinkIndex,
+		LinkName:  names[x.LinkIndex],
+		Family:    InetFamily(x.Family),
+		IP:        x.IP.String(),
+		State:     neighStateString(x.State),
+		Router:    x.Flags&netlink.NTF_ROUTER != 0,
+	}
+
+	if len(x.HardwareAddr) > 0 {
+		n.HwAddr = x.HardwareAddr.String()
+	}
+
+	return &n
+}
+
+var neighStates = []struct {
+	state int
+	name  string
+}{
+	{netlink.NUD_INCOMPLETE, "INCOMPLETE"},
+	{netlink.NUD_REACHABLE, "REACHABLE"},
+	{netlink.NUD_STALE, "STALE"},
+	{netlink.NUD_DELAY, "DELAY"},
+	{netlink.NUD_PROBE, "PROBE"},
+	{netlink.NUD_FAILED, "FAILED"},
+	{netlink.NUD_NOARP, "NOARP"},
+	{netlink.NUD_PERMANENT, "PERMANENT"},
+}
+
+// neighStateString returns the NUD state in the ip-neighbour(8) notation,
+// e.g. "REACHABLE" or "STALE".
+func neighStateString(state int) string {
+	if state == netlink.NUD_NONE {
+		return "NONE"
+	}
+
+	names := make([]string, 0, 1)
+
+	for _, x := range neighStates {
+		if state&x.state != 0 {
+			names = append(names, x.name)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
+
+var virtualLinkTypes = []string{"vlan", "bridge", "bond", "macvlan", "dummy", "wireguard"}
+
+func isVirtualLinkType(t string) bool {
+	for _, x := range virtualLinkTypes {
+		if x == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+var macvlanModes = map[string]netlink.MacvlanMode{
+	"private":  netlink.MACVLAN_MODE_PRIVATE,
+	"vepa":     netlink.MACVLAN_MODE_VEPA,
+	"bridge":   netlink.MACVLAN_MODE_BRIDGE,
+	"passthru": netlink.MACVLAN_MODE_PASSTHRU,
+}
+
+func newNetlinkLink(attrs *VirtualLinkAttrs) (netlink.Link, error) {
+	if len(attrs.Name) == 0 || len(attrs.Name) >= unix.IFNAMSIZ {
+		return nil, fmt.Errorf("invalid interface name: %q", attrs.Name)
+	}
+
+	la := netlink.NewLinkAttrs()
+
+	la.Name = attrs.Name
+	la.MTU = attrs.MTU
+
+	if len(attrs.HwAddr) > 0 {
+		hwaddr, err := net.ParseMAC(attrs.HwAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		la.HardwareAddr = hwaddr
+	}
+
+	switch attrs.Type {
+	case "vlan", "macvlan":
+		if len(attrs.Parent) == 0 {
+			return nil, fmt.Errorf("parent link is not specified")
+		}
+
+		parent, err := netlink.LinkByName(attrs.Parent)
+		if err != nil {
+			return nil, os.NewSyscallError("rtnetlink", err)
+		}
+
+		la.ParentIndex = parent.Attrs().Index
+	}
+
+	if len(attrs.Ports) > 0 && attrs.Type != "bridge" && attrs.Type != "bond" {
+		return nil, fmt.Errorf("link of type %q cannot have ports", attrs.Type)
+	}
+
+	switch attrs.Type {
+	case "vlan":
+		if attrs.VlanID < 1 || attrs.VlanID > 4094 {
+			return nil, fmt.Errorf("invalid VLAN ID: %d", attrs.VlanID)
+		}
+
+		proto := netlink.VLAN_PROTOCOL_8021Q
+
+		if len(attrs.VlanProtocol) > 0 {
+			if proto = netlink.StringToVlanProtocol(strings.ToLower(attrs.VlanProtocol)); proto == netlink.VLAN_PROTOCOL_UNKNOWN {
+				return nil, fmt.Errorf("invalid VLAN protocol: %s", attrs.VlanProtocol)
+			}
+		}
+
+		return &netlink.Vlan{LinkAttrs: la, VlanId: attrs.VlanID, VlanProtocol: proto}, nil
+	case "macvlan":
+		mode := netlink.MACVLAN_MODE_BRIDGE
+
+		if len(attrs.MacvlanMode) > 0 {
+			v, ok := macvlanModes[attrs.MacvlanMode]
+			if !ok {
+				return nil, fmt.Errorf("invalid macvlan mode: %s", attrs.MacvlanMode)
+			}
+			mode = v
+		}
+
+		return &netlink.Macvlan{LinkAttrs: la, Mode: mode}, nil
+	case "bond":
+		bond := netlink.NewLinkBond(la)
+
+		if len(attrs.BondMode) > 0 {
+			if bond.Mode = netlink.StringToBondMode(attrs.BondMode); bond.Mode == netlink.BOND_MODE_UNKNOWN {
+				return nil, fmt.Errorf("invalid bond mode: %s", attrs.BondMode)
+			}
+		}
+
+		if attrs.MiiMon > 0 {
+			bond.Miimon = attrs.MiiMon
+		}
+
+		if len(attrs.LacpRate) > 0 {
+			if bond.LacpRate = netlink.StringToBondLacpRate(attrs.LacpRate); bond.LacpRate == netlink.BOND_LACP_RATE_UNKNOWN {
+				return nil, fmt.Errorf("invalid bond LACP rate: %s", attrs.LacpRate)
+			}
+		}
+
+		if len(attrs.XmitHashPolicy) > 0 {
+			if bond.XmitHashPolicy = netlink.StringToBondXmitHashPolicy(attrs.XmitHashPolicy); bond.XmitHashPolicy == netlink.BOND_XMIT_HASH_POLICY_UNKNOWN {
+				return nil, fmt.Errorf("invalid bond transmit hash policy: %s", attrs.XmitHashPolicy)
+			}
+		}
+
+		if attrs.UpDelay > 0 {
+			bond.UpDelay = attrs.UpDelay
+		}
+
+		if attrs.DownDelay > 0 {
+			bond.DownDelay = attrs.DownDelay
+		}
+
+		return bond, nil
+	case "bridge":
+		return &netlink.Bridge{LinkAttrs: la}, nil
+	case "dummy":
+		return &netlink.Dummy{LinkAttrs: la}, nil
+	case "wireguard":
+		return &netlink.Wireguard{LinkAttrs: la}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedLinkType, attrs.Type)
+}
+
+// SetLinkAttributes changes the link attributes. The link must be down to be renamed.
+func SetLinkAttributes(ifname string, attrs *LinkAttrs) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	// Validate the arguments first so as not to leave the link half-configured
+	// because of an invalid one. The kernel can still reject a valid value
+	// (e.g. the MTU is out of the device range), then the previous changes remain
+	var hwaddr net.HardwareAddr
+
+	if len(attrs.HwAddr) > 0 {
+		if hwaddr, err = net.ParseMAC(attrs.HwAddr); err != nil {
+			return err
+		}
+	}
+
+	rename := len(attrs.Name) > 0 && attrs.Name != link.Attrs().Name
+
+	if rename {
+		if len(attrs.Name) >= unix.IFNAMSIZ {
+			return fmt.Errorf("interface name is too long: %s", attrs.Name)
+		}
+
+		if strings.ContainsAny(attrs.Name, "/: \t\n") || attrs.Name == "." || attrs.Name == ".." {
+			return fmt.Errorf("invalid interface name: %s", attrs.Name)
+		}
+
+		if link.Attrs().Flags&net.FlagUp != 0 {
+			return fmt.Errorf("%w: %s", ErrLinkIsUp, ifname)
+		}
+	}
+
+	// IFALIASZ in the kernel
+	if len(attrs.Alias) >= 256 {
+		return fmt.Errorf("interface alias is too long: %s", attrs.Alias)
+	}
+
+	if attrs.ClearAlias && len(attrs.Alias) > 0 {
+		return fmt.Errorf("alias cannot be set and cleared at the same time")
+	}
+
+	if attrs.MTU < 0 || attrs.TxQLen < 0 {
+		return fmt.Errorf("MTU and txqueuelen cannot be negative")
+	}
+
+	if attrs.MTU > 0 {
+		if err := netlink.LinkSetMTU(link, attrs.MTU); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	if hwaddr != nil {
+		if err := netlink.LinkSetHardwareAddr(link, hwaddr); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	if attrs.TxQLen > 0 {
+		if err := netlink.LinkSetTxQLen(link, attrs.TxQLen); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	if len(attrs.Alias) > 0 || attrs.ClearAlias {
+		if err := netlink.LinkSetAlias(link, attrs.Alias); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	if rename {
+		if err := netlink.LinkSetName(link, attrs.Name); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	return nil
+}
+
+// CreateLink creates a new virtual link and attaches the ports to it.
+func CreateLink(attrs *VirtualLinkAttrs) error {
+	link, err := newNetlinkLink(attrs)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the ports before creating anything
+	ports := make([]netlink.Link, 0, len(attrs.Ports))
+
+	for _, name := range attrs.Ports {
+		p, err := netlink.LinkByName(name)
+		if err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+
+		ports = append(ports, p)
+	}
+
+	if err := netlink.LinkAdd(link); err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if err := setupVirtualLink(link, attrs, ports); err != nil {
+		// Don't leave the half-configured link in the system
+		if err := netlink.LinkDel(link); err != nil {
+			log.Errorf("Unable to remove link %s: %s", attrs.Name, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// setupVirtualLink applies the bridge options, attaches the ports
+// to the newly created bridge/bond and brings the link up if requested.
+func setupVirtualLink(link netlink.Link, attrs *VirtualLinkAttrs, ports []netlink.Link) error {
+	if _, ok := link.(*netlink.Bridge); ok {
+		if err := setBridgeOptions(attrs.Name, attrs.STP, attrs.ForwardDelay); err != nil {
+			return err
+		}
+	}
+
+	for i, p := range ports {
+		if err := linkSetMaster(p, link); err != nil {
+			restorePorts(ports[:i+1])
+
+			return err
+		}
+	}
+
+	if attrs.Up {
+		if err := netlink.LinkSetUp(link); err != nil {
+			restorePorts(ports)
+
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	return nil
+}
+
+// restorePorts returns the ports to their previous masters and up/down
+// state. The ports must be resolved before they were attached, so that
+// their attributes contain the previous state.
+func restorePorts(ports []netlink.Link) {
+	for _, p := range ports {
+		attrs := p.Attrs()
+
+		var err error
+
+		if attrs.MasterIndex > 0 {
+			var m netlink.Link
+
+			if m, err = netlink.LinkByIndex(attrs.MasterIndex); err == nil {
+				err = linkSetMaster(p, m)
+			}
+		} else {
+			err = netlink.LinkSetNoMaster(p)
+		}
+		if err != nil {
+			log.Errorf("Unable to restore the master of %s: %s", attrs.Name, err)
+		}
+
+		if attrs.Flags&net.FlagUp != 0 {
+			err = netlink.LinkSetUp(p)
+		} else {
+			err = netlink.LinkSetDown(p)
+		}
+		if err != nil {
+			log.Errorf("Unable to restore the state of %s: %s", attrs.Name, err)
+		}
+	}
+}
+
+// setBridgeOptions configures the options that are not supported
+// by the netlink library via sysfs.
+func setBridgeOptions(ifname string, stp bool, forwardDelay int) error {
+	opts := make(map[string]string)
+
+	if stp {
+		opts["stp_state"] = "1"
+	}
+
+	if forwardDelay > 0 {
+		// In centiseconds
+		opts["forward_delay"] = strconv.Itoa(forwardDelay * 100)
+	}
+
+	for k, v := range opts {
+		if err := os.WriteFile(filepath.Join("/sys/class/net", ifname, "bridge", k), []byte(v), 0644); err != nil {
+			return fmt.Errorf("unable to set bridge option %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// SetLinkMaster attaches the link to the bridge or bond.
+func SetLinkMaster(ifname, master string) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	m, err := netlink.LinkByName(master)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if link.Attrs().MasterIndex == m.Attrs().Index {
+		return nil
+	}
+
+	return linkSetMaster(link, m)
+}
+
+func linkSetMaster(link, master netlink.Link) error {
+	// The bonding driver requires the members to be down
+	if master.Type() == "bond" {
+		if err := netlink.LinkSetDown(link); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	if err := netlink.LinkSetMaster(link, master); err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if err := netlink.LinkSetUp(link); err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	return nil
+}
+
 func SetInterfaceLinkUp(ifname string) error {
 	iface := &netlink.Device{
 		LinkAttrs: netlink.LinkAttrs{Name: ifname},
@@ -106,3 +774,81 @@ func ParseCIDR(s string) (net.IP, *net.IPNet, error) {
 
 	return net.ParseCIDR(s)
 }
+
+func linkToInterfaceInfo(link netlink.Link, names map[int]string) (*InterfaceInfo, error) {
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	iface := linkAttrsToInterfaceInfo(link, names)
+
+	iface.Addrs = make([]string, 0, len(addrs))
+
+	for _, v := range addrs {
+		iface.Addrs = append(iface.Addrs, v.IPNet.String())
+	}
+
+	return iface, nil
+}
+
+// linkAttrsToInterfaceInfo is like linkToInterfaceInfo
+// but doesn't query the link addresses.
+func linkAttrsToInterfaceInfo(link netlink.Link, names map[int]string) *InterfaceInfo {
+	attrs := link.Attrs()
+
+	iface := InterfaceInfo{
+		Index:     attrs.Index,
+		Name:      attrs.Name,
+		Flags:     attrs.Flags,
+		MTU:       attrs.MTU,
+		HwAddr:    attrs.HardwareAddr.String(),
+		OperState: attrs.OperState.String(),
+		LinkType:  link.Type(),
+		Carrier:   attrs.RawFlags&unix.IFF_LOWER_UP != 0,
+		TxQLen:    attrs.TxQLen,
+		Alias:     attrs.Alias,
+	}
+
+	if attrs.MasterIndex > 0 {
+		iface.MasterName = names[attrs.MasterIndex]
+	}
+
+	iface.Speed, iface.Duplex = getLinkSpeed(attrs.Name)
+
+	if st := attrs.Statistics; st != nil {
+		iface.Stats = &InterfaceStats{
+			RxBytes:   st.RxBytes,
+			RxPackets: st.RxPackets,
+			RxErrors:  st.RxErrors,
+			RxDropped: st.RxDropped,
+			TxBytes:   st.TxBytes,
+			TxPackets: st.TxPackets,
+			TxErrors:  st.TxErrors,
+			TxDropped: st.TxDropped,
+		}
+	}
+
+	return &iface
+}
+
+// getLinkSpeed returns the link speed (in Mbit/s) and the duplex mode
+// as reported by the driver. Virtual devices usually don't report them.
+func getLinkSpeed(ifname string) (int, string) {
+	var speed int
+	var duplex string
+
+	if b, err := os.ReadFile(filepath.Join("/sys/class/net", ifname, "speed")); err == nil {
+		if v, err := strconv.Atoi(strings.TrimSpace(string(b))); err == nil && v > 0 {
+			speed = v
+		}
+	}
+
+	if b, err := os.ReadFile(filepath.Join("/sys/class/net", ifname, "duplex")); err == nil {
+		if v := strings.TrimSpace(string(b)); v != "unknown" {
+			duplex = v
+		}
+	}
+
+	return speed, duplex
+}
@@ -0,0 +1,171 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+var errSubscriptionClosed = errors.New("netlink subscription closed unexpectedly")
+
+// WatchNetwork subscribes to the netlink notifications and calls fn
+// for each received event until ctx is canceled or fn returns an error.
+func (s *Server) WatchNetwork(ctx context.Context, opts *NetworkWatchOptions, fn func(*NetworkEvent) error) error {
+	if !opts.Links && !opts.Addrs && !opts.Routes && !opts.Neighbors {
+		opts = &NetworkWatchOptions{Links: true, Addrs: true, Routes: true, Neighbors: true}
+	}
+
+	// Closing this channel stops all subscriptions
+	done := make(chan struct{})
+
+	// Fatal errors also close the corresponding channel,
+	// so here they are only logged
+	onError := func(err error) {
+		select {
+		case <-done:
+		default:
+			log.Warnf("Network watcher: %s", err)
+		}
+	}
+
+	var linkCh chan netlink.LinkUpdate
+	var addrCh chan netlink.AddrUpdate
+	var routeCh chan netlink.RouteUpdate
+	var neighCh chan netlink.NeighUpdate
+
+	defer func() {
+		close(done)
+
+		// A subscription goroutine can be blocked while sending
+		// to a full channel, so drain them until closed.
+		// Only the successfully subscribed channels are set here.
+		go drain(linkCh)
+		go drain(addrCh)
+		go drain(routeCh)
+		go drain(neighCh)
+	}()
+
+	if opts.Links {
+		ch := make(chan netlink.LinkUpdate, 64)
+
+		if err := netlink.LinkSubscribeWithOptions(ch, done, netlink.LinkSubscribeOptions{ErrorCallback: onError}); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+
+		linkCh = ch
+	}
+
+	if opts.Addrs {
+		ch := make(chan netlink.AddrUpdate, 64)
+
+		if err := netlink.AddrSubscribeWithOptions(ch, done, netlink.AddrSubscribeOptions{ErrorCallback: onError}); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+
+		addrCh = ch
+	}
+
+	if opts.Routes {
+		ch := make(chan netlink.RouteUpdate, 64)
+
+		if err := netlink.RouteSubscribeWithOptions(ch, done, netlink.RouteSubscribeOptions{ErrorCallback: onError}); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+
+		routeCh = ch
+	}
+
+	if opts.Neighbors {
+		ch := make(chan netlink.NeighUpdate, 64)
+
+		if err := netlink.NeighSubscribeWithOptions(ch, done, netlink.NeighSubscribeOptions{ErrorCallback: onError}); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+
+		neighCh = ch
+	}
+
+	names, err := getLinkNames()
+	if err != nil {
+		return err
+	}
+
+	for {
+		var ev *NetworkEvent
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case u, ok := <-linkCh:
+			if !ok {
+				return errSubscriptionClosed
+			}
+
+			names[u.Attrs().Index] = u.Attrs().Name
+
+			ev = &NetworkEvent{
+				Deleted: u.Header.Type == unix.RTM_DELLINK,
+				Link:    linkAttrsToInterfaceInfo(u.Link, names),
+			}
+
+			if ev.Deleted {
+				delete(names, u.Attrs().Index)
+			}
+		case u, ok := <-addrCh:
+			if !ok {
+				return errSubscriptionClosed
+			}
+
+			ev = &NetworkEvent{
+				Deleted: !u.NewAddr,
+				Addr: &AddrInfo{
+					LinkIndex: u.LinkIndex,
+					LinkName:  names[u.LinkIndex],
+					Addr:      u.LinkAddress.String(),
+					Scope:     u.Scope,
+					Flags:     u.Flags,
+				},
+			}
+		case u, ok := <-routeCh:
+			if !ok {
+				return errSubscriptionClosed
+			}
+
+			ev = &NetworkEvent{
+				Deleted: u.Type == unix.RTM_DELROUTE,
+				Route:   routeToRouteInfo(&u.Route, names),
+			}
+		case u, ok := <-neighCh:
+			if !ok {
+				return errSubscriptionClosed
+			}
+
+			// Skip the service entries without an address
+			if u.IP == nil {
+				continue
+			}
+
+			ev = &NetworkEvent{
+				Deleted:  u.Type == unix.RTM_DELNEIGH,
+				Neighbor: neighToNeighborInfo(&u.Neigh, names),
+			}
+		}
+
+		if err := fn(ev); err != nil {
+			return err
+		}
+	}
+}
+
+func drain[T any](ch chan T) {
+	if ch == nil {
+		return
+	}
+
+	for range ch {
+	}
+}
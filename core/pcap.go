@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/pcap"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// MaxCaptureSnapLen is also the default snapshot length, as in tcpdump
+	MaxCaptureSnapLen = 262144
+
+	// DefaultCaptureDuration is used when neither the number of packets
+	// nor the duration of the capture is specified
+	DefaultCaptureDuration = time.Minute
+
+	MaxCaptureDuration = time.Hour
+)
+
+// CapturePackets captures the packets on the link and calls fn
+// with the pcapng blocks until the limits are reached or ctx is canceled.
+func (s *Server) CapturePackets(ctx context.Context, opts *CaptureOptions, fn func([]byte) error) (*CaptureStats, error) {
+	o := *opts
+
+	if o.SnapLen == 0 {
+		o.SnapLen = MaxCaptureSnapLen
+	}
+
+	if o.MaxPackets == 0 && o.Duration == 0 {
+		o.Duration = DefaultCaptureDuration
+	}
+
+	switch {
+	case len(o.Interface) == 0:
+		return nil, fmt.Errorf("link name is not specified")
+	case o.SnapLen < 0 || o.SnapLen > MaxCaptureSnapLen:
+		return nil, fmt.Errorf("snapshot length must be in the range 1-%d", MaxCaptureSnapLen)
+	case o.MaxPackets < 0:
+		return nil, fmt.Errorf("negative number of packets")
+	case o.Duration < 0 || o.Duration > MaxCaptureDuration:
+		return nil, fmt.Errorf("capture duration must not exceed %s", MaxCaptureDuration)
+	}
+
+	log.WithField("link", o.Interface).Infof("Starting packet capture (filter = %q, max packets = %d, duration = %s)", o.Filter, o.MaxPackets, o.Duration)
+
+	stats, err := pcap.Capture(ctx, &o, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithField("link", o.Interface).Infof("Packet capture finished: captured = %d, dropped = %d", stats.Captured, stats.Dropped)
+
+	return stats, nil
+}
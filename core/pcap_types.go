@@ -0,0 +1,9 @@
+package core
+
+import (
+	"github.com/0xef53/phoenix-guest-agent/internal/pcap"
+)
+
+type CaptureOptions = pcap.CaptureOptions
+
+type CaptureStats = pcap.CaptureStats
@@ -23,6 +23,12 @@ type Server struct {
 	sshUserKey func() []byte
 
 	features *AgentFeatures
+
+	netconfMu sync.Mutex
+	netconf   *pendingNetworkConfig
+
+	fwMu sync.Mutex
+	fw   *pendingFirewallRuleset
 }
 
 func NewServer(ctx context.Context, features *AgentFeatures) (*Server, error) {
@@ -38,6 +44,11 @@ func NewServer(ctx context.Context, features *AgentFeatures) (*Server, error) {
 
 	go poller.Run(ctx, 30*time.Second)
 
+	// Start renewal of the leases obtained by the built-in DHCP client
+	keeper := DHCPLeaseKeeper{}
+
+	go keeper.Run(ctx, 10*time.Second)
+
 	// Start Secure Shell server
 	if !features.WithoutSSH && !features.LegacyMode {
 		go func() {
@@ -106,6 +117,9 @@ type AgentFeatures struct {
 	SerialPort string
 	WithoutSSH bool
 	WithoutTCP bool
+
+	// The sysctl keys (glob patterns) that can be changed via SetSysctl
+	SysctlAllowlist []string
 }
 
 func (s *Server) GetAgentInfo(ctx context.Context) *AgentInfo {
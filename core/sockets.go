@@ -0,0 +1,13 @@
+package core
+
+import (
+	"context"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/procnet"
+)
+
+// ListSockets returns the TCP, UDP and UNIX sockets of the guest
+// with the processes using them.
+func (s *Server) ListSockets(ctx context.Context, opts *SocketListOptions) ([]*Socket, error) {
+	return procnet.List(*opts)
+}
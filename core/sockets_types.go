@@ -0,0 +1,11 @@
+package core
+
+import (
+	"github.com/0xef53/phoenix-guest-agent/internal/procnet"
+)
+
+type Socket = procnet.Socket
+
+type SocketProcess = procnet.Process
+
+type SocketListOptions = procnet.Options
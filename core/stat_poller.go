@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,14 +13,23 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/0xef53/phoenix-guest-agent/internal/procstat"
 	"github.com/0xef53/phoenix-guest-agent/internal/utmp"
 
 	systemd_login1 "github.com/coreos/go-systemd/v22/login1"
+	log "github.com/sirupsen/logrus"
 )
 
 type StatPoller struct {
 	mu   sync.Mutex
 	stat *GuestInfo
+
+	// The previous samples of the counters
+	// used to calculate the rates
+	prevTime  time.Time
+	prevCPUs  map[string]*procstat.CPUTimes
+	prevDisks map[string]*procstat.DiskStats
+	prevNet   map[string]*procstat.NetDevStats
 }
 
 func (p *StatPoller) Stat() *GuestInfo {
@@ -81,6 +91,37 @@ func (p *StatPoller) Run(ctx context.Context, interval time.Duration) error {
 			return err
 		}
 
+		now := time.Now()
+
+		// So the first rates are the averages since boot
+		// like the first CPU utilization
+		if p.prevTime.IsZero() {
+			p.prevTime = now.Add(-time.Duration(st.Uptime) * time.Second)
+		}
+
+		// The following stats are optional, so they are skipped
+		// if unavailable rather than stopping the poller
+
+		if err := p.updateCPUStat(); err != nil {
+			log.Warnf("Unable to update CPU stats: %s", err)
+		}
+
+		if err := p.updateDiskStat(now); err != nil {
+			log.Warnf("Unable to update disk stats: %s", err)
+		}
+
+		if err := p.updateNetDevStat(now); err != nil {
+			log.Warnf("Unable to update network device stats: %s", err)
+		}
+
+		if pp, err := p.getPressureStat(); err == nil {
+			p.stat.Pressure = pp
+		} else {
+			log.Warnf("Unable to update pressure stall information: %s", err)
+		}
+
+		p.prevTime = now
+
 		return nil
 	}
 
@@ -112,7 +153,7 @@ func (p *StatPoller) updateMemStatFields(st *GuestInfo_MemStat) error {
 	scanner := bufio.NewScanner(fd)
 
 	// number of fields we're interested in
-	n := 4
+	n := 11
 
 	for scanner.Scan() && n > 0 {
 		switch {
@@ -124,6 +165,20 @@ func (p *StatPoller) updateMemStatFields(st *GuestInfo_MemStat) error {
 			_, err = fmt.Sscanf(scanner.Text(), "Buffers:%d", &st.Buffers)
 		case bytes.HasPrefix(scanner.Bytes(), []byte(`Cached:`)):
 			_, err = fmt.Sscanf(scanner.Text(), "Cached:%d", &st.Cached)
+		case bytes.HasPrefix(scanner.Bytes(), []byte(`MemAvailable:`)):
+			_, err = fmt.Sscanf(scanner.Text(), "MemAvailable:%d", &st.Available)
+		case bytes.HasPrefix(scanner.Bytes(), []byte(`Dirty:`)):
+			_, err = fmt.Sscanf(scanner.Text(), "Dirty:%d", &st.Dirty)
+		case bytes.HasPrefix(scanner.Bytes(), []byte(`Writeback:`)):
+			_, err = fmt.Sscanf(scanner.Text(), "Writeback:%d", &st.Writeback)
+		case bytes.HasPrefix(scanner.Bytes(), []byte(`Slab:`)):
+			_, err = fmt.Sscanf(scanner.Text(), "Slab:%d", &st.Slab)
+		case bytes.HasPrefix(scanner.Bytes(), []byte(`HugePages_Total:`)):
+			_, err = fmt.Sscanf(scanner.Text(), "HugePages_Total:%d", &st.HugePagesTotal)
+		case bytes.HasPrefix(scanner.Bytes(), []byte(`HugePages_Free:`)):
+			_, err = fmt.Sscanf(scanner.Text(), "HugePages_Free:%d", &st.HugePagesFree)
+		case bytes.HasPrefix(scanner.Bytes(), []byte(`Hugepagesize:`)):
+			_, err = fmt.Sscanf(scanner.Text(), "Hugepagesize:%d", &st.HugePageSize)
 		default:
 			continue
 		}
@@ -143,6 +198,211 @@ func (p *StatPoller) updateMemStatFields(st *GuestInfo_MemStat) error {
 	return nil
 }
 
+// updateCPUStat calculates the CPU utilization since the previous update.
+// The first time it is the average utilization since boot.
+func (p *StatPoller) updateCPUStat() error {
+	cpus, err := procstat.ReadCPUTimes()
+	if err != nil {
+		return err
+	}
+
+	prevCPUs := make(map[string]*procstat.CPUTimes, len(cpus))
+
+	p.stat.CPUs = make([]*GuestInfo_CPUStat, 0, len(cpus))
+
+	for _, cur := range cpus {
+		prev, ok := p.prevCPUs[cur.Name]
+		if !ok {
+			prev = new(procstat.CPUTimes)
+		}
+
+		prevCPUs[cur.Name] = cur
+
+		total := float64(cur.Total()) - float64(prev.Total())
+
+		pct := func(cur, prev uint64) float64 {
+			if total <= 0 || cur < prev {
+				return 0
+			}
+
+			return float64(cur-prev) * 100 / total
+		}
+
+		st := GuestInfo_CPUStat{
+			Name:    cur.Name,
+			User:    pct(cur.User, prev.User),
+			Nice:    pct(cur.Nice, prev.Nice),
+			System:  pct(cur.System, prev.System),
+			Idle:    pct(cur.Idle, prev.Idle),
+			Iowait:  pct(cur.Iowait, prev.Iowait),
+			Irq:     pct(cur.Irq, prev.Irq),
+			Softirq: pct(cur.Softirq, prev.Softirq),
+			Steal:   pct(cur.Steal, prev.Steal),
+		}
+
+		if cur.Name == "cpu" {
+			p.stat.CPU = &st
+		} else {
+			p.stat.CPUs = append(p.stat.CPUs, &st)
+		}
+	}
+
+	p.prevCPUs = prevCPUs
+
+	return nil
+}
+
+// rate returns the change of the counter per second since the previous update.
+// The rate is zero when the counter has been reset.
+func (p *StatPoller) rate(cur, prev uint64, now time.Time) float64 {
+	elapsed := now.Sub(p.prevTime).Seconds()
+
+	if elapsed <= 0 || cur < prev {
+		return 0
+	}
+
+	return float64(cur-prev) / elapsed
+}
+
+// updateDiskStat calculates the I/O rates of the whole disks (not partitions).
+// The disks that have never been used, e.g. unused loop devices, are skipped.
+func (p *StatPoller) updateDiskStat(now time.Time) error {
+	disks, err := procstat.ReadDiskStats()
+	if err != nil {
+		// There is no previous sample for the next update
+		p.prevDisks = make(map[string]*procstat.DiskStats)
+
+		return err
+	}
+
+	// The sector is always 512 bytes in /proc/diskstats
+	const sectorSize = 512
+
+	prevDisks := make(map[string]*procstat.DiskStats, len(disks))
+
+	p.stat.Disks = make([]*GuestInfo_DiskStat, 0, len(disks))
+
+	for _, cur := range disks {
+		if cur.ReadsCompleted+cur.WritesCompleted == 0 {
+			continue
+		}
+
+		if _, err := os.Stat(filepath.Join("/sys/block", strings.ReplaceAll(cur.Name, "/", "!"))); err != nil {
+			continue
+		}
+
+		prev, ok := p.prevDisks[cur.Name]
+		switch {
+		case !ok && p.prevDisks == nil:
+			// The first update, the average since boot
+			prev = new(procstat.DiskStats)
+		case !ok:
+			// The disk has just appeared
+			prev = cur
+		}
+
+		prevDisks[cur.Name] = cur
+
+		p.stat.Disks = append(p.stat.Disks, &GuestInfo_DiskStat{
+			Name:             cur.Name,
+			ReadsPerSec:      p.rate(cur.ReadsCompleted, prev.ReadsCompleted, now),
+			WritesPerSec:     p.rate(cur.WritesCompleted, prev.WritesCompleted, now),
+			ReadBytesPerSec:  p.rate(cur.SectorsRead, prev.SectorsRead, now) * sectorSize,
+			WriteBytesPerSec: p.rate(cur.SectorsWritten, prev.SectorsWritten, now) * sectorSize,
+			Utilization:      min(p.rate(cur.IOTicks, prev.IOTicks, now)/10, 100), // ms per second -> percent
+			InFlight:         cur.InFlight,
+			Reads:            cur.ReadsCompleted,
+			Writes:           cur.WritesCompleted,
+			ReadBytes:        cur.SectorsRead * sectorSize,
+			WriteBytes:       cur.SectorsWritten * sectorSize,
+		})
+	}
+
+	p.prevDisks = prevDisks
+
+	return nil
+}
+
+func (p *StatPoller) updateNetDevStat(now time.Time) error {
+	devs, err := procstat.ReadNetDevStats()
+	if err != nil {
+		// There is no previous sample for the next update
+		p.prevNet = make(map[string]*procstat.NetDevStats)
+
+		return err
+	}
+
+	prevNet := make(map[string]*procstat.NetDevStats, len(devs))
+
+	p.stat.NetDevs = make([]*GuestInfo_NetDevStat, 0, len(devs))
+
+	for _, cur := range devs {
+		prev, ok := p.prevNet[cur.Name]
+		switch {
+		case !ok && p.prevNet == nil:
+			// The first update, the average since boot
+			prev = new(procstat.NetDevStats)
+		case !ok:
+			// The device has just appeared
+			prev = cur
+		}
+
+		prevNet[cur.Name] = cur
+
+		p.stat.NetDevs = append(p.stat.NetDevs, &GuestInfo_NetDevStat{
+			Name:            cur.Name,
+			RxBytesPerSec:   p.rate(cur.RxBytes, prev.RxBytes, now),
+			TxBytesPerSec:   p.rate(cur.TxBytes, prev.TxBytes, now),
+			RxPacketsPerSec: p.rate(cur.RxPackets, prev.RxPackets, now),
+			TxPacketsPerSec: p.rate(cur.TxPackets, prev.TxPackets, now),
+			RxBytes:         cur.RxBytes,
+			TxBytes:         cur.TxBytes,
+			RxPackets:       cur.RxPackets,
+			TxPackets:       cur.TxPackets,
+			RxErrors:        cur.RxErrors,
+			TxErrors:        cur.TxErrors,
+			RxDropped:       cur.RxDropped,
+			TxDropped:       cur.TxDropped,
+		})
+	}
+
+	p.prevNet = prevNet
+
+	return nil
+}
+
+// getPressureStat returns nil if the pressure stall information
+// is not available in the guest kernel.
+func (p *StatPoller) getPressureStat() ([]*GuestInfo_PressureStat, error) {
+	var stats []*GuestInfo_PressureStat
+
+	for _, res := range []string{"cpu", "memory", "io"} {
+		pr, err := procstat.ReadPressure(res)
+		if err != nil {
+			if os.IsNotExist(err) || errors.Is(err, syscall.EOPNOTSUPP) {
+				// The kernel is built without CONFIG_PSI or booted with psi=0
+				continue
+			}
+
+			return nil, err
+		}
+
+		stats = append(stats, &GuestInfo_PressureStat{
+			Resource:   res,
+			SomeAvg10:  pr.Some.Avg10,
+			SomeAvg60:  pr.Some.Avg60,
+			SomeAvg300: pr.Some.Avg300,
+			SomeTotal:  pr.Some.Total,
+			FullAvg10:  pr.Full.Avg10,
+			FullAvg60:  pr.Full.Avg60,
+			FullAvg300: pr.Full.Avg300,
+			FullTotal:  pr.Full.Total,
+		})
+	}
+
+	return stats, nil
+}
+
 func (p *StatPoller) updateUtsnameFields(st *GuestInfo_Utsname) error {
 	tmp := syscall.Utsname{}
 	if err := syscall.Uname(&tmp); err != nil {
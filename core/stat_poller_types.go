@@ -8,6 +8,11 @@ type GuestInfo struct {
 	Swap      *GuestInfo_SwapStat
 	Users     []*GuestInfo_LoggedUser
 	Blockdevs []*GuestInfo_BlockDevice
+	CPU       *GuestInfo_CPUStat
+	CPUs      []*GuestInfo_CPUStat
+	Disks     []*GuestInfo_DiskStat
+	NetDevs   []*GuestInfo_NetDevStat
+	Pressure  []*GuestInfo_PressureStat
 }
 
 type GuestInfo_Utsname struct {
@@ -31,6 +36,14 @@ type GuestInfo_MemStat struct {
 	Buffers   uint64
 	Cached    uint64
 	FreeTotal uint64
+
+	Available      uint64
+	Dirty          uint64
+	Writeback      uint64
+	Slab           uint64
+	HugePagesTotal uint64 // pages
+	HugePagesFree  uint64 // pages
+	HugePageSize   uint64
 }
 
 type GuestInfo_SwapStat struct {
@@ -56,3 +69,65 @@ type GuestInfo_BlockDevice struct {
 	InodesUsed  uint64
 	InodesAvail uint64
 }
+
+// GuestInfo_CPUStat contains the CPU utilization in percent
+// over the last polling interval.
+type GuestInfo_CPUStat struct {
+	Name    string
+	User    float64
+	Nice    float64
+	System  float64
+	Idle    float64
+	Iowait  float64
+	Irq     float64
+	Softirq float64
+	Steal   float64
+}
+
+// GuestInfo_DiskStat contains the I/O rates of a disk over the last
+// polling interval together with the cumulative counters.
+type GuestInfo_DiskStat struct {
+	Name             string
+	ReadsPerSec      float64
+	WritesPerSec     float64
+	ReadBytesPerSec  float64
+	WriteBytesPerSec float64
+	Utilization      float64 // percent of time spent doing I/O
+	InFlight         uint64
+	Reads            uint64
+	Writes           uint64
+	ReadBytes        uint64
+	WriteBytes       uint64
+}
+
+// GuestInfo_NetDevStat contains the throughput of a network interface
+// over the last polling interval together with the cumulative counters.
+type GuestInfo_NetDevStat struct {
+	Name            string
+	RxBytesPerSec   float64
+	TxBytesPerSec   float64
+	RxPacketsPerSec float64
+	TxPacketsPerSec float64
+	RxBytes         uint64
+	TxBytes         uint64
+	RxPackets       uint64
+	TxPackets       uint64
+	RxErrors        uint64
+	TxErrors        uint64
+	RxDropped       uint64
+	TxDropped       uint64
+}
+
+// GuestInfo_PressureStat is the pressure stall information
+// of a resource: cpu, memory or io.
+type GuestInfo_PressureStat struct {
+	Resource   string
+	SomeAvg10  float64
+	SomeAvg60  float64
+	SomeAvg300 float64
+	SomeTotal  uint64 // microseconds
+	FullAvg10  float64
+	FullAvg60  float64
+	FullAvg300 float64
+	FullTotal  uint64 // microseconds
+}
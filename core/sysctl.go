@@ -0,0 +1,26 @@
+package core
+
+import (
+	"context"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/sysctl"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultSysctlAllowlist is used unless the allowlist is specified explicitly.
+var DefaultSysctlAllowlist = sysctl.DefaultAllowlist
+
+func (s *Server) GetSysctl(ctx context.Context, patterns []string) ([]*SysctlParam, error) {
+	return sysctl.Get(patterns...)
+}
+
+// SetSysctl changes the kernel parameters. Only the keys matching
+// the allowlist from the agent features can be changed.
+func (s *Server) SetSysctl(ctx context.Context, params []*SysctlParam, persist bool) error {
+	for _, p := range params {
+		log.WithField("key", p.Key).Infof("Setting kernel parameter to %q (persist = %t)", p.Value, persist)
+	}
+
+	return sysctl.Set(s.features.SysctlAllowlist, params, persist)
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+var ErrQdiscNotSupported = errors.New("qdisc is not supported by the kernel")
+
+// ListQdiscs returns the queueing disciplines of the link (as tc qdisc show).
+func (s *Server) ListQdiscs(ctx context.Context, ifname string) ([]*QdiscInfo, error) {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil && !errors.Is(err, netlink.ErrDumpInterrupted) {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	infos := make([]*QdiscInfo, 0, len(qdiscs))
+
+	for _, q := range qdiscs {
+		infos = append(infos, qdiscToInfo(q))
+	}
+
+	return infos, nil
+}
+
+// ListTrafficClasses returns the classes of all qdiscs of the link (as tc class show).
+func (s *Server) ListTrafficClasses(ctx context.Context, ifname string) ([]*TrafficClassInfo, error) {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	classes, err := netlink.ClassList(link, netlink.HANDLE_NONE)
+	if err != nil && !errors.Is(err, netlink.ErrDumpInterrupted) {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	infos := make([]*TrafficClassInfo, 0, len(classes))
+
+	for _, c := range classes {
+		infos = append(infos, trafficClassToInfo(c))
+	}
+
+	return infos, nil
+}
+
+// ListTrafficFilters returns the filters attached to the qdiscs
+// and classes of the link (as tc filter show).
+func (s *Server) ListTrafficFilters(ctx context.Context, ifname string) ([]*TrafficFilterInfo, error) {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil && !errors.Is(err, netlink.ErrDumpInterrupted) {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	classes, err := netlink.ClassList(link, netlink.HANDLE_NONE)
+	if err != nil && !errors.Is(err, netlink.ErrDumpInterrupted) {
+		return nil, os.NewSyscallError("rtnetlink", err)
+	}
+
+	// The kernel dumps the filters of a single block at once
+	var parents []uint32
+
+	for _, q := range qdiscs {
+		switch q.Type() {
+		case "clsact":
+			parents = append(parents, netlink.HANDLE_MIN_INGRESS, netlink.HANDLE_MIN_EGRESS)
+		default:
+			if q.Attrs().Handle != netlink.HANDLE_NONE {
+				parents = append(parents, q.Attrs().Handle)
+			}
+		}
+	}
+
+	for _, c := range classes {
+		parents = append(parents, c.Attrs().Handle)
+	}
+
+	var infos []*TrafficFilterInfo
+
+	for _, parent := range parents {
+		filters, err := netlink.FilterList(link, parent)
+		if err != nil && !errors.Is(err, netlink.ErrDumpInterrupted) {
+			return nil, os.NewSyscallError("rtnetlink", err)
+		}
+
+		for _, f := range filters {
+			infos = append(infos, trafficFilterToInfo(f))
+		}
+	}
+
+	return infos, nil
+}
+
+// SetRootQdisc replaces the root qdisc of the link with a simple shaping
+// profile: tbf or htb rate limit, fq_codel or netem.
+func (s *Server) SetRootQdisc(ctx context.Context, ifname string, attrs *QdiscAttrs) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	qdisc, class, err := newNetlinkQdisc(link.Attrs().Index, attrs)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("ifname", ifname).Infof("Setting root qdisc %s", qdisc.Type())
+
+	// The qdisc of another kind cannot be changed in place
+	if err := DeleteRootQdisc(link); err != nil {
+		return err
+	}
+
+	if err := netlink.QdiscAdd(qdisc); err != nil {
+		// The module of this qdisc kind is not available
+		if errors.Is(err, unix.ENOENT) {
+			return fmt.Errorf("%w: %s", ErrQdiscNotSupported, qdisc.Type())
+		}
+
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if class != nil {
+		if err := netlink.ClassAdd(class); err != nil {
+			return os.NewSyscallError("rtnetlink", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteRootQdisc restores the default root qdisc of the link.
+func (s *Server) DeleteRootQdisc(ctx context.Context, ifname string) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	log.WithField("ifname", ifname).Info("Removing root qdisc")
+
+	return DeleteRootQdisc(link)
+}
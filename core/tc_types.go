@@ -0,0 +1,94 @@
+package core
+
+import (
+	"time"
+)
+
+// QdiscAttrs describes the root qdisc (shaping profile) to be set on a link.
+// Exactly one of the kind-specific options must be specified.
+type QdiscAttrs struct {
+	Tbf     *TbfOptions
+	Htb     *HtbOptions
+	FqCodel *FqCodelOptions
+	Netem   *NetemOptions
+}
+
+type QdiscInfo struct {
+	Kind   string
+	Handle string
+	Parent string
+	Stats  *TrafficControlStats
+
+	// Only for tbf, fq_codel and netem
+	Tbf     *TbfOptions
+	FqCodel *FqCodelOptions
+	Netem   *NetemOptions
+}
+
+type TrafficClassInfo struct {
+	Kind   string
+	Handle string
+	Parent string
+	Stats  *TrafficControlStats
+
+	// Only for htb
+	Rate uint64 // bits per second
+	Ceil uint64 // bits per second
+}
+
+type TrafficFilterInfo struct {
+	Kind     string
+	Handle   string
+	Parent   string
+	Priority int
+	Protocol string
+	ClassID  string
+}
+
+type TrafficControlStats struct {
+	Bytes      uint64
+	Packets    uint32
+	Drops      uint32
+	Overlimits uint32
+	Requeues   uint32
+	Backlog    uint32
+	Qlen       uint32
+}
+
+// TbfOptions describes a token bucket filter. Either the Limit
+// or the Latency is used to compute the queue size.
+type TbfOptions struct {
+	Rate    uint64 // bits per second
+	Burst   uint32 // bytes
+	Limit   uint32 // bytes
+	Latency time.Duration
+}
+
+// HtbOptions describes a single htb class (1:1) with the rate limit
+// that is used for all the traffic.
+type HtbOptions struct {
+	Rate uint64 // bits per second
+	Ceil uint64 // bits per second, the same as Rate if not specified
+}
+
+// FqCodelOptions describes a fq_codel qdisc. The zero values mean
+// the kernel defaults.
+type FqCodelOptions struct {
+	Limit    uint32 // packets
+	Flows    uint32
+	Interval time.Duration
+	NoECN    bool
+}
+
+// NetemOptions describes a netem qdisc that emulates the properties
+// of a bad network. The probabilities are in percent.
+type NetemOptions struct {
+	Delay     time.Duration
+	Jitter    time.Duration
+	Loss      float32
+	Duplicate float32
+	Corrupt   float32
+	Reorder   float32
+	Limit     uint32 // packets
+	Rate      uint64 // bits per second
+}
@@ -0,0 +1,289 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// The handles of the root qdisc and the htb class created by SetRootQdisc
+var (
+	rootQdiscHandle = netlink.MakeHandle(1, 0)
+	htbClassHandle  = netlink.MakeHandle(1, 1)
+)
+
+// newNetlinkQdisc returns the root qdisc and, for htb, its only class.
+func newNetlinkQdisc(linkIndex int, attrs *QdiscAttrs) (netlink.Qdisc, netlink.Class, error) {
+	var n int
+
+	for _, ok := range []bool{attrs.Tbf != nil, attrs.Htb != nil, attrs.FqCodel != nil, attrs.Netem != nil} {
+		if ok {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return nil, nil, fmt.Errorf("exactly one qdisc kind must be specified")
+	}
+
+	qa := netlink.QdiscAttrs{
+		LinkIndex: linkIndex,
+		Handle:    rootQdiscHandle,
+		Parent:    netlink.HANDLE_ROOT,
+	}
+
+	switch {
+	case attrs.Tbf != nil:
+		o := attrs.Tbf
+
+		if o.Rate == 0 || o.Burst == 0 {
+			return nil, nil, fmt.Errorf("tbf: rate and burst must be specified")
+		}
+
+		rate := o.Rate / 8
+		limit := o.Limit
+
+		if limit == 0 {
+			if o.Latency <= 0 {
+				return nil, nil, fmt.Errorf("tbf: either limit or latency must be specified")
+			}
+
+			limit = uint32(float64(rate)*o.Latency.Seconds()) + o.Burst
+		}
+
+		return &netlink.Tbf{QdiscAttrs: qa, Rate: rate, Limit: limit, Buffer: netlink.Xmittime(rate, o.Burst)}, nil, nil
+	case attrs.Htb != nil:
+		o := attrs.Htb
+
+		if o.Rate == 0 {
+			return nil, nil, fmt.Errorf("htb: rate must be specified")
+		}
+
+		if o.Ceil != 0 && o.Ceil < o.Rate {
+			return nil, nil, fmt.Errorf("htb: ceil must not be less than rate")
+		}
+
+		qdisc := netlink.NewHtb(qa)
+
+		// All the unclassified traffic goes to 1:1
+		_, minor := netlink.MajorMinor(htbClassHandle)
+
+		qdisc.Defcls = uint32(minor)
+
+		class := netlink.NewHtbClass(
+			netlink.ClassAttrs{LinkIndex: linkIndex, Parent: rootQdiscHandle, Handle: htbClassHandle},
+			netlink.HtbClassAttrs{Rate: o.Rate, Ceil: o.Ceil},
+		)
+
+		return qdisc, class, nil
+	case attrs.FqCodel != nil:
+		o := attrs.FqCodel
+
+		qdisc := netlink.NewFqCodel(qa)
+
+		qdisc.Limit = o.Limit
+		qdisc.Flows = o.Flows
+		qdisc.Interval = uint32(o.Interval.Microseconds())
+
+		if o.NoECN {
+			qdisc.ECN = 0
+		}
+
+		return qdisc, nil, nil
+	}
+
+	o := attrs.Netem
+
+	for _, p := range []float32{o.Loss, o.Duplicate, o.Corrupt, o.Reorder} {
+		if p < 0 || p > 100 {
+			return nil, nil, fmt.Errorf("netem: invalid probability: %g%%", p)
+		}
+	}
+
+	if o.Delay < 0 || o.Jitter < 0 || o.Delay > math.MaxUint32*time.Microsecond || o.Jitter > o.Delay {
+		return nil, nil, fmt.Errorf("netem: invalid delay or jitter: %s, %s", o.Delay, o.Jitter)
+	}
+
+	if o.Reorder > 0 && o.Delay == 0 {
+		return nil, nil, fmt.Errorf("netem: reordering requires delay")
+	}
+
+	qdisc := netlink.NewNetem(qa, netlink.NetemQdiscAttrs{
+		Latency:     uint32(o.Delay.Microseconds()),
+		Jitter:      uint32(o.Jitter.Microseconds()),
+		Loss:        o.Loss,
+		Duplicate:   o.Duplicate,
+		CorruptProb: o.Corrupt,
+		ReorderProb: o.Reorder,
+		Limit:       o.Limit,
+		Rate64:      o.Rate / 8,
+	})
+
+	return qdisc, nil, nil
+}
+
+// DeleteRootQdisc removes the root qdisc of the link unless it is
+// the default one, and the kernel restores the default.
+func DeleteRootQdisc(link netlink.Link) error {
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil && !errors.Is(err, netlink.ErrDumpInterrupted) {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	for _, q := range qdiscs {
+		// The default qdiscs have no handle
+		if q.Attrs().Parent == netlink.HANDLE_ROOT && q.Attrs().Handle != netlink.HANDLE_NONE {
+			if err := netlink.QdiscDel(q); err != nil {
+				return os.NewSyscallError("rtnetlink", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func qdiscToInfo(q netlink.Qdisc) *QdiscInfo {
+	a := q.Attrs()
+
+	info := QdiscInfo{
+		Kind:   q.Type(),
+		Handle: netlink.HandleStr(a.Handle),
+		Parent: netlink.HandleStr(a.Parent),
+		Stats:  tcStatsFromNetlink((*netlink.ClassStatistics)(a.Statistics)),
+	}
+
+	switch x := q.(type) {
+	case *netlink.Tbf:
+		info.Tbf = &TbfOptions{
+			Rate:  x.Rate * 8,
+			Burst: netlink.Xmitsize(x.Rate, x.Buffer),
+			Limit: x.Limit,
+		}
+	case *netlink.FqCodel:
+		info.FqCodel = &FqCodelOptions{
+			Limit:    x.Limit,
+			Flows:    x.Flows,
+			Interval: time.Duration(x.Interval) * time.Microsecond,
+			NoECN:    x.ECN == 0,
+		}
+	case *netlink.Netem:
+		info.Netem = &NetemOptions{
+			Delay:     ticksToDuration(x.Latency),
+			Jitter:    ticksToDuration(x.Jitter),
+			Loss:      u32ToPercentage(x.Loss),
+			Duplicate: u32ToPercentage(x.Duplicate),
+			Corrupt:   u32ToPercentage(x.CorruptProb),
+			Reorder:   u32ToPercentage(x.ReorderProb),
+			Limit:     x.Limit,
+			Rate:      x.Rate64 * 8,
+		}
+	}
+
+	return &info
+}
+
+func trafficClassToInfo(c netlink.Class) *TrafficClassInfo {
+	a := c.Attrs()
+
+	info := TrafficClassInfo{
+		Kind:   c.Type(),
+		Handle: netlink.HandleStr(a.Handle),
+		Parent: netlink.HandleStr(a.Parent),
+		Stats:  tcStatsFromNetlink(a.Statistics),
+	}
+
+	if x, ok := c.(*netlink.HtbClass); ok {
+		info.Rate = x.Rate * 8
+		info.Ceil = x.Ceil * 8
+	}
+
+	return &info
+}
+
+func trafficFilterToInfo(f netlink.Filter) *TrafficFilterInfo {
+	a := f.Attrs()
+
+	info := TrafficFilterInfo{
+		Kind:     f.Type(),
+		Handle:   fmt.Sprintf("%#x", a.Handle),
+		Parent:   netlink.HandleStr(a.Parent),
+		Priority: int(a.Priority),
+		Protocol: ethProtocolName(a.Protocol),
+	}
+
+	var classID uint32
+
+	switch x := f.(type) {
+	case *netlink.U32:
+		classID = x.ClassId
+	case *netlink.FwFilter:
+		classID = x.ClassId
+	case *netlink.BpfFilter:
+		classID = x.ClassId
+	case *netlink.MatchAll:
+		classID = x.ClassId
+	case *netlink.Flower:
+		classID = x.ClassId
+	}
+
+	if classID != 0 {
+		info.ClassID = netlink.HandleStr(classID)
+	}
+
+	return &info
+}
+
+func tcStatsFromNetlink(s *netlink.ClassStatistics) *TrafficControlStats {
+	if s == nil {
+		return nil
+	}
+
+	var stats TrafficControlStats
+
+	if s.Basic != nil {
+		stats.Bytes = s.Basic.Bytes
+		stats.Packets = s.Basic.Packets
+	}
+
+	if s.Queue != nil {
+		stats.Drops = s.Queue.Drops
+		stats.Overlimits = s.Queue.Overlimits
+		stats.Requeues = s.Queue.Requeues
+		stats.Backlog = s.Queue.Backlog
+		stats.Qlen = s.Queue.Qlen
+	}
+
+	return &stats
+}
+
+func ticksToDuration(ticks uint32) time.Duration {
+	return time.Duration(float64(ticks)/netlink.TickInUsec()) * time.Microsecond
+}
+
+func u32ToPercentage(v uint32) float32 {
+	return float32(float64(v) / math.MaxUint32 * 100)
+}
+
+func ethProtocolName(proto uint16) string {
+	switch proto {
+	case unix.ETH_P_ALL:
+		return "all"
+	case unix.ETH_P_IP:
+		return "ip"
+	case unix.ETH_P_IPV6:
+		return "ipv6"
+	case unix.ETH_P_ARP:
+		return "arp"
+	case unix.ETH_P_8021Q:
+		return "802.1q"
+	case unix.ETH_P_8021AD:
+		return "802.1ad"
+	}
+
+	return fmt.Sprintf("%#04x", proto)
+}
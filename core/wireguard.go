@@ -0,0 +1,36 @@
+package core
+
+import (
+	"context"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/wireguard"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// GetWireguardDevice returns the configuration of the WireGuard link
+// with the handshake and transfer stats of its peers.
+func (s *Server) GetWireguardDevice(ctx context.Context, ifname string) (*WireguardDevice, error) {
+	if err := checkWireguardLink(ifname); err != nil {
+		return nil, err
+	}
+
+	return wireguard.GetDevice(ifname)
+}
+
+// ConfigureWireguard changes the keys, the listen port and the peers
+// of the WireGuard link. The link can be created using CreateLink.
+func (s *Server) ConfigureWireguard(ctx context.Context, ifname string, cfg *WireguardConfig) error {
+	if err := checkWireguardLink(ifname); err != nil {
+		return err
+	}
+
+	wgcfg, err := cfg.toInternal()
+	if err != nil {
+		return err
+	}
+
+	log.WithField("ifname", ifname).Infof("Configuring WireGuard link: %d peer(s), replace peers = %t", len(wgcfg.Peers), wgcfg.ReplacePeers)
+
+	return wireguard.ConfigureDevice(ifname, wgcfg)
+}
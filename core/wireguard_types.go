@@ -0,0 +1,31 @@
+package core
+
+import (
+	"github.com/0xef53/phoenix-guest-agent/internal/wireguard"
+)
+
+type WireguardDevice = wireguard.Device
+
+type WireguardPeer = wireguard.Peer
+
+// WireguardConfig describes the changes of a WireGuard link.
+// The zero values leave the corresponding settings unchanged.
+// Replacing a large set of peers is not atomic, see wireguard.ConfigureDevice.
+type WireguardConfig struct {
+	PrivateKey   string // base64
+	ListenPort   int
+	FirewallMark int
+	ReplacePeers bool
+	Peers        []*WireguardPeerConfig
+}
+
+type WireguardPeerConfig struct {
+	PublicKey           string // base64
+	Remove              bool
+	UpdateOnly          bool
+	PresharedKey        string // base64
+	Endpoint            string // host:port
+	PersistentKeepalive int    // seconds
+	ReplaceAllowedIPs   bool
+	AllowedIPs          []string
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/internal/wireguard"
+
+	"github.com/vishvananda/netlink"
+)
+
+func checkWireguardLink(ifname string) error {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return os.NewSyscallError("rtnetlink", err)
+	}
+
+	if link.Type() != "wireguard" {
+		return fmt.Errorf("%w: %s is of type %q", ErrUnsupportedLinkType, ifname, link.Type())
+	}
+
+	return nil
+}
+
+func (c *WireguardConfig) toInternal() (*wireguard.Config, error) {
+	wgcfg := wireguard.Config{
+		ListenPort:   c.ListenPort,
+		FirewallMark: c.FirewallMark,
+		ReplacePeers: c.ReplacePeers,
+	}
+
+	if len(c.PrivateKey) > 0 {
+		k, err := wireguard.ParseKey(c.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		wgcfg.PrivateKey = k
+	}
+
+	for _, p := range c.Peers {
+		peer, err := p.toInternal()
+		if err != nil {
+			return nil, err
+		}
+
+		wgcfg.Peers = append(wgcfg.Peers, peer)
+	}
+
+	return &wgcfg, nil
+}
+
+func (c *WireguardPeerConfig) toInternal() (*wireguard.PeerConfig, error) {
+	var err error
+
+	peer := wireguard.PeerConfig{
+		Remove:              c.Remove,
+		UpdateOnly:          c.UpdateOnly,
+		PersistentKeepalive: time.Duration(c.PersistentKeepalive) * time.Second,
+		ReplaceAllowedIPs:   c.ReplaceAllowedIPs,
+	}
+
+	if peer.PublicKey, err = wireguard.ParseKey(c.PublicKey); err != nil {
+		return nil, err
+	}
+
+	if len(c.PresharedKey) > 0 {
+		if peer.PresharedKey, err = wireguard.ParseKey(c.PresharedKey); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.Endpoint) > 0 {
+		if peer.Endpoint, err = net.ResolveUDPAddr("udp", c.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint of peer %s: %w", c.PublicKey, err)
+		}
+	}
+
+	for _, s := range c.AllowedIPs {
+		_, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed IP of peer %s: %w", c.PublicKey, err)
+		}
+
+		peer.AllowedIPs = append(peer.AllowedIPs, *ipnet)
+	}
+
+	return &peer, nil
+}
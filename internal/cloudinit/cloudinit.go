@@ -2,69 +2,46 @@ package cloudinit
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"syscall"
 
 	"github.com/digitalocean/go-smbios/smbios"
-	"gopkg.in/yaml.v2"
 )
 
-type Data struct {
-	Network *NetworkConfig
-}
+// Datasource types
+const (
+	NoCloud     = "NoCloud"
+	ConfigDrive = "ConfigDrive"
+)
 
-type NetworkConfig struct {
-	Version   int                       `json:"version" yaml:"version"`
-	Ethernets map[string]EthernetConfig `json:"ethernets" yaml:"ethernets"`
+type Data struct {
+	Source   string
+	Network  *NetworkConfig
+	Meta     *MetaData
+	UserData []byte // raw, see ParseCloudConfig()
 }
 
-type EthernetConfig struct {
-	Match struct {
-		MacAddress string `json:"mac_address" yaml:"mac_address"`
-	} `json:"match" yaml:"match"`
-	Addresses []string `json:"addresses" yaml:"addresses"`
-	Gateway4  string   `json:"gateway4" yaml:"gateway4"`
-	Gateway6  string   `json:"gateway6" yaml:"gateway6"`
-	Routes    []struct {
-		To  string `json:"to" yaml:"to"`
-		Via string `json:"via" yaml:"via"`
-	} `json:"routes" yaml:"routes"`
+type datasource struct {
+	Type   string
+	Device string
+	FSType string
 }
 
+// ReadData finds the volume of the NoCloud (labelled CIDATA) or
+// the OpenStack ConfigDrive (labelled config-2) datasource and
+// reads the network configuration, meta-data and user-data from it.
 func ReadData() (*Data, error) {
-	dir, err := ioutil.ReadDir("/sys/block")
+	ds, err := findDatasource()
 	if err != nil {
 		return nil, err
 	}
 
-	var devname string
-
-	for _, file := range dir {
-		if _, err := os.Stat(filepath.Join("/sys/class/block", file.Name(), "dev")); err != nil {
-			continue
-		}
-		fslabel, err := GetDeviceAttr(filepath.Join("/dev", file.Name()), "LABEL")
-		if err != nil {
-			return nil, err
-		}
-		if strings.ToUpper(fslabel) == "CIDATA" {
-			devname = filepath.Join("/dev", file.Name())
-			break
-		}
-	}
-
-	if len(devname) == 0 {
-		return nil, fmt.Errorf("CIDATA device not found")
-	}
-
 	if err := os.MkdirAll("/run/phoenix-ga", 0750); err != nil {
 		return nil, err
 	}
-	tmpdir, err := ioutil.TempDir("/run/phoenix-ga", "netinit_*")
+	tmpdir, err := os.MkdirTemp("/run/phoenix-ga", "netinit_*")
 	if err != nil {
 		return nil, err
 	}
@@ -72,50 +49,110 @@ func ReadData() (*Data, error) {
 
 	var flags uintptr = syscall.MS_NOATIME | syscall.MS_SILENT | syscall.MS_NODEV | syscall.MS_NOEXEC | syscall.MS_NOSUID | syscall.MS_RDONLY
 
-	if err := syscall.Mount(devname, tmpdir, "iso9660", flags, ""); err != nil {
+	if err := syscall.Mount(ds.Device, tmpdir, ds.FSType, flags, ""); err != nil {
 		return nil, fmt.Errorf("mount error: %s", err)
 	}
 	defer syscall.Unmount(tmpdir, 0)
 
-	b, err := ioutil.ReadFile(filepath.Join(tmpdir, "network-config"))
-	if err != nil {
-		return nil, err
+	data := Data{
+		Source: ds.Type,
 	}
 
-	nnn := NetworkConfig{}
+	// All the files are optional: it's up to the caller
+	// to decide which of them are required.
+	readFile := func(name string) ([]byte, error) {
+		b, err := os.ReadFile(filepath.Join(tmpdir, name))
+		if err != nil && os.IsNotExist(err) {
+			return nil, nil
+		}
 
-	if err := yaml.Unmarshal(b, &nnn); err != nil {
-		return nil, err
+		return b, err
 	}
 
-	return &Data{Network: &nnn}, nil
-}
+	var netconf, metadata []byte
 
-func GetDeviceAttr(device, attr string) (string, error) {
-	// Check device availability
-	if fd, err := os.Open(device); err == nil {
-		fd.Close()
-	} else {
-		return "", err
+	switch ds.Type {
+	case NoCloud:
+		if netconf, err = readFile("network-config"); err != nil {
+			return nil, err
+		}
+		if metadata, err = readFile("meta-data"); err != nil {
+			return nil, err
+		}
+		if data.UserData, err = readFile("user-data"); err != nil {
+			return nil, err
+		}
+	case ConfigDrive:
+		if netconf, err = readFile("openstack/latest/network_data.json"); err != nil {
+			return nil, err
+		}
+		if metadata, err = readFile("openstack/latest/meta_data.json"); err != nil {
+			return nil, err
+		}
+		if data.UserData, err = readFile("openstack/latest/user_data"); err != nil {
+			return nil, err
+		}
 	}
 
-	out, err := exec.Command("blkid", "-c", "/dev/null", "-ovalue", "-t", "TYPE=iso9660", "-s", strings.ToUpper(attr), device).CombinedOutput()
-	if err != nil {
-		var exitCode int
-		if exiterr, ok := err.(*exec.ExitError); ok {
-			status := exiterr.Sys().(syscall.WaitStatus)
-			switch {
-			case status.Exited():
-				exitCode = status.ExitStatus()
-			case status.Signaled():
-				exitCode = 128 + int(status.Signal())
+	if netconf != nil {
+		switch ds.Type {
+		case NoCloud:
+			data.Network, err = ParseNetworkConfig(netconf)
+		case ConfigDrive:
+			if data.Network, err = parseNetworkData(netconf); err == nil {
+				err = data.Network.Validate()
 			}
 		}
-		if exitCode != 2 {
-			return "", fmt.Errorf("blkid probe failed (%s): %s", err, out)
+		if err != nil {
+			return nil, err
 		}
 	}
-	return strings.TrimSpace(string(out)), nil
+
+	if metadata != nil {
+		switch ds.Type {
+		case NoCloud:
+			data.Meta, err = ParseMetaData(metadata)
+		case ConfigDrive:
+			data.Meta, err = parseConfigDriveMetaData(metadata)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data, nil
+}
+
+// findDatasource looks for the block device with
+// an iso9660 or vfat filesystem labelled CIDATA or config-2.
+func findDatasource() (*datasource, error) {
+	dir, err := os.ReadDir("/sys/class/block")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range dir {
+		if _, err := os.Stat(filepath.Join("/sys/class/block", file.Name(), "dev")); err != nil {
+			continue
+		}
+
+		device := filepath.Join("/dev", file.Name())
+
+		fstype, label, err := ProbeLabel(device)
+		if err != nil {
+			// E.g. an empty CD-ROM drive
+			continue
+		}
+
+		switch strings.ToUpper(label) {
+		case "CIDATA":
+			return &datasource{Type: NoCloud, Device: device, FSType: fstype}, nil
+		case "CONFIG-2":
+			return &datasource{Type: ConfigDrive, Device: device, FSType: fstype}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("neither CIDATA nor config-2 device found")
 }
 
 func IsNoCloudMarkerPresent() (bool, error) {
@@ -155,3 +192,59 @@ func IsNoCloudMarkerPresent() (bool, error) {
 
 	return false, nil
 }
+
+// IsConfigDriveMarkerPresent reports whether the hypervisor points to
+// the ConfigDrive datasource: the system serial number contains
+// "ds=configdrive" or the product name (or the chassis asset tag)
+// is the one set by OpenStack Nova.
+func IsConfigDriveMarkerPresent() (bool, error) {
+	rc, _, err := smbios.Stream()
+	if err != nil {
+		return false, fmt.Errorf("failed to open stream: %s", err)
+	}
+	defer rc.Close()
+
+	ss, err := smbios.NewDecoder(rc).Decode()
+	if err != nil {
+		return false, fmt.Errorf("failed to decode smbios structures: %s", err)
+	}
+
+	return hasConfigDriveMarker(ss), nil
+}
+
+func hasConfigDriveMarker(ss []*smbios.Structure) bool {
+	// Returns the string referenced by the byte at the given offset
+	// of the structure (the offsets include the 4-byte header)
+	str := func(s *smbios.Structure, offset int) string {
+		if offset-4 >= len(s.Formatted) {
+			return ""
+		}
+
+		if n := int(s.Formatted[offset-4]); n > 0 && n <= len(s.Strings) {
+			return strings.TrimSpace(s.Strings[n-1])
+		}
+
+		return ""
+	}
+
+	isNova := func(v string) bool {
+		return v == "OpenStack Nova" || v == "OpenStack Compute"
+	}
+
+	for _, s := range ss {
+		switch s.Header.Type {
+		case 1:
+			// System Information: Product Name (05h), Serial Number (07h)
+			if isNova(str(s, 0x05)) || strings.Contains(str(s, 0x07), "ds=configdrive") {
+				return true
+			}
+		case 3:
+			// Chassis Information: Asset Tag Number (08h)
+			if isNova(str(s, 0x08)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,203 @@
+package cloudinit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+)
+
+// networkData is the OpenStack network metadata (openstack/latest/network_data.json).
+type networkData struct {
+	Links    []*networkDataLink    `json:"links"`
+	Networks []*networkDataNetwork `json:"networks"`
+	Services []*networkDataService `json:"services"`
+}
+
+type networkDataLink struct {
+	ID             string   `json:"id"`
+	Type           string   `json:"type"`
+	MacAddress     string   `json:"ethernet_mac_address"`
+	MTU            int      `json:"mtu"`
+	VlanLink       string   `json:"vlan_link"`
+	VlanID         int      `json:"vlan_id"`
+	VlanMacAddress string   `json:"vlan_mac_address"`
+	BondLinks      []string `json:"bond_links"`
+	BondMode       string   `json:"bond_mode"`
+	BondMiimon     int      `json:"bond_miimon"`
+	BondHashPolicy string   `json:"bond_xmit_hash_policy"`
+}
+
+type networkDataNetwork struct {
+	ID        string                `json:"id"`
+	Type      string                `json:"type"`
+	Link      string                `json:"link"`
+	IPAddress string                `json:"ip_address"`
+	Netmask   string                `json:"netmask"`
+	Routes    []*networkDataRoute   `json:"routes"`
+	Services  []*networkDataService `json:"services"`
+}
+
+type networkDataRoute struct {
+	Network string `json:"network"`
+	Netmask string `json:"netmask"`
+	Gateway string `json:"gateway"`
+}
+
+type networkDataService struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+}
+
+// parseNetworkData translates the OpenStack network metadata
+// into the netplan-compatible model.
+func parseNetworkData(b []byte) (*NetworkConfig, error) {
+	var nd networkData
+
+	if err := json.Unmarshal(b, &nd); err != nil {
+		return nil, fmt.Errorf("invalid network_data.json: %w", err)
+	}
+
+	c := NetworkConfig{
+		Version: 2, // translated
+	}
+
+	devices := make(map[string]*DeviceConfig)
+
+	for idx, l := range nd.Links {
+		if l == nil || len(l.ID) == 0 {
+			return nil, fmt.Errorf("links[%d]: id is not specified", idx)
+		}
+
+		if _, ok := devices[l.ID]; ok {
+			return nil, fmt.Errorf("links[%d]: duplicate link id: %s", idx, l.ID)
+		}
+
+		switch l.Type {
+		case "vlan":
+			vlan := VlanConfig{
+				ID:   l.VlanID,
+				Link: l.VlanLink,
+			}
+
+			vlan.MTU = l.MTU
+			vlan.MacAddress = l.VlanMacAddress
+
+			if c.Vlans == nil {
+				c.Vlans = make(map[string]*VlanConfig)
+			}
+
+			c.Vlans[l.ID] = &vlan
+			devices[l.ID] = &vlan.DeviceConfig
+		case "bond":
+			bond := BondConfig{
+				Interfaces: l.BondLinks,
+			}
+
+			if len(l.BondMode) > 0 || l.BondMiimon > 0 || len(l.BondHashPolicy) > 0 {
+				bond.Parameters = &BondParameters{
+					Mode:               l.BondMode,
+					MiiMonitorInterval: l.BondMiimon,
+					TransmitHashPolicy: l.BondHashPolicy,
+				}
+			}
+
+			bond.MTU = l.MTU
+			bond.MacAddress = l.MacAddress
+
+			if c.Bonds == nil {
+				c.Bonds = make(map[string]*BondConfig)
+			}
+
+			c.Bonds[l.ID] = &bond
+			devices[l.ID] = &bond.DeviceConfig
+		default:
+			// All other types (phy, vif, ovs, tap, bridge, etc.)
+			// are the physical devices from the guest point of view
+			if len(l.MacAddress) == 0 {
+				return nil, fmt.Errorf("links[%d]: ethernet_mac_address is not specified", idx)
+			}
+
+			e := EthernetConfig{
+				Match: &MatchConfig{MacAddress: l.MacAddress},
+			}
+
+			e.MTU = l.MTU
+
+			if c.Ethernets == nil {
+				c.Ethernets = make(map[string]*EthernetConfig)
+			}
+
+			c.Ethernets[l.ID] = &e
+			devices[l.ID] = &e.DeviceConfig
+		}
+	}
+
+	for idx, n := range nd.Networks {
+		if n == nil {
+			return nil, fmt.Errorf("networks[%d]: empty definition", idx)
+		}
+
+		d, ok := devices[n.Link]
+		if !ok {
+			return nil, fmt.Errorf("networks[%d]: unknown link %q", idx, n.Link)
+		}
+
+		switch n.Type {
+		case "ipv4", "ipv6":
+			addr, err := toCIDR(n.IPAddress, n.Netmask)
+			if err != nil {
+				return nil, fmt.Errorf("networks[%d]: %w", idx, err)
+			}
+
+			d.Addresses = append(d.Addresses, addr)
+		case "ipv4_dhcp":
+			d.DHCP4 = true
+		case "ipv6_dhcp", "ipv6_dhcpv6-stateful", "ipv6_dhcpv6-stateless":
+			d.DHCP6 = true
+		case "ipv6_slaac":
+			// Nothing to do: the kernel configures SLAAC addresses itself
+		default:
+			return nil, fmt.Errorf("networks[%d]: unsupported network type %q", idx, n.Type)
+		}
+
+		for ridx, r := range n.Routes {
+			if r == nil {
+				return nil, fmt.Errorf("networks[%d].routes[%d]: empty definition", idx, ridx)
+			}
+
+			dst, err := toCIDR(r.Network, r.Netmask)
+			if err != nil {
+				return nil, fmt.Errorf("networks[%d].routes[%d]: %w", idx, ridx, err)
+			}
+
+			// Clear the host bits
+			if _, ipnet, err := net.ParseCIDR(dst); err == nil {
+				dst = ipnet.String()
+			}
+
+			d.Routes = append(d.Routes, &RouteConfig{To: dst, Via: r.Gateway})
+		}
+
+		d.Nameservers = appendDNSServices(d.Nameservers, n.Services)
+	}
+
+	c.Nameservers = appendDNSServices(c.Nameservers, nd.Services)
+
+	return &c, nil
+}
+
+func appendDNSServices(ns *NameserversConfig, services []*networkDataService) *NameserversConfig {
+	for _, s := range services {
+		if s == nil || s.Type != "dns" || len(s.Address) == 0 {
+			continue
+		}
+
+		if ns == nil {
+			ns = new(NameserversConfig)
+		}
+
+		ns.Addresses = append(ns.Addresses, s.Address)
+	}
+
+	return ns
+}
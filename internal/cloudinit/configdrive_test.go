@@ -0,0 +1,101 @@
+package cloudinit
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-smbios/smbios"
+)
+
+func TestParseNetworkData(t *testing.T) {
+	data := []byte(`{
+  "links": [
+    {"id": "tap0", "type": "ovs", "ethernet_mac_address": "fa:16:3e:00:00:01", "mtu": 1450},
+    {"id": "tap1", "type": "phy", "ethernet_mac_address": "fa:16:3e:00:00:02", "mtu": null},
+    {"id": "vlan5", "type": "vlan", "vlan_link": "tap1", "vlan_id": 5, "vlan_mac_address": "fa:16:3e:00:00:02"}
+  ],
+  "networks": [
+    {
+      "id": "network0", "type": "ipv4", "link": "tap0",
+      "ip_address": "10.0.0.5", "netmask": "255.255.255.0",
+      "routes": [{"network": "0.0.0.0", "netmask": "0.0.0.0", "gateway": "10.0.0.1"}],
+      "services": [{"type": "dns", "address": "10.0.0.2"}]
+    },
+    {
+      "id": "network1", "type": "ipv6", "link": "tap0",
+      "ip_address": "2001:db8::5", "netmask": "ffff:ffff:ffff:ffff::"
+    },
+    {"id": "network2", "type": "ipv4_dhcp", "link": "vlan5"}
+  ],
+  "services": [{"type": "dns", "address": "8.8.8.8"}]
+}`)
+
+	c, err := parseNetworkData(data)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("got unexpected validation error: %v", err)
+	}
+
+	e := c.Ethernets["tap0"]
+
+	if e == nil || e.Match.MacAddress != "fa:16:3e:00:00:01" || e.MTU != 1450 {
+		t.Fatalf("got invalid ethernet config: %+v", e)
+	}
+
+	if len(e.Addresses) != 2 || e.Addresses[0] != "10.0.0.5/24" || e.Addresses[1] != "2001:db8::5/64" {
+		t.Fatalf("got invalid addresses: %v", e.Addresses)
+	}
+
+	if len(e.Routes) != 1 || e.Routes[0].To != "0.0.0.0/0" || e.Routes[0].Via != "10.0.0.1" {
+		t.Fatalf("got invalid routes: %+v", e.Routes)
+	}
+
+	if e.Nameservers == nil || e.Nameservers.Addresses[0] != "10.0.0.2" {
+		t.Fatalf("got invalid nameservers: %+v", e.Nameservers)
+	}
+
+	if v := c.Vlans["vlan5"]; v == nil || v.ID != 5 || v.Link != "tap1" || !v.DHCP4 {
+		t.Fatalf("got invalid vlan config: %+v", v)
+	}
+
+	if c.Nameservers == nil || c.Nameservers.Addresses[0] != "8.8.8.8" {
+		t.Fatalf("got invalid global nameservers: %+v", c.Nameservers)
+	}
+
+	if _, err := parseNetworkData([]byte(`{"networks": [{"type": "ipv4", "link": "eth9"}]}`)); err == nil {
+		t.Fatalf("expected an error for unknown link, got nil")
+	}
+}
+
+func TestHasConfigDriveMarker(t *testing.T) {
+	// Manufacturer, Product Name, Version, Serial Number
+	sysinfo := func(product, serial string) *smbios.Structure {
+		return &smbios.Structure{
+			Header:    smbios.Header{Type: 1},
+			Formatted: []byte{1, 2, 3, 4},
+			Strings:   []string{"QEMU", product, "1.0", serial},
+		}
+	}
+
+	tests := []struct {
+		ss   []*smbios.Structure
+		want bool
+	}{
+		{[]*smbios.Structure{sysinfo("Standard PC", "")}, false},
+		{[]*smbios.Structure{sysinfo("Standard PC", "ds=nocloud")}, false},
+		{[]*smbios.Structure{sysinfo("OpenStack Nova", "")}, true},
+		{[]*smbios.Structure{sysinfo("Standard PC", "ds=configdrive")}, true},
+		// Chassis Information with the asset tag
+		{[]*smbios.Structure{sysinfo("Standard PC", ""), {Header: smbios.Header{Type: 3}, Formatted: []byte{1, 1, 0, 0, 2}, Strings: []string{"QEMU", "OpenStack Nova"}}}, true},
+		// Invalid string references
+		{[]*smbios.Structure{{Header: smbios.Header{Type: 1}, Formatted: []byte{1, 5}, Strings: []string{"QEMU"}}}, false},
+	}
+
+	for i, tt := range tests {
+		if got := hasConfigDriveMarker(tt.ss); got != tt.want {
+			t.Fatalf("test %d: got %t, want %t", i, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,575 @@
+package cloudinit
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// NetworkConfig is the network configuration in the netplan-compatible
+// format (network-config version 2). Other supported formats are translated
+// into this model.
+type NetworkConfig struct {
+	Version   int                        `json:"version" yaml:"version"`
+	Renderer  string                     `json:"renderer,omitempty" yaml:"renderer,omitempty"`
+	Ethernets map[string]*EthernetConfig `json:"ethernets,omitempty" yaml:"ethernets,omitempty"`
+	Bonds     map[string]*BondConfig     `json:"bonds,omitempty" yaml:"bonds,omitempty"`
+	Bridges   map[string]*BridgeConfig   `json:"bridges,omitempty" yaml:"bridges,omitempty"`
+	Vlans     map[string]*VlanConfig     `json:"vlans,omitempty" yaml:"vlans,omitempty"`
+
+	// Nameservers that are not bound to any device (network-config version 1 only)
+	Nameservers *NameserversConfig `json:"nameservers,omitempty" yaml:"-"`
+
+	// Warnings about the skipped parts of the config (network-config version 1 only)
+	Warnings []string `json:"-" yaml:"-"`
+}
+
+// DeviceConfig contains the properties common to all device types.
+type DeviceConfig struct {
+	Addresses     []string               `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	Gateway4      string                 `json:"gateway4,omitempty" yaml:"gateway4,omitempty"`
+	Gateway6      string                 `json:"gateway6,omitempty" yaml:"gateway6,omitempty"`
+	Nameservers   *NameserversConfig     `json:"nameservers,omitempty" yaml:"nameservers,omitempty"`
+	MTU           int                    `json:"mtu,omitempty" yaml:"mtu,omitempty"`
+	MacAddress    string                 `json:"macaddress,omitempty" yaml:"macaddress,omitempty"`
+	DHCP4         bool                   `json:"dhcp4,omitempty" yaml:"dhcp4,omitempty"`
+	DHCP6         bool                   `json:"dhcp6,omitempty" yaml:"dhcp6,omitempty"`
+	Routes        []*RouteConfig         `json:"routes,omitempty" yaml:"routes,omitempty"`
+	RoutingPolicy []*RoutingPolicyConfig `json:"routing-policy,omitempty" yaml:"routing-policy,omitempty"`
+
+	// Has no effect, accepted for compatibility with netplan
+	Optional bool `json:"optional,omitempty" yaml:"optional,omitempty"`
+}
+
+type EthernetConfig struct {
+	Match        *MatchConfig `json:"match,omitempty" yaml:"match,omitempty"`
+	SetName      string       `json:"set-name,omitempty" yaml:"set-name,omitempty"`
+	DeviceConfig `yaml:",inline"`
+}
+
+// MatchConfig selects the physical device by its name, MAC address
+// and driver. The name and the driver may contain shell-style globs.
+type MatchConfig struct {
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	MacAddress string `json:"macaddress,omitempty" yaml:"macaddress,omitempty"`
+	Driver     string `json:"driver,omitempty" yaml:"driver,omitempty"`
+
+	// The spelling understood by the earlier versions of netinit
+	LegacyMacAddress string `json:"-" yaml:"mac_address,omitempty"`
+}
+
+type BondConfig struct {
+	Interfaces   []string        `json:"interfaces,omitempty" yaml:"interfaces,omitempty"`
+	Parameters   *BondParameters `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	DeviceConfig `yaml:",inline"`
+}
+
+type BondParameters struct {
+	Mode               string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	MiiMonitorInterval int    `json:"mii-monitor-interval,omitempty" yaml:"mii-monitor-interval,omitempty"`
+	LacpRate           string `json:"lacp-rate,omitempty" yaml:"lacp-rate,omitempty"`
+	TransmitHashPolicy string `json:"transmit-hash-policy,omitempty" yaml:"transmit-hash-policy,omitempty"`
+	UpDelay            int    `json:"up-delay,omitempty" yaml:"up-delay,omitempty"`
+	DownDelay          int    `json:"down-delay,omitempty" yaml:"down-delay,omitempty"`
+}
+
+type BridgeConfig struct {
+	Interfaces   []string          `json:"interfaces,omitempty" yaml:"interfaces,omitempty"`
+	Parameters   *BridgeParameters `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	DeviceConfig `yaml:",inline"`
+}
+
+type BridgeParameters struct {
+	STP          *bool `json:"stp,omitempty" yaml:"stp,omitempty"`
+	ForwardDelay int   `json:"forward-delay,omitempty" yaml:"forward-delay,omitempty"`
+}
+
+// STPEnabled reports whether STP should be enabled on the bridge.
+// As in netplan, it is enabled by default.
+func (p *BridgeParameters) STPEnabled() bool {
+	return p == nil || p.STP == nil || *p.STP
+}
+
+type VlanConfig struct {
+	ID           int    `json:"id" yaml:"id"`
+	Link         string `json:"link" yaml:"link"`
+	DeviceConfig `yaml:",inline"`
+}
+
+type NameserversConfig struct {
+	Addresses []string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	Search    []string `json:"search,omitempty" yaml:"search,omitempty"`
+}
+
+type RouteConfig struct {
+	To     string `json:"to" yaml:"to"`
+	Via    string `json:"via,omitempty" yaml:"via,omitempty"`
+	From   string `json:"from,omitempty" yaml:"from,omitempty"`
+	OnLink bool   `json:"on-link,omitempty" yaml:"on-link,omitempty"`
+	Metric int    `json:"metric,omitempty" yaml:"metric,omitempty"`
+	Table  int    `json:"table,omitempty" yaml:"table,omitempty"`
+	Type   string `json:"type,omitempty" yaml:"type,omitempty"`
+	Scope  string `json:"scope,omitempty" yaml:"scope,omitempty"`
+	MTU    int    `json:"mtu,omitempty" yaml:"mtu,omitempty"`
+}
+
+// Destination returns the route destination prefix,
+// "default" is converted according to the gateway family.
+func (r *RouteConfig) Destination() string {
+	if r.To != "default" {
+		return r.To
+	}
+
+	if ip := net.ParseIP(r.Via); ip != nil && ip.To4() == nil {
+		return "::/0"
+	}
+
+	return "0.0.0.0/0"
+}
+
+type RoutingPolicyConfig struct {
+	From     string `json:"from,omitempty" yaml:"from,omitempty"`
+	To       string `json:"to,omitempty" yaml:"to,omitempty"`
+	Table    int    `json:"table" yaml:"table"`
+	Priority int    `json:"priority,omitempty" yaml:"priority,omitempty"`
+	Mark     uint32 `json:"mark,omitempty" yaml:"mark,omitempty"`
+}
+
+var (
+	bondModes = []string{"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+
+	bondLacpRates = []string{"slow", "fast"}
+
+	bondHashPolicies = []string{"layer2", "layer3+4", "layer2+3", "encap2+3", "encap3+4"}
+
+	routeTypes = []string{"unicast", "blackhole", "unreachable", "prohibit"}
+
+	routeScopes = []string{"global", "link", "host"}
+)
+
+// ParseNetworkConfig parses the network-config data of version 1 or 2.
+// Both the bare format and the one wrapped in the top-level "network" key
+// are accepted.
+func ParseNetworkConfig(b []byte) (*NetworkConfig, error) {
+	var top map[string]interface{}
+
+	if err := yaml.Unmarshal(b, &top); err != nil {
+		return nil, fmt.Errorf("invalid network-config: %w", err)
+	}
+
+	if v, ok := top["network"]; ok {
+		nb, err := yaml.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		b = nb
+
+		top = nil
+
+		if err := yaml.Unmarshal(b, &top); err != nil {
+			return nil, fmt.Errorf("invalid network-config: %w", err)
+		}
+	}
+
+	var c *NetworkConfig
+
+	switch v := top["version"].(type) {
+	case int:
+		switch v {
+		case 1:
+			x, err := parseNetworkConfigV1(b)
+			if err != nil {
+				return nil, err
+			}
+			c = x
+		case 2:
+			c = new(NetworkConfig)
+
+			if err := unmarshalStrict(b, c); err != nil {
+				return nil, fmt.Errorf("invalid network-config: %w", err)
+			}
+
+			for _, e := range c.Ethernets {
+				if e != nil && e.Match != nil && len(e.Match.MacAddress) == 0 {
+					e.Match.MacAddress = e.Match.LegacyMacAddress
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported network-config version: %d", v)
+		}
+	case nil:
+		return nil, fmt.Errorf("network-config version is not specified")
+	default:
+		return nil, fmt.Errorf("invalid network-config version: %v", v)
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+var yamlUnknownField = regexp.MustCompile(`field (\S+) not found in type \S+`)
+
+// unmarshalStrict is like yaml.UnmarshalStrict, but makes the errors
+// about the unknown keys more readable.
+func unmarshalStrict(b []byte, v interface{}) error {
+	if err := yaml.UnmarshalStrict(b, v); err != nil {
+		var te *yaml.TypeError
+
+		if errors.As(err, &te) {
+			msgs := make([]string, 0, len(te.Errors))
+
+			for _, s := range te.Errors {
+				msgs = append(msgs, yamlUnknownField.ReplaceAllString(s, `unsupported key "$1"`))
+			}
+
+			return errors.New(strings.Join(msgs, "; "))
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Validate checks the configuration for errors that
+// cannot be detected by the YAML parser.
+func (c *NetworkConfig) Validate() error {
+	if c.Nameservers != nil {
+		for _, s := range c.Nameservers.Addresses {
+			if net.ParseIP(s) == nil {
+				return fmt.Errorf("nameservers: invalid IP address: %s", s)
+			}
+		}
+	}
+
+	ids := make(map[string]string)
+
+	register := func(section, id string) error {
+		if s, ok := ids[id]; ok {
+			return fmt.Errorf("%s.%s: ID is already used in %s", section, id, s)
+		}
+
+		ids[id] = section
+
+		return nil
+	}
+
+	for _, id := range sortedKeys(c.Ethernets) {
+		e := c.Ethernets[id]
+
+		if e == nil {
+			return fmt.Errorf("ethernets.%s: empty definition", id)
+		}
+
+		if err := register("ethernets", id); err != nil {
+			return err
+		}
+
+		path := "ethernets." + id
+
+		if len(e.SetName) > 0 && e.Match == nil {
+			return fmt.Errorf("%s: set-name requires match rules", path)
+		}
+
+		if e.Match != nil {
+			if len(e.Match.MacAddress) > 0 {
+				if _, err := net.ParseMAC(e.Match.MacAddress); err != nil {
+					return fmt.Errorf("%s.match: %w", path, err)
+				}
+			}
+
+			for _, p := range []string{e.Match.Name, e.Match.Driver} {
+				if _, err := filepath.Match(p, ""); err != nil {
+					return fmt.Errorf("%s.match: invalid pattern %q", path, p)
+				}
+			}
+		}
+
+		if err := e.DeviceConfig.validate(path); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sortedKeys(c.Bonds) {
+		b := c.Bonds[id]
+
+		if b == nil {
+			return fmt.Errorf("bonds.%s: empty definition", id)
+		}
+
+		if err := register("bonds", id); err != nil {
+			return err
+		}
+
+		path := "bonds." + id
+
+		if p := b.Parameters; p != nil {
+			if err := oneOf(path+".parameters.mode", p.Mode, bondModes); err != nil {
+				return err
+			}
+			if err := oneOf(path+".parameters.lacp-rate", p.LacpRate, bondLacpRates); err != nil {
+				return err
+			}
+			if err := oneOf(path+".parameters.transmit-hash-policy", p.TransmitHashPolicy, bondHashPolicies); err != nil {
+				return err
+			}
+		}
+
+		if err := b.DeviceConfig.validate(path); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sortedKeys(c.Bridges) {
+		b := c.Bridges[id]
+
+		if b == nil {
+			return fmt.Errorf("bridges.%s: empty definition", id)
+		}
+
+		if err := register("bridges", id); err != nil {
+			return err
+		}
+
+		if err := b.DeviceConfig.validate("bridges." + id); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sortedKeys(c.Vlans) {
+		v := c.Vlans[id]
+
+		if v == nil {
+			return fmt.Errorf("vlans.%s: empty definition", id)
+		}
+
+		if err := register("vlans", id); err != nil {
+			return err
+		}
+
+		path := "vlans." + id
+
+		if v.ID < 1 || v.ID > 4094 {
+			return fmt.Errorf("%s: invalid VLAN ID: %d", path, v.ID)
+		}
+
+		if len(v.Link) == 0 {
+			return fmt.Errorf("%s: link is not specified", path)
+		}
+
+		if err := v.DeviceConfig.validate(path); err != nil {
+			return err
+		}
+	}
+
+	// All the references must point to the defined devices
+	for _, id := range sortedKeys(c.Vlans) {
+		if _, ok := ids[c.Vlans[id].Link]; !ok {
+			return fmt.Errorf("vlans.%s: unknown link %q", id, c.Vlans[id].Link)
+		}
+	}
+
+	members := make(map[string]string)
+
+	checkMembers := func(section, id string, ifaces []string) error {
+		for _, x := range ifaces {
+			if _, ok := ids[x]; !ok {
+				return fmt.Errorf("%s.%s: unknown interface %q", section, id, x)
+			}
+
+			if m, ok := members[x]; ok {
+				return fmt.Errorf("%s.%s: interface %q is already a member of %s", section, id, x, m)
+			}
+
+			members[x] = id
+		}
+
+		return nil
+	}
+
+	for _, id := range sortedKeys(c.Bonds) {
+		if err := checkMembers("bonds", id, c.Bonds[id].Interfaces); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sortedKeys(c.Bridges) {
+		if err := checkMembers("bridges", id, c.Bridges[id].Interfaces); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DeviceConfig) validate(path string) error {
+	for _, s := range d.Addresses {
+		if _, _, err := net.ParseCIDR(s); err != nil {
+			return fmt.Errorf("%s.addresses: %w", path, err)
+		}
+	}
+
+	if len(d.Gateway4) > 0 {
+		if ip := net.ParseIP(d.Gateway4); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%s.gateway4: invalid IPv4 address: %s", path, d.Gateway4)
+		}
+	}
+
+	if len(d.Gateway6) > 0 {
+		if ip := net.ParseIP(d.Gateway6); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%s.gateway6: invalid IPv6 address: %s", path, d.Gateway6)
+		}
+	}
+
+	if d.Nameservers != nil {
+		for _, s := range d.Nameservers.Addresses {
+			if net.ParseIP(s) == nil {
+				return fmt.Errorf("%s.nameservers.addresses: invalid IP address: %s", path, s)
+			}
+		}
+	}
+
+	if len(d.MacAddress) > 0 {
+		if _, err := net.ParseMAC(d.MacAddress); err != nil {
+			return fmt.Errorf("%s.macaddress: %w", path, err)
+		}
+	}
+
+	for idx, r := range d.Routes {
+		rpath := fmt.Sprintf("%s.routes[%d]", path, idx)
+
+		if r == nil || len(r.To) == 0 {
+			return fmt.Errorf("%s: destination is not specified", rpath)
+		}
+
+		if r.To != "default" && !isPrefix(r.To) {
+			return fmt.Errorf("%s.to: invalid prefix: %s", rpath, r.To)
+		}
+
+		for k, v := range map[string]string{"via": r.Via, "from": r.From} {
+			if len(v) > 0 && net.ParseIP(v) == nil {
+				return fmt.Errorf("%s.%s: invalid IP address: %s", rpath, k, v)
+			}
+		}
+
+		if err := oneOf(rpath+".type", r.Type, routeTypes); err != nil {
+			return err
+		}
+
+		if err := oneOf(rpath+".scope", r.Scope, routeScopes); err != nil {
+			return err
+		}
+	}
+
+	for idx, p := range d.RoutingPolicy {
+		ppath := fmt.Sprintf("%s.routing-policy[%d]", path, idx)
+
+		if p == nil || (len(p.From) == 0 && len(p.To) == 0) {
+			return fmt.Errorf("%s: neither from nor to is specified", ppath)
+		}
+
+		if p.Table <= 0 {
+			return fmt.Errorf("%s: table is not specified", ppath)
+		}
+
+		for k, v := range map[string]string{"from": p.From, "to": p.To} {
+			if len(v) > 0 && !isPrefix(v) {
+				return fmt.Errorf("%s.%s: invalid prefix: %s", ppath, k, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// MatchEthernet returns the ID and the configuration of the first
+// (in order of IDs) ethernets entry that matches the given device.
+// An entry without match rules matches the device with the same name as its ID.
+func (c *NetworkConfig) MatchEthernet(ifname, hwaddr, driver string) (string, *EthernetConfig) {
+	for _, id := range sortedKeys(c.Ethernets) {
+		e := c.Ethernets[id]
+
+		switch {
+		case e.Match == nil:
+			if id == ifname {
+				return id, e
+			}
+		case len(e.SetName) > 0 && e.SetName == ifname:
+			// Already renamed
+			return id, e
+		case e.Match.matches(ifname, hwaddr, driver):
+			return id, e
+		}
+	}
+
+	return "", nil
+}
+
+func (m *MatchConfig) matches(ifname, hwaddr, driver string) bool {
+	if len(m.Name) == 0 && len(m.MacAddress) == 0 && len(m.Driver) == 0 {
+		return false
+	}
+
+	if len(m.Name) > 0 {
+		if ok, _ := filepath.Match(m.Name, ifname); !ok {
+			return false
+		}
+	}
+
+	if len(m.MacAddress) > 0 && !strings.EqualFold(m.MacAddress, hwaddr) {
+		return false
+	}
+
+	if len(m.Driver) > 0 {
+		if ok, _ := filepath.Match(m.Driver, driver); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPrefix reports whether s is a network prefix or a single IP address.
+func isPrefix(s string) bool {
+	if _, _, err := net.ParseCIDR(s); err == nil {
+		return true
+	}
+
+	return net.ParseIP(s) != nil
+}
+
+func oneOf(path, value string, allowed []string) error {
+	if len(value) == 0 {
+		return nil
+	}
+
+	for _, x := range allowed {
+		if x == value {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s: unsupported value %q (allowed: %s)", path, value, strings.Join(allowed, ", "))
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,130 @@
+package cloudinit
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseNetworkConfig(t *testing.T) {
+	data := []byte(`
+network:
+  version: 2
+  renderer: networkd
+  ethernets:
+    nic0:
+      match:
+        macaddress: "52:54:00:12:34:56"
+      set-name: eth0
+      mtu: 9000
+      addresses: [10.0.0.5/24, "2001:db8::5/64"]
+      gateway4: 10.0.0.1
+      nameservers:
+        addresses: [10.0.0.2]
+        search: [example.com]
+      routes:
+        - to: default
+          via: 2001:db8::1
+          metric: 100
+        - to: 192.168.0.0/16
+          via: 10.0.0.254
+          table: 100
+          on-link: true
+      routing-policy:
+        - from: 10.0.0.0/24
+          table: 100
+          priority: 1000
+    old:
+      match:
+        mac_address: "52:54:00:aa:bb:cc"
+    nic1:
+      match:
+        driver: virtio*
+        name: ens*
+  bonds:
+    bond0:
+      interfaces: [nic1]
+      parameters:
+        mode: 802.3ad
+        lacp-rate: fast
+  vlans:
+    vlan10:
+      id: 10
+      link: bond0
+      dhcp4: true
+`)
+
+	c, err := ParseNetworkConfig(data)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	e := c.Ethernets["nic0"]
+
+	if e == nil || e.SetName != "eth0" || e.MTU != 9000 || len(e.Addresses) != 2 {
+		t.Fatalf("got invalid ethernet config: %+v", e)
+	}
+
+	if got := e.Routes[0].Destination(); got != "::/0" {
+		t.Fatalf("got invalid route destination:\nwant:\t%q\ngot:\t%q", "::/0", got)
+	}
+
+	if !e.Routes[1].OnLink || e.Routes[1].Table != 100 {
+		t.Fatalf("got invalid route: %+v", e.Routes[1])
+	}
+
+	if got := c.Ethernets["old"].Match.MacAddress; got != "52:54:00:aa:bb:cc" {
+		t.Fatalf("got invalid legacy mac_address:\nwant:\t%q\ngot:\t%q", "52:54:00:aa:bb:cc", got)
+	}
+
+	if v := c.Vlans["vlan10"]; v == nil || v.ID != 10 || v.Link != "bond0" || !v.DHCP4 {
+		t.Fatalf("got invalid vlan config: %+v", v)
+	}
+
+	// matching tests
+
+	if id, _ := c.MatchEthernet("ens3", "52:54:00:12:34:56", "virtio_net"); id != "nic0" {
+		t.Fatalf("got invalid match by mac:\nwant:\t%q\ngot:\t%q", "nic0", id)
+	}
+
+	if id, _ := c.MatchEthernet("eth0", "52:54:00:00:00:01", "e1000"); id != "nic0" {
+		t.Fatalf("got invalid match by set-name:\nwant:\t%q\ngot:\t%q", "nic0", id)
+	}
+
+	if id, _ := c.MatchEthernet("ens4", "52:54:00:00:00:01", "virtio_net"); id != "nic1" {
+		t.Fatalf("got invalid match by name and driver:\nwant:\t%q\ngot:\t%q", "nic1", id)
+	}
+
+	if id, e := c.MatchEthernet("ens4", "52:54:00:00:00:01", "e1000"); e != nil {
+		t.Fatalf("got unexpected match: %q", id)
+	}
+}
+
+func TestParseNetworkConfigErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{"version: 3\nconfig: []", "unsupported network-config version"},
+		{"ethernets: {}", "version is not specified"},
+		{"version: 2\nwifis: {}", `unsupported key "wifis"`},
+		{"version: 2\nethernets:\n  eth0:\n    wakeonlan: true", `unsupported key "wakeonlan"`},
+		{"version: 2\nethernets:\n  eth0:\n    set-name: lan0", "set-name requires match rules"},
+		{"version: 2\nethernets:\n  eth0:\n    addresses: [10.0.0.5]", "ethernets.eth0.addresses"},
+		{"version: 2\nethernets:\n  eth0:\n    routes:\n      - via: 10.0.0.1", "destination is not specified"},
+		{"version: 2\nethernets:\n  eth0:\n    routing-policy:\n      - from: 10.0.0.0/8", "table is not specified"},
+		{"version: 2\nvlans:\n  vlan5:\n    id: 5\n    link: eth9", `unknown link "eth9"`},
+		{"version: 2\nethernets:\n  eth0: {}\nbonds:\n  bond0:\n    interfaces: [eth0]\n    parameters:\n      mode: fast", `unsupported value "fast"`},
+		{"version: 2\nethernets:\n  eth0: {}\nbonds:\n  bond0:\n    interfaces: [eth0]\nbridges:\n  br0:\n    interfaces: [eth0]", "already a member of bond0"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseNetworkConfig([]byte(tt.data))
+		if err == nil {
+			t.Fatalf("expected an error for:\n%s", tt.data)
+		}
+
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Fatalf("got invalid error:\nwant:\t%q\ngot:\t%q", tt.want, err)
+		}
+	}
+}
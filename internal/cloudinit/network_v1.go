@@ -0,0 +1,593 @@
+package cloudinit
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// The types below describe the entries of the network-config version 1.
+// See https://cloudinit.readthedocs.io/en/latest/reference/network-config-format-v1.html
+
+type v1Device struct {
+	Type       string      `yaml:"type"`
+	Name       string      `yaml:"name"`
+	MacAddress string      `yaml:"mac_address"`
+	MTU        int         `yaml:"mtu"`
+	Subnets    []*v1Subnet `yaml:"subnets"`
+}
+
+type v1Vlan struct {
+	v1Device `yaml:",inline"`
+	VlanLink string `yaml:"vlan_link"`
+	VlanID   int    `yaml:"vlan_id"`
+}
+
+type v1Bond struct {
+	v1Device       `yaml:",inline"`
+	BondInterfaces []string               `yaml:"bond_interfaces"`
+	Params         map[string]interface{} `yaml:"params"`
+}
+
+type v1Bridge struct {
+	v1Device         `yaml:",inline"`
+	BridgeInterfaces []string               `yaml:"bridge_interfaces"`
+	Params           map[string]interface{} `yaml:"params"`
+}
+
+type v1Subnet struct {
+	Type           string     `yaml:"type"`
+	Control        string     `yaml:"control"`
+	Address        string     `yaml:"address"`
+	Netmask        string     `yaml:"netmask"`
+	Gateway        string     `yaml:"gateway"`
+	DNSNameservers stringList `yaml:"dns_nameservers"`
+	DNSSearch      stringList `yaml:"dns_search"`
+	Routes         []*v1Route `yaml:"routes"`
+}
+
+type v1Route struct {
+	Network     string `yaml:"network"`
+	Destination string `yaml:"destination"`
+	Netmask     string `yaml:"netmask"`
+	Prefix      int    `yaml:"prefix"`
+	Gateway     string `yaml:"gateway"`
+	Metric      int    `yaml:"metric"`
+}
+
+type v1GlobalRoute struct {
+	Type    string `yaml:"type"`
+	v1Route `yaml:",inline"`
+}
+
+type v1Nameserver struct {
+	Type      string     `yaml:"type"`
+	Address   stringList `yaml:"address"`
+	Search    stringList `yaml:"search"`
+	Interface string     `yaml:"interface"`
+}
+
+// stringList is a list of strings that can also be specified as a single string.
+type stringList []string
+
+func (l *stringList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+
+	if err := unmarshal(&s); err == nil {
+		*l = strings.Fields(s)
+
+		return nil
+	}
+
+	var v []string
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	*l = v
+
+	return nil
+}
+
+// parseNetworkConfigV1 translates the network-config version 1
+// into the netplan-compatible model.
+func parseNetworkConfigV1(b []byte) (*NetworkConfig, error) {
+	var v struct {
+		Version int             `yaml:"version"`
+		Config  []yaml.MapSlice `yaml:"config"`
+	}
+
+	if err := unmarshalStrict(b, &v); err != nil {
+		return nil, fmt.Errorf("invalid network-config: %w", err)
+	}
+
+	c := NetworkConfig{
+		Version: 2, // translated
+	}
+
+	// Devices by name in order of appearance
+	devices := make(map[string]*DeviceConfig)
+	order := make([]string, 0, len(v.Config))
+
+	addDevice := func(name string, d *DeviceConfig) error {
+		if len(name) == 0 {
+			return fmt.Errorf("name is not specified")
+		}
+
+		if _, ok := devices[name]; ok {
+			return fmt.Errorf("duplicate device name: %s", name)
+		}
+
+		devices[name] = d
+		order = append(order, name)
+
+		return nil
+	}
+
+	var routes []*v1Route
+	var routeIdx []int
+
+	for idx, item := range v.Config {
+		path := fmt.Sprintf("config[%d]", idx)
+
+		raw, err := yaml.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+
+		var t string
+
+		for _, kv := range item {
+			if k, ok := kv.Key.(string); ok && k == "type" {
+				t, _ = kv.Value.(string)
+			}
+		}
+
+		path += " (" + t + ")"
+
+		// Decodes the entry into the type-specific structure
+		decode := func(x interface{}) error {
+			if err := unmarshalStrict(raw, x); err != nil {
+				return fmt.Errorf("invalid network-config: %s: %w", path, err)
+			}
+
+			return nil
+		}
+
+		switch t {
+		case "physical":
+			var x v1Device
+
+			if err := decode(&x); err != nil {
+				return nil, err
+			}
+
+			e := EthernetConfig{}
+
+			if len(x.MacAddress) > 0 {
+				e.Match = &MatchConfig{MacAddress: x.MacAddress}
+				e.SetName = x.Name
+			}
+
+			if e.DeviceConfig, err = x.deviceConfig(); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if err := addDevice(x.Name, &e.DeviceConfig); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if c.Ethernets == nil {
+				c.Ethernets = make(map[string]*EthernetConfig)
+			}
+
+			c.Ethernets[x.Name] = &e
+		case "vlan":
+			var x v1Vlan
+
+			if err := decode(&x); err != nil {
+				return nil, err
+			}
+
+			vlan := VlanConfig{
+				ID:   x.VlanID,
+				Link: x.VlanLink,
+			}
+
+			if vlan.DeviceConfig, err = x.deviceConfig(); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if err := addDevice(x.Name, &vlan.DeviceConfig); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if c.Vlans == nil {
+				c.Vlans = make(map[string]*VlanConfig)
+			}
+
+			c.Vlans[x.Name] = &vlan
+		case "bond":
+			var x v1Bond
+
+			if err := decode(&x); err != nil {
+				return nil, err
+			}
+
+			bond := BondConfig{
+				Interfaces: x.BondInterfaces,
+			}
+
+			if bond.Parameters, err = v1BondParameters(x.Params); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if bond.DeviceConfig, err = x.deviceConfig(); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if err := addDevice(x.Name, &bond.DeviceConfig); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if c.Bonds == nil {
+				c.Bonds = make(map[string]*BondConfig)
+			}
+
+			c.Bonds[x.Name] = &bond
+		case "bridge":
+			var x v1Bridge
+
+			if err := decode(&x); err != nil {
+				return nil, err
+			}
+
+			br := BridgeConfig{
+				Interfaces: x.BridgeInterfaces,
+			}
+
+			if br.Parameters, err = v1BridgeParameters(x.Params); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if br.DeviceConfig, err = x.deviceConfig(); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if err := addDevice(x.Name, &br.DeviceConfig); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+
+			if c.Bridges == nil {
+				c.Bridges = make(map[string]*BridgeConfig)
+			}
+
+			c.Bridges[x.Name] = &br
+		case "route":
+			var x v1GlobalRoute
+
+			if err := decode(&x); err != nil {
+				return nil, err
+			}
+
+			// Will be bound to the devices when all of them are known
+			routes = append(routes, &x.v1Route)
+			routeIdx = append(routeIdx, idx)
+		case "nameserver":
+			var x v1Nameserver
+
+			if err := decode(&x); err != nil {
+				return nil, err
+			}
+
+			ns := &c.Nameservers
+
+			if len(x.Interface) > 0 {
+				d, ok := devices[x.Interface]
+				if !ok {
+					return nil, fmt.Errorf("%s: unknown interface %q", path, x.Interface)
+				}
+
+				ns = &d.Nameservers
+			}
+
+			if *ns == nil {
+				*ns = new(NameserversConfig)
+			}
+
+			(*ns).Addresses = append((*ns).Addresses, x.Address...)
+			(*ns).Search = append((*ns).Search, x.Search...)
+		case "loopback":
+			// Nothing to do
+		default:
+			return nil, fmt.Errorf("%s: unsupported entry type %q", path, t)
+		}
+	}
+
+	// The global routes are bound to the device whose static
+	// address network contains the gateway. The gateway can also be
+	// in a DHCP subnet, which is unknown here, so such routes are skipped
+	for i, r := range routes {
+		path := fmt.Sprintf("config[%d] (route)", routeIdx[i])
+
+		rc, err := r.routeConfig()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		gw := net.ParseIP(rc.Via)
+		if gw == nil {
+			return nil, fmt.Errorf("%s: gateway is not specified", path)
+		}
+
+		var dev *DeviceConfig
+
+	LOOP:
+		for _, name := range order {
+			for _, a := range devices[name].Addresses {
+				if _, n, err := net.ParseCIDR(a); err == nil && n.Contains(gw) {
+					dev = devices[name]
+					break LOOP
+				}
+			}
+		}
+
+		if dev == nil {
+			c.Warnings = append(c.Warnings, fmt.Sprintf("%s: skipping route to %s: no device with a static subnet containing gateway %s", path, rc.To, rc.Via))
+
+			continue
+		}
+
+		dev.Routes = append(dev.Routes, rc)
+	}
+
+	return &c, nil
+}
+
+func (d *v1Device) deviceConfig() (DeviceConfig, error) {
+	dc := DeviceConfig{
+		MTU:        d.MTU,
+		MacAddress: d.MacAddress,
+	}
+
+	// For physical devices the address is used to match the device
+	if d.Type == "physical" {
+		dc.MacAddress = ""
+	}
+
+	for idx, sn := range d.Subnets {
+		path := fmt.Sprintf("subnets[%d]", idx)
+
+		if sn == nil {
+			return dc, fmt.Errorf("%s: empty definition", path)
+		}
+
+		switch sn.Type {
+		case "static", "static6":
+			addr, err := toCIDR(sn.Address, sn.Netmask)
+			if err != nil {
+				return dc, fmt.Errorf("%s: %w", path, err)
+			}
+
+			dc.Addresses = append(dc.Addresses, addr)
+
+			if len(sn.Gateway) > 0 {
+				gw := &dc.Gateway6
+
+				if ip := net.ParseIP(sn.Gateway); ip != nil && ip.To4() != nil {
+					gw = &dc.Gateway4
+				}
+
+				if len(*gw) > 0 && *gw != sn.Gateway {
+					return dc, fmt.Errorf("%s: only one gateway per address family is supported", path)
+				}
+
+				*gw = sn.Gateway
+			}
+		case "dhcp", "dhcp4":
+			dc.DHCP4 = true
+		case "dhcp6", "ipv6_dhcpv6-stateful", "ipv6_dhcpv6-stateless":
+			dc.DHCP6 = true
+		case "ipv6_slaac", "manual":
+			// Nothing to do: the kernel configures SLAAC addresses itself
+		default:
+			return dc, fmt.Errorf("%s: unsupported subnet type %q", path, sn.Type)
+		}
+
+		if len(sn.DNSNameservers) > 0 || len(sn.DNSSearch) > 0 {
+			if dc.Nameservers == nil {
+				dc.Nameservers = new(NameserversConfig)
+			}
+
+			dc.Nameservers.Addresses = append(dc.Nameservers.Addresses, sn.DNSNameservers...)
+			dc.Nameservers.Search = append(dc.Nameservers.Search, sn.DNSSearch...)
+		}
+
+		for ridx, r := range sn.Routes {
+			if r == nil {
+				return dc, fmt.Errorf("%s.routes[%d]: empty definition", path, ridx)
+			}
+
+			rc, err := r.routeConfig()
+			if err != nil {
+				return dc, fmt.Errorf("%s.routes[%d]: %w", path, ridx, err)
+			}
+
+			dc.Routes = append(dc.Routes, rc)
+		}
+	}
+
+	return dc, nil
+}
+
+func (r *v1Route) routeConfig() (*RouteConfig, error) {
+	dst := r.Destination
+
+	if len(dst) == 0 {
+		if len(r.Network) == 0 {
+			return nil, fmt.Errorf("destination is not specified")
+		}
+
+		switch {
+		case len(r.Netmask) > 0:
+			s, err := toCIDR(r.Network, r.Netmask)
+			if err != nil {
+				return nil, err
+			}
+			dst = s
+		case r.Prefix > 0:
+			dst = r.Network + "/" + strconv.Itoa(r.Prefix)
+		default:
+			dst = r.Network
+		}
+	}
+
+	rc := RouteConfig{
+		To:     dst,
+		Via:    r.Gateway,
+		Metric: r.Metric,
+	}
+
+	// Clear the host bits, e.g. 10.1.2.3/8 -> 10.0.0.0/8
+	if _, n, err := net.ParseCIDR(rc.To); err == nil {
+		rc.To = n.String()
+	}
+
+	return &rc, nil
+}
+
+// toCIDR converts the address and the netmask to the CIDR notation.
+// The netmask can be specified in the dotted form or as a prefix length.
+func toCIDR(addr, netmask string) (string, error) {
+	if len(addr) == 0 {
+		return "", fmt.Errorf("address is not specified")
+	}
+
+	if strings.Contains(addr, "/") {
+		return addr, nil
+	}
+
+	if len(netmask) == 0 {
+		return "", fmt.Errorf("no prefix length or netmask specified for %s", addr)
+	}
+
+	if m := net.ParseIP(netmask); m != nil {
+		mask := net.IPMask(m.To16())
+
+		if m4 := m.To4(); m4 != nil && strings.Contains(netmask, ".") {
+			mask = net.IPMask(m4)
+		}
+
+		ones, bits := mask.Size()
+		if bits == 0 {
+			return "", fmt.Errorf("invalid netmask: %s", netmask)
+		}
+
+		return fmt.Sprintf("%s/%d", addr, ones), nil
+	}
+
+	if n, err := strconv.Atoi(netmask); err == nil {
+		return fmt.Sprintf("%s/%d", addr, n), nil
+	}
+
+	return "", fmt.Errorf("invalid netmask: %s", netmask)
+}
+
+func v1BondParameters(params map[string]interface{}) (*BondParameters, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	p := BondParameters{}
+
+	for k, v := range params {
+		var err error
+
+		switch strings.TrimPrefix(strings.ReplaceAll(k, "_", "-"), "bond-") {
+		case "mode":
+			p.Mode = fmt.Sprint(v)
+		case "miimon":
+			p.MiiMonitorInterval, err = paramInt(v)
+		case "lacp-rate":
+			p.LacpRate = fmt.Sprint(v)
+		case "xmit-hash-policy":
+			p.TransmitHashPolicy = fmt.Sprint(v)
+		case "updelay":
+			p.UpDelay, err = paramInt(v)
+		case "downdelay":
+			p.DownDelay, err = paramInt(v)
+		default:
+			return nil, fmt.Errorf("unsupported bond parameter %q", k)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of bond parameter %q: %w", k, err)
+		}
+	}
+
+	return &p, nil
+}
+
+func v1BridgeParameters(params map[string]interface{}) (*BridgeParameters, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	p := BridgeParameters{}
+
+	for k, v := range params {
+		var err error
+
+		switch strings.TrimPrefix(strings.ReplaceAll(k, "-", "_"), "bridge_") {
+		case "stp":
+			var stp bool
+
+			stp, err = paramBool(v)
+
+			p.STP = &stp
+		case "fd":
+			p.ForwardDelay, err = paramInt(v)
+		default:
+			return nil, fmt.Errorf("unsupported bridge parameter %q", k)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of bridge parameter %q: %w", k, err)
+		}
+	}
+
+	return &p, nil
+}
+
+func paramInt(v interface{}) (int, error) {
+	switch x := v.(type) {
+	case int:
+		return x, nil
+	case string:
+		return strconv.Atoi(x)
+	}
+
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func paramBool(v interface{}) (bool, error) {
+	switch x := v.(type) {
+	case bool:
+		return x, nil
+	case string:
+		switch strings.ToLower(x) {
+		case "on", "yes", "true", "1":
+			return true, nil
+		case "off", "no", "false", "0":
+			return false, nil
+		}
+	case int:
+		return x != 0, nil
+	}
+
+	return false, fmt.Errorf("not a boolean: %v", v)
+}
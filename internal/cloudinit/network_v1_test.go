@@ -0,0 +1,154 @@
+package cloudinit
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseNetworkConfigV1(t *testing.T) {
+	data := []byte(`
+version: 1
+config:
+  - type: physical
+    name: eth0
+    mac_address: "52:54:00:12:34:56"
+    mtu: 1450
+    subnets:
+      - type: static
+        address: 192.168.1.10
+        netmask: 255.255.255.0
+        gateway: 192.168.1.1
+        dns_nameservers: [192.168.1.2]
+        routes:
+          - network: 10.0.0.0
+            netmask: 255.0.0.0
+            gateway: 192.168.1.254
+            metric: 3
+      - type: static6
+        address: 2001:db8::10/64
+        gateway: 2001:db8::1
+  - type: physical
+    name: eth1
+  - type: physical
+    name: eth2
+  - type: bond
+    name: bond0
+    bond_interfaces: [eth1, eth2]
+    params:
+      bond-mode: 802.3ad
+      bond-miimon: "100"
+    subnets:
+      - type: dhcp4
+  - type: vlan
+    name: bond0.100
+    vlan_link: bond0
+    vlan_id: 100
+    subnets:
+      - type: static
+        address: 172.16.0.5/24
+  - type: route
+    destination: 172.17.0.0/16
+    gateway: 172.16.0.1
+  - type: nameserver
+    address: 8.8.8.8
+    search: [example.com]
+`)
+
+	c, err := ParseNetworkConfig(data)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	e := c.Ethernets["eth0"]
+
+	if e == nil || e.Match == nil || e.Match.MacAddress != "52:54:00:12:34:56" || e.SetName != "eth0" || e.MTU != 1450 {
+		t.Fatalf("got invalid ethernet config: %+v", e)
+	}
+
+	if len(e.Addresses) != 2 || e.Addresses[0] != "192.168.1.10/24" {
+		t.Fatalf("got invalid addresses: %v", e.Addresses)
+	}
+
+	if e.Gateway4 != "192.168.1.1" || e.Gateway6 != "2001:db8::1" {
+		t.Fatalf("got invalid gateways: %q, %q", e.Gateway4, e.Gateway6)
+	}
+
+	if len(e.Routes) != 1 || e.Routes[0].To != "10.0.0.0/8" || e.Routes[0].Metric != 3 {
+		t.Fatalf("got invalid routes: %+v", e.Routes)
+	}
+
+	if c.Ethernets["eth1"].Match != nil {
+		t.Fatalf("got unexpected match rules for eth1")
+	}
+
+	b := c.Bonds["bond0"]
+
+	if b == nil || len(b.Interfaces) != 2 || !b.DHCP4 || b.Parameters.Mode != "802.3ad" || b.Parameters.MiiMonitorInterval != 100 {
+		t.Fatalf("got invalid bond config: %+v", b)
+	}
+
+	v := c.Vlans["bond0.100"]
+
+	if v == nil || v.ID != 100 || v.Link != "bond0" {
+		t.Fatalf("got invalid vlan config: %+v", v)
+	}
+
+	if len(v.Routes) != 1 || v.Routes[0].To != "172.17.0.0/16" {
+		t.Fatalf("the global route is not bound to the vlan: %+v", v.Routes)
+	}
+
+	if c.Nameservers == nil || c.Nameservers.Addresses[0] != "8.8.8.8" || c.Nameservers.Search[0] != "example.com" {
+		t.Fatalf("got invalid global nameservers: %+v", c.Nameservers)
+	}
+}
+
+func TestParseNetworkConfigV1Errors(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{"version: 1\nconfig:\n  - type: infiniband\n    name: ib0", `unsupported entry type "infiniband"`},
+		{"version: 1\nconfig:\n  - type: physical\n    name: eth0\n    wakeonlan: true", `unsupported key "wakeonlan"`},
+		{"version: 1\nconfig:\n  - type: physical\n    name: eth0\n    subnets:\n      - type: static\n        address: 10.0.0.5", "no prefix length or netmask"},
+		{"version: 1\nconfig:\n  - type: physical\n    name: eth0\n    subnets:\n      - type: ipv4ll", `unsupported subnet type "ipv4ll"`},
+		{"version: 1\nconfig:\n  - type: bond\n    name: bond0\n    params:\n      bond-primary: eth0", `unsupported bond parameter "bond-primary"`},
+		{"version: 1\nconfig:\n  - type: physical\n    name: eth0\n  - type: physical\n    name: eth0", "duplicate device name"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseNetworkConfig([]byte(tt.data))
+		if err == nil {
+			t.Fatalf("expected an error for:\n%s", tt.data)
+		}
+
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Fatalf("got invalid error:\nwant:\t%q\ngot:\t%q", tt.want, err)
+		}
+	}
+}
+
+func TestParseNetworkConfigV1SkippedRoute(t *testing.T) {
+	data := `version: 1
+config:
+  - type: physical
+    name: eth0
+    subnets:
+      - type: dhcp
+  - type: route
+    destination: 10.0.0.0/8
+    gateway: 192.168.0.1
+`
+
+	c, err := ParseNetworkConfig([]byte(data))
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if len(c.Ethernets["eth0"].Routes) != 0 {
+		t.Fatalf("got unexpected routes: %+v", c.Ethernets["eth0"].Routes)
+	}
+
+	if len(c.Warnings) != 1 || !strings.Contains(c.Warnings[0], "skipping route to 10.0.0.0/8") {
+		t.Fatalf("got invalid warnings: %q", c.Warnings)
+	}
+}
@@ -0,0 +1,197 @@
+package cloudinit
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// Filesystem types of the datasource volumes
+const (
+	FSTypeISO9660 = "iso9660"
+	FSTypeVFAT    = "vfat"
+)
+
+// ProbeLabel returns the filesystem type and the label of the block device
+// if it contains an iso9660 or vfat filesystem. Otherwise empty strings
+// are returned.
+func ProbeLabel(device string) (string, string, error) {
+	fd, err := os.Open(device)
+	if err != nil {
+		return "", "", err
+	}
+	defer fd.Close()
+
+	return probeLabel(fd)
+}
+
+func probeLabel(r io.ReaderAt) (string, string, error) {
+	if label, ok, err := probeISO9660(r); err != nil {
+		return "", "", err
+	} else if ok {
+		return FSTypeISO9660, label, nil
+	}
+
+	if label, ok, err := probeVFAT(r); err != nil {
+		return "", "", err
+	} else if ok {
+		return FSTypeVFAT, label, nil
+	}
+
+	return "", "", nil
+}
+
+func probeISO9660(r io.ReaderAt) (string, bool, error) {
+	buf := make([]byte, 2048)
+
+	// The volume descriptor set starts at sector 16
+	for sector := int64(16); sector < 16+32; sector++ {
+		if _, err := r.ReadAt(buf, sector*2048); err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", false, nil
+			}
+			return "", false, err
+		}
+
+		if string(buf[1:6]) != "CD001" {
+			return "", false, nil
+		}
+
+		switch buf[0] {
+		case 1:
+			// Primary volume descriptor: the volume identifier is at offset 40
+			return strings.TrimRight(string(buf[40:72]), " \x00"), true, nil
+		case 255:
+			// Set terminator
+			return "", true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+func probeVFAT(r io.ReaderAt) (string, bool, error) {
+	buf := make([]byte, 512)
+
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		if errors.Is(err, io.EOF) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	if buf[510] != 0x55 || buf[511] != 0xAA {
+		return "", false, nil
+	}
+
+	var label string
+	var fat32 bool
+
+	// The label is stored in the extended BIOS parameter block
+	// whose offset depends on the FAT type
+	switch {
+	case string(buf[0x52:0x57]) == "FAT32":
+		fat32 = true
+		if buf[0x42] == 0x29 {
+			label = string(buf[0x47:0x52])
+		}
+	case string(buf[0x36:0x39]) == "FAT":
+		if buf[0x26] == 0x29 {
+			label = string(buf[0x2B:0x36])
+		}
+	default:
+		return "", false, nil
+	}
+
+	label = trimVFATLabel(label)
+
+	// Some tools set only the volume label entry of the root directory
+	if label == "" {
+		var err error
+
+		if label, err = probeVFATRootLabel(r, buf, fat32); err != nil {
+			return "", false, err
+		}
+	}
+
+	return label, true, nil
+}
+
+// probeVFATRootLabel returns the label stored in the volume label entry
+// of the root directory. Only the first cluster of the FAT32 root directory
+// is searched, the label entry is normally the first one.
+func probeVFATRootLabel(r io.ReaderAt, bootSector []byte, fat32 bool) (string, error) {
+	bytesPerSector := int64(binary.LittleEndian.Uint16(bootSector[0x0B:]))
+	sectorsPerCluster := int64(bootSector[0x0D])
+	reservedSectors := int64(binary.LittleEndian.Uint16(bootSector[0x0E:]))
+	numFATs := int64(bootSector[0x10])
+	rootEntries := int64(binary.LittleEndian.Uint16(bootSector[0x11:]))
+	fatSize := int64(binary.LittleEndian.Uint16(bootSector[0x16:]))
+
+	if bytesPerSector < 512 || bytesPerSector > 4096 || bytesPerSector&(bytesPerSector-1) != 0 {
+		return "", nil
+	}
+
+	var offset, size int64
+
+	if fat32 {
+		fatSize = int64(binary.LittleEndian.Uint32(bootSector[0x24:]))
+		rootCluster := int64(binary.LittleEndian.Uint32(bootSector[0x2C:]))
+
+		if rootCluster < 2 || sectorsPerCluster == 0 {
+			return "", nil
+		}
+
+		dataStart := reservedSectors + numFATs*fatSize
+		offset = (dataStart + (rootCluster-2)*sectorsPerCluster) * bytesPerSector
+		size = sectorsPerCluster * bytesPerSector
+	} else {
+		offset = (reservedSectors + numFATs*fatSize) * bytesPerSector
+		size = rootEntries * 32
+	}
+
+	if size == 0 {
+		return "", nil
+	}
+
+	dir := make([]byte, size)
+
+	if _, err := r.ReadAt(dir, offset); err != nil {
+		if errors.Is(err, io.EOF) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	for i := 0; i+32 <= len(dir); i += 32 {
+		entry := dir[i : i+32]
+
+		switch {
+		case entry[0] == 0x00:
+			// End of the directory
+			return "", nil
+		case entry[0] == 0xE5:
+			// Deleted entry
+			continue
+		case entry[11]&0x0F == 0x0F:
+			// Long file name entry
+			continue
+		case entry[11]&0x08 != 0:
+			return trimVFATLabel(string(entry[0:11])), nil
+		}
+	}
+
+	return "", nil
+}
+
+func trimVFATLabel(label string) string {
+	label = strings.TrimRight(label, " \x00")
+
+	if label == "NO NAME" {
+		return ""
+	}
+
+	return label
+}
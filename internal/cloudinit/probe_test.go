@@ -0,0 +1,86 @@
+package cloudinit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestProbeLabel(t *testing.T) {
+	// iso9660
+	iso := make([]byte, 18*2048)
+
+	pvd := iso[16*2048:]
+	pvd[0] = 1
+	copy(pvd[1:6], "CD001")
+	copy(pvd[40:72], "cidata                          ")
+
+	term := iso[17*2048:]
+	term[0] = 255
+	copy(term[1:6], "CD001")
+
+	// FAT16
+	fat16 := make([]byte, 1024)
+	fat16[0x26] = 0x29
+	copy(fat16[0x2B:0x36], "CIDATA     ")
+	copy(fat16[0x36:0x3E], "FAT16   ")
+	fat16[510], fat16[511] = 0x55, 0xAA
+
+	// FAT32
+	fat32 := make([]byte, 1024)
+	fat32[0x42] = 0x29
+	copy(fat32[0x47:0x52], "CONFIG-2   ")
+	copy(fat32[0x52:0x5A], "FAT32   ")
+	fat32[510], fat32[511] = 0x55, 0xAA
+
+	// FAT32 without label
+	noname := make([]byte, 1024)
+	copy(noname, fat32)
+	copy(noname[0x47:0x52], "NO NAME    ")
+
+	// FAT16 with the label only in the root directory
+	rootLabel := make([]byte, 8192)
+	binary.LittleEndian.PutUint16(rootLabel[0x0B:], 512)
+	rootLabel[0x0D] = 4
+	binary.LittleEndian.PutUint16(rootLabel[0x0E:], 1)
+	rootLabel[0x10] = 2
+	binary.LittleEndian.PutUint16(rootLabel[0x11:], 16)
+	binary.LittleEndian.PutUint16(rootLabel[0x16:], 2)
+	rootLabel[0x26] = 0x29
+	copy(rootLabel[0x2B:0x36], "NO NAME    ")
+	copy(rootLabel[0x36:0x3E], "FAT16   ")
+	rootLabel[510], rootLabel[511] = 0x55, 0xAA
+
+	// The root directory starts after the reserved sector and two FATs
+	root := rootLabel[5*512:]
+	copy(root[0:11], "DELETED TXT")
+	root[0] = 0xE5
+	copy(root[32:43], "CIDATA     ")
+	root[32+11] = 0x08
+
+	tests := []struct {
+		name   string
+		data   []byte
+		fstype string
+		label  string
+	}{
+		{"iso9660", iso, FSTypeISO9660, "cidata"},
+		{"fat16", fat16, FSTypeVFAT, "CIDATA"},
+		{"fat32", fat32, FSTypeVFAT, "CONFIG-2"},
+		{"noname", noname, FSTypeVFAT, ""},
+		{"rootlabel", rootLabel, FSTypeVFAT, "CIDATA"},
+		{"empty", make([]byte, 4096), "", ""},
+		{"short", make([]byte, 100), "", ""},
+	}
+
+	for _, tt := range tests {
+		fstype, label, err := probeLabel(bytes.NewReader(tt.data))
+		if err != nil {
+			t.Fatalf("%s: got unexpected error: %v", tt.name, err)
+		}
+
+		if fstype != tt.fstype || label != tt.label {
+			t.Fatalf("%s: got invalid result:\nwant:\t%q, %q\ngot:\t%q, %q", tt.name, tt.fstype, tt.label, fstype, label)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+type MetaData struct {
+	InstanceID    string `yaml:"instance-id"`
+	LocalHostname string `yaml:"local-hostname"`
+}
+
+// ParseMetaData parses the NoCloud meta-data.
+func ParseMetaData(b []byte) (*MetaData, error) {
+	md := MetaData{}
+
+	if err := yaml.Unmarshal(b, &md); err != nil {
+		return nil, fmt.Errorf("invalid meta-data: %w", err)
+	}
+
+	return &md, nil
+}
+
+// parseConfigDriveMetaData parses the OpenStack meta_data.json.
+func parseConfigDriveMetaData(b []byte) (*MetaData, error) {
+	var v struct {
+		UUID     string `json:"uuid"`
+		Hostname string `json:"hostname"`
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("invalid meta_data.json: %w", err)
+	}
+
+	return &MetaData{InstanceID: v.UUID, LocalHostname: v.Hostname}, nil
+}
+
+// CloudConfig is the subset of the cloud-config user-data
+// that can be applied by the agent.
+type CloudConfig struct {
+	Hostname         string             `yaml:"hostname"`
+	FQDN             string             `yaml:"fqdn"`
+	PreserveHostname bool               `yaml:"preserve_hostname"`
+	Users            []*UserConfig      `yaml:"users"`
+	WriteFiles       []*WriteFileConfig `yaml:"write_files"`
+	RunCmd           []*RunCommand      `yaml:"runcmd"`
+
+	// The top-level keys that are not supported and therefore ignored
+	IgnoredKeys []string `yaml:"-"`
+}
+
+var cloudConfigKeys = []string{"hostname", "fqdn", "preserve_hostname", "users", "write_files", "runcmd"}
+
+type UserConfig struct {
+	Name              string    `yaml:"name"`
+	Gecos             string    `yaml:"gecos"`
+	HomeDir           string    `yaml:"homedir"`
+	Shell             string    `yaml:"shell"`
+	Groups            commaList `yaml:"groups"`
+	Sudo              sudoRules `yaml:"sudo"`
+	SSHAuthorizedKeys []string  `yaml:"ssh_authorized_keys"`
+
+	// Set for the "default" entry which refers to the distribution default user
+	Default bool `yaml:"-"`
+}
+
+func (u *UserConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+
+	if err := unmarshal(&name); err == nil {
+		u.Name = name
+		u.Default = name == "default"
+
+		return nil
+	}
+
+	type plain UserConfig
+
+	return unmarshal((*plain)(u))
+}
+
+type WriteFileConfig struct {
+	Path        string      `yaml:"path"`
+	Content     string      `yaml:"content"`
+	Encoding    string      `yaml:"encoding"`
+	Owner       string      `yaml:"owner"`
+	Permissions interface{} `yaml:"permissions"`
+	Append      bool        `yaml:"append"`
+	Defer       bool        `yaml:"defer"`
+}
+
+// Data returns the decoded file content.
+func (f *WriteFileConfig) Data() ([]byte, error) {
+	var b []byte
+
+	switch strings.ToLower(f.Encoding) {
+	case "", "text/plain":
+		return []byte(f.Content), nil
+	case "b64", "base64", "gz+b64", "gz+base64", "gzip+b64", "gzip+base64":
+		x, err := base64.StdEncoding.DecodeString(strings.TrimSpace(f.Content))
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 content: %w", err)
+		}
+		b = x
+	case "gz", "gzip":
+		b = []byte(f.Content)
+	default:
+		return nil, fmt.Errorf("unsupported encoding: %s", f.Encoding)
+	}
+
+	if strings.HasPrefix(strings.ToLower(f.Encoding), "gz") {
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip content: %w", err)
+		}
+		defer r.Close()
+
+		if b, err = io.ReadAll(r); err != nil {
+			return nil, fmt.Errorf("invalid gzip content: %w", err)
+		}
+	}
+
+	return b, nil
+}
+
+// Mode returns the file permissions, 0644 by default.
+func (f *WriteFileConfig) Mode() (uint32, error) {
+	switch v := f.Permissions.(type) {
+	case nil:
+		return 0644, nil
+	case int:
+		// An unquoted octal number, e.g. 0644, is already decoded by the YAML parser
+		return uint32(v), nil
+	case string:
+		n, err := strconv.ParseUint(v, 8, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid permissions: %s", v)
+		}
+		return uint32(n), nil
+	}
+
+	return 0, fmt.Errorf("invalid permissions: %v", f.Permissions)
+}
+
+// RunCommand is a runcmd entry: either a shell command line
+// or a list of program arguments.
+type RunCommand struct {
+	Shell string
+	Args  []string
+}
+
+func (c *RunCommand) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&c.Shell); err == nil {
+		return nil
+	}
+
+	return unmarshal(&c.Args)
+}
+
+func (c *RunCommand) String() string {
+	if len(c.Args) > 0 {
+		return strings.Join(c.Args, " ")
+	}
+
+	return c.Shell
+}
+
+// commaList is a list of strings that can also be specified
+// as a single comma-separated string.
+type commaList []string
+
+func (l *commaList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+
+	if err := unmarshal(&s); err == nil {
+		*l = nil
+
+		for _, x := range strings.Split(s, ",") {
+			if x = strings.TrimSpace(x); len(x) > 0 {
+				*l = append(*l, x)
+			}
+		}
+
+		return nil
+	}
+
+	var v []string
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	*l = v
+
+	return nil
+}
+
+// sudoRules is a list of sudoers rules that can also be specified
+// as a single string. The false value means no rules.
+type sudoRules []string
+
+func (r *sudoRules) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var b bool
+
+	if err := unmarshal(&b); err == nil {
+		if b {
+			return fmt.Errorf("sudo: true is not a valid sudoers rule")
+		}
+
+		*r = nil
+
+		return nil
+	}
+
+	var s string
+
+	if err := unmarshal(&s); err == nil {
+		*r = []string{s}
+
+		return nil
+	}
+
+	var v []string
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	*r = v
+
+	return nil
+}
+
+// IsCloudConfig reports whether the user-data is in the cloud-config format.
+func IsCloudConfig(b []byte) bool {
+	return bytes.HasPrefix(b, []byte("#cloud-config"))
+}
+
+// ParseCloudConfig parses the cloud-config user-data. The unsupported
+// top-level keys are not an error, they are listed in IgnoredKeys.
+func ParseCloudConfig(b []byte) (*CloudConfig, error) {
+	if !IsCloudConfig(b) {
+		return nil, fmt.Errorf("user-data is not in the cloud-config format")
+	}
+
+	cc := CloudConfig{}
+
+	if err := yaml.Unmarshal(b, &cc); err != nil {
+		return nil, fmt.Errorf("invalid user-data: %w", err)
+	}
+
+	var top map[string]interface{}
+
+	if err := yaml.Unmarshal(b, &top); err != nil {
+		return nil, fmt.Errorf("invalid user-data: %w", err)
+	}
+
+	for k := range top {
+		known := false
+
+		for _, x := range cloudConfigKeys {
+			if k == x {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			cc.IgnoredKeys = append(cc.IgnoredKeys, k)
+		}
+	}
+
+	sort.Strings(cc.IgnoredKeys)
+
+	for idx, u := range cc.Users {
+		if u == nil || len(u.Name) == 0 {
+			return nil, fmt.Errorf("users[%d]: name is not specified", idx)
+		}
+	}
+
+	for idx, f := range cc.WriteFiles {
+		if f == nil || len(f.Path) == 0 {
+			return nil, fmt.Errorf("write_files[%d]: path is not specified", idx)
+		}
+
+		if _, err := f.Mode(); err != nil {
+			return nil, fmt.Errorf("write_files[%d]: %w", idx, err)
+		}
+	}
+
+	for idx, c := range cc.RunCmd {
+		if c == nil || (len(c.Shell) == 0 && len(c.Args) == 0) {
+			return nil, fmt.Errorf("runcmd[%d]: empty command", idx)
+		}
+	}
+
+	return &cc, nil
+}
@@ -0,0 +1,84 @@
+package cloudinit
+
+import (
+	"testing"
+)
+
+func TestParseCloudConfig(t *testing.T) {
+	data := []byte(`#cloud-config
+hostname: web01
+fqdn: web01.example.com
+packages: [nginx]
+users:
+  - default
+  - name: deploy
+    gecos: Deploy User
+    groups: wheel, adm
+    sudo: ALL=(ALL) NOPASSWD:ALL
+    ssh_authorized_keys:
+      - ssh-ed25519 AAAAC3Nza deploy@example
+write_files:
+  - path: /etc/motd
+    content: aGVsbG8K
+    encoding: b64
+    permissions: '0600'
+  - path: /etc/app.conf
+    content: "x = 1\n"
+    permissions: 0640
+    owner: deploy:deploy
+runcmd:
+  - echo hello > /tmp/hello
+  - [systemctl, restart, nginx]
+`)
+
+	cc, err := ParseCloudConfig(data)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if cc.Hostname != "web01" || cc.FQDN != "web01.example.com" {
+		t.Fatalf("got invalid hostname/fqdn: %q, %q", cc.Hostname, cc.FQDN)
+	}
+
+	if len(cc.IgnoredKeys) != 1 || cc.IgnoredKeys[0] != "packages" {
+		t.Fatalf("got invalid ignored keys: %v", cc.IgnoredKeys)
+	}
+
+	if len(cc.Users) != 2 || !cc.Users[0].Default {
+		t.Fatalf("got invalid users: %+v", cc.Users)
+	}
+
+	u := cc.Users[1]
+
+	if u.Name != "deploy" || len(u.Groups) != 2 || u.Groups[1] != "adm" || len(u.Sudo) != 1 || len(u.SSHAuthorizedKeys) != 1 {
+		t.Fatalf("got invalid user: %+v", u)
+	}
+
+	if b, err := cc.WriteFiles[0].Data(); err != nil || string(b) != "hello\n" {
+		t.Fatalf("got invalid file data: %q, %v", b, err)
+	}
+
+	for idx, want := range []uint32{0600, 0640} {
+		if mode, err := cc.WriteFiles[idx].Mode(); err != nil || mode != want {
+			t.Fatalf("got invalid file mode:\nwant:\t%04o\ngot:\t%04o (%v)", want, mode, err)
+		}
+	}
+
+	if len(cc.RunCmd) != 2 || cc.RunCmd[0].Shell != "echo hello > /tmp/hello" || len(cc.RunCmd[1].Args) != 3 {
+		t.Fatalf("got invalid runcmd: %+v, %+v", cc.RunCmd[0], cc.RunCmd[1])
+	}
+
+	// bad values tests
+
+	if _, err := ParseCloudConfig([]byte("#!/bin/sh\necho hello")); err == nil {
+		t.Fatalf("expected an error for shell script, got nil")
+	}
+
+	if _, err := ParseCloudConfig([]byte("#cloud-config\nwrite_files:\n  - content: x")); err == nil {
+		t.Fatalf("expected an error for missing path, got nil")
+	}
+
+	if _, err := ParseCloudConfig([]byte("#cloud-config\nwrite_files:\n  - path: /x\n    permissions: rw")); err == nil {
+		t.Fatalf("expected an error for invalid permissions, got nil")
+	}
+}
@@ -0,0 +1,156 @@
+package cryptsetup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+var ErrNotInstalled = errors.New("cryptsetup is not installed")
+
+// Error describes a failed cryptsetup command.
+type Error struct {
+	Command  string
+	ExitCode int
+	Stderr   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("cryptsetup %s failed (exit status %d): %s", e.Command, e.ExitCode, e.Stderr)
+}
+
+type Status struct {
+	Name     string
+	IsActive bool
+	Type     string
+	Cipher   string
+	KeySize  uint32
+	Device   string
+	ReadOnly bool
+}
+
+// Open unlocks the LUKS device and maps it as /dev/mapper/<name>.
+// The key is passed to cryptsetup through a pipe, so it is never
+// written to any file.
+func Open(ctx context.Context, device, name string, key []byte, readOnly bool) error {
+	args := []string{"--type", "luks", "--key-file", "-"}
+
+	if readOnly {
+		args = append(args, "--readonly")
+	}
+
+	args = append(args, device, name)
+
+	_, err := run(ctx, bytes.NewReader(key), "open", args...)
+
+	return err
+}
+
+func Close(ctx context.Context, name string) error {
+	_, err := run(ctx, nil, "close", name)
+
+	return err
+}
+
+func GetStatus(ctx context.Context, name string) (*Status, error) {
+	out, err := run(ctx, nil, "status", name)
+	if err != nil {
+		var csErr *Error
+
+		// Exit code 4 means that the device is inactive or does not exist
+		if errors.As(err, &csErr) && csErr.ExitCode == 4 {
+			return &Status{Name: name}, nil
+		}
+
+		return nil, err
+	}
+
+	return parseStatus(name, out)
+}
+
+func parseStatus(name string, data []byte) (*Status, error) {
+	st := Status{
+		Name: name,
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// /dev/mapper/data is active and is in use.
+		if strings.HasPrefix(line, "/") {
+			st.IsActive = strings.Contains(line, " is active")
+
+			continue
+		}
+
+		//   keysize: 512 bits
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		value := strings.TrimSpace(parts[1])
+
+		switch strings.TrimSpace(parts[0]) {
+		case "type":
+			st.Type = value
+		case "cipher":
+			st.Cipher = value
+		case "keysize":
+			if v, err := strconv.ParseUint(strings.TrimSuffix(value, " bits"), 10, 32); err == nil {
+				st.KeySize = uint32(v)
+			} else {
+				return nil, fmt.Errorf("invalid keysize value: %q", value)
+			}
+		case "device":
+			st.Device = value
+		case "mode":
+			st.ReadOnly = value == "readonly"
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &st, nil
+}
+
+func run(ctx context.Context, stdin io.Reader, command string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "cryptsetup", append([]string{command}, args...)...)
+
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	cmd.Stdin = stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+
+		switch {
+		case errors.As(err, &exitErr):
+			return nil, &Error{
+				Command:  command,
+				ExitCode: exitErr.ExitCode(),
+				Stderr:   strings.TrimSpace(stderr.String()),
+			}
+		case errors.Is(err, exec.ErrNotFound):
+			return nil, ErrNotInstalled
+		}
+
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
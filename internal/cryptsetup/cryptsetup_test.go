@@ -0,0 +1,44 @@
+package cryptsetup
+
+import (
+	"testing"
+)
+
+func TestParseStatus(t *testing.T) {
+	data := []byte(`/dev/mapper/data is active and is in use.
+  type:    LUKS2
+  cipher:  aes-xts-plain64
+  keysize: 512 bits
+  key location: keyring
+  device:  /dev/vdb
+  sector size:  512
+  offset:  32768 sectors
+  size:    20938752 sectors
+  mode:    readonly
+`)
+
+	got, err := parseStatus("data", data)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	want := Status{
+		Name:     "data",
+		IsActive: true,
+		Type:     "LUKS2",
+		Cipher:   "aes-xts-plain64",
+		KeySize:  512,
+		Device:   "/dev/vdb",
+		ReadOnly: true,
+	}
+
+	if *got != want {
+		t.Fatalf("got invalid result:\nwant:\t%+v\ngot:\t%+v", want, *got)
+	}
+
+	// bad values tests
+
+	if _, err := parseStatus("data", []byte("  keysize: many bits\n")); err == nil {
+		t.Fatalf("expected an error for invalid keysize, got nil")
+	}
+}
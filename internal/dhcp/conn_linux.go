@@ -0,0 +1,58 @@
+package dhcp
+
+import (
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// listenUDP returns a UDP socket bound to the network interface.
+// It allows to send and receive the DHCP messages when there is
+// no IP address configured on the interface.
+func listenUDP(family int, ifname string, port int) (net.PacketConn, error) {
+	domain := unix.AF_INET
+
+	if family == 6 {
+		domain = unix.AF_INET6
+	}
+
+	fd, err := unix.Socket(domain, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, unix.IPPROTO_UDP)
+	if err != nil {
+		return nil, os.NewSyscallError("socket", err)
+	}
+
+	f := os.NewFile(uintptr(fd), "dhcp")
+	defer f.Close()
+
+	if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_REUSEADDR, 1); err != nil {
+		return nil, os.NewSyscallError("setsockopt", err)
+	}
+
+	if err := unix.SetsockoptString(fd, unix.SOL_SOCKET, unix.SO_BINDTODEVICE, ifname); err != nil {
+		return nil, os.NewSyscallError("setsockopt", err)
+	}
+
+	var sa unix.Sockaddr
+
+	if family == 6 {
+		if err := unix.SetsockoptInt(fd, unix.IPPROTO_IPV6, unix.IPV6_V6ONLY, 1); err != nil {
+			return nil, os.NewSyscallError("setsockopt", err)
+		}
+
+		sa = &unix.SockaddrInet6{Port: port}
+	} else {
+		if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_BROADCAST, 1); err != nil {
+			return nil, os.NewSyscallError("setsockopt", err)
+		}
+
+		sa = &unix.SockaddrInet4{Port: port}
+	}
+
+	if err := unix.Bind(fd, sa); err != nil {
+		return nil, os.NewSyscallError("bind", err)
+	}
+
+	// Duplicates the descriptor, so f can be closed
+	return net.FilePacketConn(f)
+}
@@ -0,0 +1,226 @@
+package dhcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	ErrNAK          = errors.New("DHCP server declined the request")
+	ErrNoAddrsAvail = errors.New("DHCP server has no addresses available")
+)
+
+// Lease describes the IPv4 or IPv6 address obtained from a DHCP server
+// together with the network parameters.
+type Lease struct {
+	Family    int    `json:"family"` // 4 or 6
+	Interface string `json:"interface"`
+	HwAddr    string `json:"hwaddr"`
+
+	Addr    string   `json:"addr"` // CIDR notation
+	Routers []string `json:"routers,omitempty"`
+	Routes  []*Route `json:"routes,omitempty"`
+	DNS     []string `json:"dns,omitempty"`
+	Search  []string `json:"search,omitempty"`
+	MTU     int      `json:"mtu,omitempty"`
+
+	// IPv4 address of the server or its hex-encoded DUID for DHCPv6
+	ServerID string `json:"server_id"`
+	IAID     uint32 `json:"iaid,omitempty"`
+
+	Obtained  time.Time     `json:"obtained"`
+	LeaseTime time.Duration `json:"lease_time"`
+	T1        time.Duration `json:"t1"`
+	T2        time.Duration `json:"t2"`
+}
+
+// Route is a classless static route (DHCPv4 option 121).
+type Route struct {
+	Dst string `json:"dst"`
+	Gw  string `json:"gw"`
+}
+
+func (l *Lease) RenewTime() time.Time {
+	return l.Obtained.Add(l.T1)
+}
+
+func (l *Lease) RebindTime() time.Time {
+	return l.Obtained.Add(l.T2)
+}
+
+func (l *Lease) ExpireTime() time.Time {
+	return l.Obtained.Add(l.LeaseTime)
+}
+
+// setTimers sets the default values of T1 and T2 as recommended by RFC 2131.
+func (l *Lease) setTimers() {
+	if l.T1 <= 0 || l.T1 > l.LeaseTime {
+		l.T1 = l.LeaseTime / 2
+	}
+
+	if l.T2 <= 0 || l.T2 > l.LeaseTime || l.T2 < l.T1 {
+		l.T2 = l.LeaseTime * 7 / 8
+	}
+}
+
+// Acquire obtains a new lease for the interface.
+func Acquire(ctx context.Context, family int, ifname string) (*Lease, error) {
+	iface, err := net.InterfaceByName(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(iface.HardwareAddr) != 6 {
+		return nil, fmt.Errorf("unsupported hardware address: %s", iface.HardwareAddr)
+	}
+
+	switch family {
+	case 4:
+		return acquire4(ctx, iface)
+	case 6:
+		return acquire6(ctx, iface)
+	}
+
+	return nil, fmt.Errorf("invalid address family: %d", family)
+}
+
+// Renew extends the lease by contacting the server that granted it.
+func Renew(ctx context.Context, l *Lease) (*Lease, error) {
+	return extend(ctx, l, false)
+}
+
+// Rebind extends the lease by contacting any available server.
+func Rebind(ctx context.Context, l *Lease) (*Lease, error) {
+	return extend(ctx, l, true)
+}
+
+func extend(ctx context.Context, l *Lease, rebind bool) (*Lease, error) {
+	iface, err := net.InterfaceByName(l.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	switch l.Family {
+	case 4:
+		return extend4(ctx, iface, l, rebind)
+	case 6:
+		return extend6(ctx, iface, l, rebind)
+	}
+
+	return nil, fmt.Errorf("invalid address family: %d", l.Family)
+}
+
+// Release gives the leased address back to the server.
+func Release(l *Lease) error {
+	iface, err := net.InterfaceByName(l.Interface)
+	if err != nil {
+		return err
+	}
+
+	switch l.Family {
+	case 4:
+		return release4(iface, l)
+	case 6:
+		return release6(iface, l)
+	}
+
+	return fmt.Errorf("invalid address family: %d", l.Family)
+}
+
+// LeasePath returns the path of the lease file in the directory.
+func LeasePath(dir, ifname string, family int) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.v%d.lease", ifname, family))
+}
+
+// SaveLease atomically writes the lease to the file in the directory.
+func SaveLease(dir string, l *Lease) error {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fname := LeasePath(dir, l.Interface, l.Family)
+
+	if err := os.WriteFile(fname+".tmp", b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(fname+".tmp", fname)
+}
+
+func LoadLease(fname string) (*Lease, error) {
+	b, err := os.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+
+	l := Lease{}
+
+	if err := json.Unmarshal(b, &l); err != nil {
+		return nil, fmt.Errorf("invalid lease file %s: %w", fname, err)
+	}
+
+	return &l, nil
+}
+
+// exchange sends the request and waits for a reply accepted by the match
+// function. The request is retransmitted with exponential backoff until
+// the context is done.
+func exchange(ctx context.Context, conn net.PacketConn, dst net.Addr, req []byte, timeout time.Duration, match func([]byte) bool) ([]byte, error) {
+	// Interrupt the blocking read when the context is done
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetReadDeadline(time.Now())
+	})
+	defer stop()
+
+	buf := make([]byte, 65536)
+
+	var lastErr error
+
+	for {
+		if _, err := conn.WriteTo(req, dst); err != nil {
+			// Can be a temporary error, e.g. the link-local
+			// address is still tentative. So just try again.
+			lastErr = err
+		}
+
+		conn.SetReadDeadline(time.Now().Add(timeout))
+
+		for {
+			n, _, err := conn.ReadFrom(buf)
+			if err != nil {
+				if errors.Is(err, os.ErrDeadlineExceeded) {
+					break
+				}
+
+				return nil, err
+			}
+
+			if match(buf[:n]) {
+				return append([]byte(nil), buf[:n]...), nil
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			if lastErr != nil {
+				return nil, fmt.Errorf("%w (last error: %s)", err, lastErr)
+			}
+
+			return nil, err
+		}
+
+		if timeout *= 2; timeout > 64*time.Second {
+			timeout = 64 * time.Second
+		}
+	}
+}
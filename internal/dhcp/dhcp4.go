@@ -0,0 +1,535 @@
+package dhcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	msgDiscover = 1
+	msgOffer    = 2
+	msgRequest  = 3
+	msgDecline  = 4
+	msgAck      = 5
+	msgNak      = 6
+	msgRelease  = 7
+)
+
+const (
+	optSubnetMask      = 1
+	optRouter          = 3
+	optDNS             = 6
+	optHostname        = 12
+	optDomainName      = 15
+	optInterfaceMTU    = 26
+	optRequestedIP     = 50
+	optLeaseTime       = 51
+	optMessageType     = 53
+	optServerID        = 54
+	optParamRequest    = 55
+	optMaxMessageSize  = 57
+	optRenewalTime     = 58
+	optRebindingTime   = 59
+	optClientID        = 61
+	optClasslessRoutes = 121
+	optEnd             = 255
+	optPad             = 0
+)
+
+var magicCookie = []byte{99, 130, 83, 99}
+
+// The parameters requested from the server
+var paramRequestList = []byte{
+	optSubnetMask,
+	optRouter,
+	optDNS,
+	optDomainName,
+	optInterfaceMTU,
+	optLeaseTime,
+	optServerID,
+	optRenewalTime,
+	optRebindingTime,
+	optClasslessRoutes,
+}
+
+type option4 struct {
+	Code byte
+	Data []byte
+}
+
+// message4 is a DHCPv4 message as defined in RFC 2131.
+type message4 struct {
+	Op      byte
+	XID     uint32
+	Secs    uint16
+	Flags   uint16
+	CIAddr  net.IP
+	YIAddr  net.IP
+	SIAddr  net.IP
+	GIAddr  net.IP
+	CHAddr  net.HardwareAddr
+	Options []option4
+}
+
+func newMessage4(msgtype byte, xid uint32, hwaddr net.HardwareAddr) *message4 {
+	return &message4{
+		Op:      1, // BOOTREQUEST
+		XID:     xid,
+		CHAddr:  hwaddr,
+		Options: []option4{{optMessageType, []byte{msgtype}}},
+	}
+}
+
+func (m *message4) addOption(code byte, data []byte) {
+	m.Options = append(m.Options, option4{code, data})
+}
+
+func (m *message4) option(code byte) []byte {
+	for _, o := range m.Options {
+		if o.Code == code {
+			return o.Data
+		}
+	}
+
+	return nil
+}
+
+func (m *message4) msgType() byte {
+	if v := m.option(optMessageType); len(v) == 1 {
+		return v[0]
+	}
+
+	return 0
+}
+
+func (m *message4) marshal() []byte {
+	b := make([]byte, 240, 300)
+
+	b[0] = m.Op
+	b[1] = 1 // Ethernet
+	b[2] = byte(len(m.CHAddr))
+
+	binary.BigEndian.PutUint32(b[4:8], m.XID)
+	binary.BigEndian.PutUint16(b[8:10], m.Secs)
+	binary.BigEndian.PutUint16(b[10:12], m.Flags)
+
+	for idx, ip := range []net.IP{m.CIAddr, m.YIAddr, m.SIAddr, m.GIAddr} {
+		if ip4 := ip.To4(); ip4 != nil {
+			copy(b[12+idx*4:], ip4)
+		}
+	}
+
+	copy(b[28:44], m.CHAddr)
+	copy(b[236:240], magicCookie)
+
+	for _, o := range m.Options {
+		// Long values are split into several options (RFC 3396)
+		data := o.Data
+
+		for {
+			n := len(data)
+			if n > 255 {
+				n = 255
+			}
+
+			b = append(b, o.Code, byte(n))
+			b = append(b, data[:n]...)
+
+			if data = data[n:]; len(data) == 0 {
+				break
+			}
+		}
+	}
+
+	b = append(b, optEnd)
+
+	// Some servers ignore the messages shorter than the minimum BOOTP size
+	for len(b) < 300 {
+		b = append(b, optPad)
+	}
+
+	return b
+}
+
+func parseMessage4(b []byte) (*message4, error) {
+	if len(b) < 240 {
+		return nil, fmt.Errorf("message is too short: %d bytes", len(b))
+	}
+
+	if !bytes.Equal(b[236:240], magicCookie) {
+		return nil, fmt.Errorf("invalid magic cookie")
+	}
+
+	hlen := int(b[2])
+	if hlen > 16 {
+		return nil, fmt.Errorf("invalid hardware address length: %d", hlen)
+	}
+
+	m := message4{
+		Op:     b[0],
+		XID:    binary.BigEndian.Uint32(b[4:8]),
+		Secs:   binary.BigEndian.Uint16(b[8:10]),
+		Flags:  binary.BigEndian.Uint16(b[10:12]),
+		CIAddr: net.IP(append([]byte(nil), b[12:16]...)),
+		YIAddr: net.IP(append([]byte(nil), b[16:20]...)),
+		SIAddr: net.IP(append([]byte(nil), b[20:24]...)),
+		GIAddr: net.IP(append([]byte(nil), b[24:28]...)),
+		CHAddr: net.HardwareAddr(append([]byte(nil), b[28:28+hlen]...)),
+	}
+
+	opts := b[240:]
+
+	for len(opts) > 0 {
+		code := opts[0]
+
+		if code == optEnd {
+			break
+		}
+
+		if code == optPad {
+			opts = opts[1:]
+			continue
+		}
+
+		if len(opts) < 2 || len(opts) < 2+int(opts[1]) {
+			return nil, fmt.Errorf("option %d is truncated", code)
+		}
+
+		data := opts[2 : 2+int(opts[1])]
+
+		// Concatenate the split options (RFC 3396)
+		found := false
+
+		for idx := range m.Options {
+			if m.Options[idx].Code == code {
+				m.Options[idx].Data = append(m.Options[idx].Data, data...)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			m.addOption(code, append([]byte(nil), data...))
+		}
+
+		opts = opts[2+int(opts[1]):]
+	}
+
+	return &m, nil
+}
+
+// lease returns the lease described by the DHCPACK message.
+func (m *message4) lease() (*Lease, error) {
+	ip := m.YIAddr.To4()
+	if ip == nil || ip.IsUnspecified() {
+		return nil, fmt.Errorf("no address in the DHCPACK message")
+	}
+
+	mask := ip.DefaultMask()
+
+	if v := m.option(optSubnetMask); len(v) == 4 {
+		mask = net.IPMask(v)
+	}
+
+	ones, bits := mask.Size()
+	if bits == 0 {
+		return nil, fmt.Errorf("invalid subnet mask: %s", net.IP(mask))
+	}
+
+	l := Lease{
+		Family: 4,
+		HwAddr: m.CHAddr.String(),
+		Addr:   fmt.Sprintf("%s/%d", ip, ones),
+	}
+
+	if v := m.option(optServerID); len(v) == 4 {
+		l.ServerID = net.IP(v).String()
+	} else {
+		return nil, fmt.Errorf("no server identifier in the DHCPACK message")
+	}
+
+	l.Routers = ipList(m.option(optRouter), 4)
+	l.DNS = ipList(m.option(optDNS), 4)
+
+	if v := m.option(optDomainName); len(v) > 0 {
+		l.Search = strings.Fields(strings.TrimRight(string(v), "\x00"))
+	}
+
+	if v := m.option(optInterfaceMTU); len(v) == 2 {
+		// The minimum legal value is 68 (RFC 2132)
+		if n := int(binary.BigEndian.Uint16(v)); n >= 68 {
+			l.MTU = n
+		}
+	}
+
+	if v := m.option(optClasslessRoutes); len(v) > 0 {
+		routes, err := parseClasslessRoutes(v)
+		if err != nil {
+			return nil, err
+		}
+
+		l.Routes = routes
+	}
+
+	if v := m.option(optLeaseTime); len(v) == 4 {
+		l.LeaseTime = secondsToDuration(binary.BigEndian.Uint32(v))
+	} else {
+		return nil, fmt.Errorf("no lease time in the DHCPACK message")
+	}
+
+	if v := m.option(optRenewalTime); len(v) == 4 {
+		l.T1 = secondsToDuration(binary.BigEndian.Uint32(v))
+	}
+
+	if v := m.option(optRebindingTime); len(v) == 4 {
+		l.T2 = secondsToDuration(binary.BigEndian.Uint32(v))
+	}
+
+	l.setTimers()
+
+	return &l, nil
+}
+
+// parseClasslessRoutes parses the classless static route option (RFC 3442).
+func parseClasslessRoutes(b []byte) ([]*Route, error) {
+	var routes []*Route
+
+	for len(b) > 0 {
+		width := int(b[0])
+		if width > 32 {
+			return nil, fmt.Errorf("invalid classless route: prefix length %d", width)
+		}
+
+		n := (width + 7) / 8
+
+		if len(b) < 1+n+4 {
+			return nil, fmt.Errorf("classless route option is truncated")
+		}
+
+		dst := make(net.IP, 4)
+		copy(dst, b[1:1+n])
+
+		routes = append(routes, &Route{
+			Dst: fmt.Sprintf("%s/%d", dst, width),
+			Gw:  net.IP(b[1+n : 1+n+4]).String(),
+		})
+
+		b = b[1+n+4:]
+	}
+
+	return routes, nil
+}
+
+func ipList(b []byte, family int) []string {
+	size := net.IPv4len
+
+	if family == 6 {
+		size = net.IPv6len
+	}
+
+	var ips []string
+
+	for ; len(b) >= size; b = b[size:] {
+		ips = append(ips, net.IP(b[:size]).String())
+	}
+
+	return ips
+}
+
+func secondsToDuration(n uint32) time.Duration {
+	// 0xffffffff means infinity
+	if n == 0xffffffff {
+		return 100 * 365 * 24 * time.Hour
+	}
+
+	return time.Duration(n) * time.Second
+}
+
+func randomXID() uint32 {
+	var b [4]byte
+
+	rand.Read(b[:])
+
+	return binary.BigEndian.Uint32(b[:])
+}
+
+type client4 struct {
+	iface *net.Interface
+	conn  net.PacketConn
+	start time.Time
+}
+
+func newClient4(iface *net.Interface) (*client4, error) {
+	conn, err := listenUDP(4, iface.Name, 68)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client4{iface: iface, conn: conn, start: time.Now()}, nil
+}
+
+func (c *client4) Close() error {
+	return c.conn.Close()
+}
+
+func (c *client4) newMessage(msgtype byte, xid uint32) *message4 {
+	m := newMessage4(msgtype, xid, c.iface.HardwareAddr)
+
+	m.Secs = uint16(time.Since(c.start) / time.Second)
+
+	m.addOption(optClientID, append([]byte{1}, c.iface.HardwareAddr...))
+
+	if msgtype == msgDiscover || msgtype == msgRequest {
+		m.addOption(optParamRequest, paramRequestList)
+		m.addOption(optMaxMessageSize, []byte{0x05, 0xdc}) // 1500
+	}
+
+	return m
+}
+
+// exchange sends the message and waits for a reply of one of the given types.
+func (c *client4) exchange(ctx context.Context, req *message4, dst net.IP, types ...byte) (*message4, error) {
+	var reply *message4
+
+	match := func(b []byte) bool {
+		m, err := parseMessage4(b)
+		if err != nil || m.Op != 2 || m.XID != req.XID || !bytes.Equal(m.CHAddr, req.CHAddr) {
+			return false
+		}
+
+		for _, t := range types {
+			if m.msgType() == t {
+				reply = m
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if _, err := exchange(ctx, c.conn, &net.UDPAddr{IP: dst, Port: 67}, req.marshal(), 4*time.Second, match); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func acquire4(ctx context.Context, iface *net.Interface) (*Lease, error) {
+	c, err := newClient4(iface)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	// Several attempts in case the server declines the selected offer
+	for attempt := 0; ; attempt++ {
+		xid := randomXID()
+
+		discover := c.newMessage(msgDiscover, xid)
+		discover.Flags = 0x8000 // Ask the server to broadcast its replies
+
+		offer, err := c.exchange(ctx, discover, net.IPv4bcast, msgOffer)
+		if err != nil {
+			return nil, fmt.Errorf("no DHCPOFFER received: %w", err)
+		}
+
+		request := c.newMessage(msgRequest, xid)
+		request.Flags = 0x8000
+
+		request.addOption(optRequestedIP, offer.YIAddr.To4())
+		request.addOption(optServerID, offer.option(optServerID))
+
+		ack, err := c.exchange(ctx, request, net.IPv4bcast, msgAck, msgNak)
+		if err != nil {
+			return nil, fmt.Errorf("no DHCPACK received: %w", err)
+		}
+
+		if ack.msgType() == msgNak {
+			if attempt < 3 {
+				continue
+			}
+
+			return nil, ErrNAK
+		}
+
+		l, err := ack.lease()
+		if err != nil {
+			return nil, err
+		}
+
+		l.Interface = iface.Name
+		l.Obtained = c.start
+
+		return l, nil
+	}
+}
+
+func extend4(ctx context.Context, iface *net.Interface, l *Lease, rebind bool) (*Lease, error) {
+	ip, _, err := net.ParseCIDR(l.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newClient4(iface)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	request := c.newMessage(msgRequest, randomXID())
+	request.CIAddr = ip
+
+	// The renewal request is unicast to the server that granted the lease
+	dst := net.IPv4bcast
+
+	if !rebind {
+		dst = net.ParseIP(l.ServerID)
+	}
+
+	ack, err := c.exchange(ctx, request, dst, msgAck, msgNak)
+	if err != nil {
+		return nil, err
+	}
+
+	if ack.msgType() == msgNak {
+		return nil, ErrNAK
+	}
+
+	newLease, err := ack.lease()
+	if err != nil {
+		return nil, err
+	}
+
+	newLease.Interface = iface.Name
+	newLease.Obtained = c.start
+
+	return newLease, nil
+}
+
+func release4(iface *net.Interface, l *Lease) error {
+	ip, _, err := net.ParseCIDR(l.Addr)
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient4(iface)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	release := c.newMessage(msgRelease, randomXID())
+	release.CIAddr = ip
+	release.addOption(optServerID, net.ParseIP(l.ServerID).To4())
+
+	// There is no reply to DHCPRELEASE
+	_, err = c.conn.WriteTo(release.marshal(), &net.UDPAddr{IP: net.ParseIP(l.ServerID), Port: 67})
+
+	return err
+}
@@ -0,0 +1,122 @@
+package dhcp
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMessage4Marshal(t *testing.T) {
+	hwaddr, _ := net.ParseMAC("52:54:00:12:34:56")
+
+	m := newMessage4(msgDiscover, 0xdeadbeef, hwaddr)
+	m.Flags = 0x8000
+	m.addOption(optParamRequest, paramRequestList)
+	m.addOption(optHostname, bytes.Repeat([]byte("a"), 300))
+
+	b := m.marshal()
+
+	if len(b) < 300 {
+		t.Fatalf("message is shorter than 300 bytes: %d", len(b))
+	}
+
+	if !bytes.Equal(b[236:240], magicCookie) {
+		t.Fatalf("invalid magic cookie: %v", b[236:240])
+	}
+
+	parsed, err := parseMessage4(b)
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	if parsed.XID != m.XID || parsed.Flags != m.Flags || parsed.msgType() != msgDiscover {
+		t.Fatalf("header mismatch: %+v", parsed)
+	}
+
+	if !bytes.Equal(parsed.CHAddr, hwaddr) {
+		t.Fatalf("chaddr mismatch: %s", parsed.CHAddr)
+	}
+
+	if !bytes.Equal(parsed.option(optParamRequest), paramRequestList) {
+		t.Fatalf("param request list mismatch: %v", parsed.option(optParamRequest))
+	}
+
+	// The long option is split and then concatenated back
+	if len(parsed.option(optHostname)) != 300 {
+		t.Fatalf("long option has %d bytes, want 300", len(parsed.option(optHostname)))
+	}
+}
+
+func TestMessage4Lease(t *testing.T) {
+	hwaddr, _ := net.ParseMAC("52:54:00:12:34:56")
+
+	m := newMessage4(msgAck, 1, hwaddr)
+	m.Op = 2
+	m.YIAddr = net.ParseIP("10.0.2.15")
+	m.addOption(optSubnetMask, []byte{255, 255, 255, 0})
+	m.addOption(optRouter, []byte{10, 0, 2, 2})
+	m.addOption(optDNS, []byte{10, 0, 2, 3, 8, 8, 8, 8})
+	m.addOption(optDomainName, []byte("example.org\x00"))
+	m.addOption(optInterfaceMTU, []byte{0x05, 0xaa})
+	m.addOption(optServerID, []byte{10, 0, 2, 2})
+	m.addOption(optLeaseTime, []byte{0, 0, 0x0e, 0x10})
+	m.addOption(optClasslessRoutes, []byte{
+		0, 10, 0, 2, 2, // default via 10.0.2.2
+		24, 192, 168, 1, 10, 0, 2, 1, // 192.168.1.0/24 via 10.0.2.1
+	})
+
+	parsed, err := parseMessage4(m.marshal())
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	l, err := parsed.lease()
+	if err != nil {
+		t.Fatalf("failed to get lease: %s", err)
+	}
+
+	want := Lease{
+		Family:   4,
+		HwAddr:   "52:54:00:12:34:56",
+		Addr:     "10.0.2.15/24",
+		Routers:  []string{"10.0.2.2"},
+		DNS:      []string{"10.0.2.3", "8.8.8.8"},
+		Search:   []string{"example.org"},
+		MTU:      1450,
+		ServerID: "10.0.2.2",
+		Routes: []*Route{
+			{Dst: "0.0.0.0/0", Gw: "10.0.2.2"},
+			{Dst: "192.168.1.0/24", Gw: "10.0.2.1"},
+		},
+		LeaseTime: time.Hour,
+		T1:        30 * time.Minute,
+		T2:        52*time.Minute + 30*time.Second,
+	}
+
+	if !reflect.DeepEqual(l, &want) {
+		t.Fatalf("lease mismatch:\n got: %+v\nwant: %+v", l, &want)
+	}
+}
+
+func TestMessage4Invalid(t *testing.T) {
+	if _, err := parseMessage4(make([]byte, 100)); err == nil {
+		t.Fatalf("short message parsed without error")
+	}
+
+	if _, err := parseMessage4(make([]byte, 300)); err == nil {
+		t.Fatalf("message without magic cookie parsed without error")
+	}
+
+	b := newMessage4(msgOffer, 1, nil).marshal()
+	b[241] = 200 // the length of the message type option
+
+	if _, err := parseMessage4(b[:260]); err == nil {
+		t.Fatalf("truncated option parsed without error")
+	}
+
+	if _, err := parseClasslessRoutes([]byte{24, 192, 168}); err == nil {
+		t.Fatalf("truncated classless route parsed without error")
+	}
+}
@@ -0,0 +1,511 @@
+package dhcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	msgSolicit   = 1
+	msgAdvertise = 2
+	msgRequest6  = 3
+	msgRenew     = 5
+	msgRebind    = 6
+	msgReply     = 7
+	msgRelease6  = 8
+)
+
+const (
+	opt6ClientID    = 1
+	opt6ServerID    = 2
+	opt6IANA        = 3
+	opt6IAAddr      = 5
+	opt6ORO         = 6
+	opt6ElapsedTime = 8
+	opt6StatusCode  = 13
+	opt6DNS         = 23
+	opt6DomainList  = 24
+)
+
+const (
+	status6Success      = 0
+	status6NoAddrsAvail = 2
+	status6NoBinding    = 3
+)
+
+// All_DHCP_Relay_Agents_and_Servers (RFC 8415)
+var allDHCPServers = net.ParseIP("ff02::1:2")
+
+type option6 struct {
+	Code uint16
+	Data []byte
+}
+
+// message6 is a DHCPv6 message as defined in RFC 8415.
+type message6 struct {
+	Type    byte
+	XID     uint32 // 24 bits
+	Options []option6
+}
+
+func (m *message6) addOption(code uint16, data []byte) {
+	m.Options = append(m.Options, option6{code, data})
+}
+
+func (m *message6) option(code uint16) []byte {
+	return findOption6(m.Options, code)
+}
+
+func (m *message6) marshal() []byte {
+	b := []byte{m.Type, byte(m.XID >> 16), byte(m.XID >> 8), byte(m.XID)}
+
+	return appendOptions6(b, m.Options)
+}
+
+func parseMessage6(b []byte) (*message6, error) {
+	if len(b) < 4 {
+		return nil, fmt.Errorf("message is too short: %d bytes", len(b))
+	}
+
+	opts, err := parseOptions6(b[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &message6{
+		Type:    b[0],
+		XID:     uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3]),
+		Options: opts,
+	}, nil
+}
+
+func appendOptions6(b []byte, opts []option6) []byte {
+	for _, o := range opts {
+		b = binary.BigEndian.AppendUint16(b, o.Code)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(o.Data)))
+		b = append(b, o.Data...)
+	}
+
+	return b
+}
+
+func parseOptions6(b []byte) ([]option6, error) {
+	var opts []option6
+
+	for len(b) > 0 {
+		if len(b) < 4 {
+			return nil, fmt.Errorf("option header is truncated")
+		}
+
+		code := binary.BigEndian.Uint16(b[0:2])
+		size := int(binary.BigEndian.Uint16(b[2:4]))
+
+		if len(b) < 4+size {
+			return nil, fmt.Errorf("option %d is truncated", code)
+		}
+
+		opts = append(opts, option6{code, append([]byte(nil), b[4:4+size]...)})
+
+		b = b[4+size:]
+	}
+
+	return opts, nil
+}
+
+func findOption6(opts []option6, code uint16) []byte {
+	for _, o := range opts {
+		if o.Code == code {
+			return o.Data
+		}
+	}
+
+	return nil
+}
+
+// iaNA is the Identity Association for Non-temporary Addresses option.
+type iaNA struct {
+	IAID    uint32
+	T1      uint32
+	T2      uint32
+	Options []option6
+}
+
+func (ia *iaNA) marshal() []byte {
+	b := make([]byte, 12)
+
+	binary.BigEndian.PutUint32(b[0:4], ia.IAID)
+	binary.BigEndian.PutUint32(b[4:8], ia.T1)
+	binary.BigEndian.PutUint32(b[8:12], ia.T2)
+
+	return appendOptions6(b, ia.Options)
+}
+
+func parseIANA(b []byte) (*iaNA, error) {
+	if len(b) < 12 {
+		return nil, fmt.Errorf("IA_NA option is too short")
+	}
+
+	opts, err := parseOptions6(b[12:])
+	if err != nil {
+		return nil, fmt.Errorf("IA_NA: %w", err)
+	}
+
+	return &iaNA{
+		IAID:    binary.BigEndian.Uint32(b[0:4]),
+		T1:      binary.BigEndian.Uint32(b[4:8]),
+		T2:      binary.BigEndian.Uint32(b[8:12]),
+		Options: opts,
+	}, nil
+}
+
+// statusCode returns the code of the status option, or success if there is none.
+func statusCode(opts []option6) (uint16, string) {
+	if v := findOption6(opts, opt6StatusCode); len(v) >= 2 {
+		return binary.BigEndian.Uint16(v[:2]), string(v[2:])
+	}
+
+	return status6Success, ""
+}
+
+// duidLL returns the DUID based on the link-layer address (RFC 8415, section 11.4).
+func duidLL(hwaddr net.HardwareAddr) []byte {
+	return append([]byte{0, 3, 0, 1}, hwaddr...)
+}
+
+// lease returns the lease described by the Reply message.
+func (m *message6) lease(iaid uint32) (*Lease, error) {
+	if code, msg := statusCode(m.Options); code != status6Success {
+		return nil, fmt.Errorf("DHCPv6 server returned status %d: %s", code, msg)
+	}
+
+	v := m.option(opt6IANA)
+	if v == nil {
+		return nil, fmt.Errorf("no IA_NA in the message")
+	}
+
+	ia, err := parseIANA(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if ia.IAID != iaid {
+		return nil, fmt.Errorf("unexpected IAID: %d", ia.IAID)
+	}
+
+	switch code, msg := statusCode(ia.Options); code {
+	case status6Success:
+	case status6NoAddrsAvail:
+		return nil, ErrNoAddrsAvail
+	default:
+		return nil, fmt.Errorf("DHCPv6 server returned IA_NA status %d: %s", code, msg)
+	}
+
+	var addr net.IP
+	var preferred, valid uint32
+
+	for _, o := range ia.Options {
+		if o.Code != opt6IAAddr || len(o.Data) < 24 {
+			continue
+		}
+
+		valid = binary.BigEndian.Uint32(o.Data[20:24])
+
+		if valid == 0 {
+			// The address is no longer valid
+			continue
+		}
+
+		addr = net.IP(o.Data[0:16])
+		preferred = binary.BigEndian.Uint32(o.Data[16:20])
+
+		break
+	}
+
+	if addr == nil {
+		return nil, fmt.Errorf("no valid address in IA_NA")
+	}
+
+	serverID := m.option(opt6ServerID)
+	if len(serverID) == 0 {
+		return nil, fmt.Errorf("no server identifier in the message")
+	}
+
+	l := Lease{
+		Family:    6,
+		Addr:      addr.String() + "/128",
+		DNS:       ipList(m.option(opt6DNS), 6),
+		Search:    parseDomainList(m.option(opt6DomainList)),
+		ServerID:  hex.EncodeToString(serverID),
+		IAID:      iaid,
+		LeaseTime: secondsToDuration(valid),
+		T1:        secondsToDuration(ia.T1),
+		T2:        secondsToDuration(ia.T2),
+	}
+
+	// The server leaves the timers to the client (RFC 8415, section 21.4)
+	if ia.T1 == 0 {
+		l.T1 = secondsToDuration(preferred) / 2
+	}
+	if ia.T2 == 0 {
+		l.T2 = secondsToDuration(preferred) * 4 / 5
+	}
+
+	l.setTimers()
+
+	return &l, nil
+}
+
+// parseDomainList parses the domain names in the uncompressed DNS wire format.
+func parseDomainList(b []byte) []string {
+	var names []string
+	var labels []string
+
+	for len(b) > 0 {
+		n := int(b[0])
+
+		if n == 0 {
+			if len(labels) > 0 {
+				names = append(names, strings.Join(labels, "."))
+			}
+
+			labels = nil
+			b = b[1:]
+
+			continue
+		}
+
+		if len(b) < 1+n {
+			break
+		}
+
+		labels = append(labels, string(b[1:1+n]))
+		b = b[1+n:]
+	}
+
+	return names
+}
+
+type client6 struct {
+	iface *net.Interface
+	conn  net.PacketConn
+	duid  []byte
+	start time.Time
+}
+
+func newClient6(iface *net.Interface) (*client6, error) {
+	conn, err := listenUDP(6, iface.Name, 546)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client6{
+		iface: iface,
+		conn:  conn,
+		duid:  duidLL(iface.HardwareAddr),
+		start: time.Now(),
+	}, nil
+}
+
+func (c *client6) Close() error {
+	return c.conn.Close()
+}
+
+func (c *client6) newMessage(msgtype byte) *message6 {
+	m := message6{
+		Type: msgtype,
+		XID:  randomXID() & 0xffffff,
+	}
+
+	m.addOption(opt6ClientID, c.duid)
+
+	// In hundredths of a second
+	elapsed := time.Since(c.start) / (10 * time.Millisecond)
+	if elapsed > 0xffff {
+		elapsed = 0xffff
+	}
+
+	m.addOption(opt6ElapsedTime, binary.BigEndian.AppendUint16(nil, uint16(elapsed)))
+
+	if msgtype != msgRelease6 {
+		m.addOption(opt6ORO, []byte{0, opt6DNS, 0, opt6DomainList})
+	}
+
+	return &m
+}
+
+func (c *client6) exchange(ctx context.Context, req *message6, msgtype byte, accept func(*message6) bool) (*message6, error) {
+	var reply *message6
+
+	match := func(b []byte) bool {
+		m, err := parseMessage6(b)
+		if err != nil || m.Type != msgtype || m.XID != req.XID || !bytes.Equal(m.option(opt6ClientID), c.duid) {
+			return false
+		}
+
+		if accept != nil && !accept(m) {
+			return false
+		}
+
+		reply = m
+
+		return true
+	}
+
+	dst := &net.UDPAddr{IP: allDHCPServers, Port: 547, Zone: c.iface.Name}
+
+	if _, err := exchange(ctx, c.conn, dst, req.marshal(), time.Second, match); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func iaidFor(iface *net.Interface) uint32 {
+	return uint32(iface.Index)
+}
+
+func acquire6(ctx context.Context, iface *net.Interface) (*Lease, error) {
+	c, err := newClient6(iface)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	iaid := iaidFor(iface)
+
+	solicit := c.newMessage(msgSolicit)
+	solicit.addOption(opt6IANA, (&iaNA{IAID: iaid}).marshal())
+
+	// The first advertisement offering an address is accepted
+	advertise, err := c.exchange(ctx, solicit, msgAdvertise, func(m *message6) bool {
+		_, err := m.lease(iaid)
+		return err == nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("no Advertise received: %w", err)
+	}
+
+	request := c.newMessage(msgRequest6)
+	request.addOption(opt6ServerID, advertise.option(opt6ServerID))
+	request.addOption(opt6IANA, advertise.option(opt6IANA))
+
+	reply, err := c.exchange(ctx, request, msgReply, nil)
+	if err != nil {
+		return nil, fmt.Errorf("no Reply received: %w", err)
+	}
+
+	l, err := reply.lease(iaid)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Interface = iface.Name
+	l.HwAddr = iface.HardwareAddr.String()
+	l.Obtained = c.start
+
+	return l, nil
+}
+
+// leaseIANA returns the IA_NA option that contains the leased address.
+func leaseIANA(l *Lease) ([]byte, error) {
+	ip, _, err := net.ParseCIDR(l.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	iaaddr := append([]byte(nil), ip.To16()...)
+	iaaddr = append(iaaddr, make([]byte, 8)...) // preferred and valid lifetimes
+
+	ia := iaNA{
+		IAID:    l.IAID,
+		Options: []option6{{opt6IAAddr, iaaddr}},
+	}
+
+	return ia.marshal(), nil
+}
+
+func extend6(ctx context.Context, iface *net.Interface, l *Lease, rebind bool) (*Lease, error) {
+	serverID, err := hex.DecodeString(l.ServerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server identifier: %w", err)
+	}
+
+	ia, err := leaseIANA(l)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newClient6(iface)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	var request *message6
+
+	if rebind {
+		request = c.newMessage(msgRebind)
+	} else {
+		request = c.newMessage(msgRenew)
+		request.addOption(opt6ServerID, serverID)
+	}
+
+	request.addOption(opt6IANA, ia)
+
+	reply, err := c.exchange(ctx, request, msgReply, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	newLease, err := reply.lease(l.IAID)
+	if err != nil {
+		if v := reply.option(opt6IANA); v != nil {
+			if x, err := parseIANA(v); err == nil {
+				if code, _ := statusCode(x.Options); code == status6NoBinding {
+					return nil, ErrNAK
+				}
+			}
+		}
+
+		return nil, err
+	}
+
+	newLease.Interface = iface.Name
+	newLease.HwAddr = iface.HardwareAddr.String()
+	newLease.Obtained = c.start
+
+	return newLease, nil
+}
+
+func release6(iface *net.Interface, l *Lease) error {
+	serverID, err := hex.DecodeString(l.ServerID)
+	if err != nil {
+		return fmt.Errorf("invalid server identifier: %w", err)
+	}
+
+	ia, err := leaseIANA(l)
+	if err != nil {
+		return err
+	}
+
+	c, err := newClient6(iface)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	release := c.newMessage(msgRelease6)
+	release.addOption(opt6ServerID, serverID)
+	release.addOption(opt6IANA, ia)
+
+	// The reply is not awaited: the address is removed anyway
+	_, err = c.conn.WriteTo(release.marshal(), &net.UDPAddr{IP: allDHCPServers, Port: 547, Zone: iface.Name})
+
+	return err
+}
@@ -0,0 +1,94 @@
+package dhcp
+
+import (
+	"encoding/binary"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func iaAddr(ip string, preferred, valid uint32) []byte {
+	b := append([]byte(nil), net.ParseIP(ip).To16()...)
+	b = binary.BigEndian.AppendUint32(b, preferred)
+
+	return binary.BigEndian.AppendUint32(b, valid)
+}
+
+func TestMessage6Marshal(t *testing.T) {
+	hwaddr, _ := net.ParseMAC("52:54:00:12:34:56")
+
+	m := message6{Type: msgSolicit, XID: 0xabcdef}
+	m.addOption(opt6ClientID, duidLL(hwaddr))
+	m.addOption(opt6IANA, (&iaNA{IAID: 2}).marshal())
+
+	b := m.marshal()
+
+	if b[0] != msgSolicit || b[1] != 0xab || b[2] != 0xcd || b[3] != 0xef {
+		t.Fatalf("invalid header: %v", b[:4])
+	}
+
+	parsed, err := parseMessage6(b)
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	if !reflect.DeepEqual(parsed, &m) {
+		t.Fatalf("message mismatch:\n got: %+v\nwant: %+v", parsed, &m)
+	}
+
+	if _, err := parseMessage6(b[:len(b)-1]); err == nil {
+		t.Fatalf("truncated message parsed without error")
+	}
+}
+
+func TestMessage6Lease(t *testing.T) {
+	serverID := []byte{0, 3, 0, 1, 1, 2, 3, 4, 5, 6}
+
+	ia := iaNA{
+		IAID: 2,
+		Options: []option6{
+			{opt6IAAddr, iaAddr("2001:db8::100", 3600, 7200)},
+		},
+	}
+
+	m := message6{Type: msgReply, XID: 1}
+	m.addOption(opt6ServerID, serverID)
+	m.addOption(opt6IANA, ia.marshal())
+	m.addOption(opt6DNS, net.ParseIP("2001:db8::53").To16())
+	m.addOption(opt6DomainList, []byte("\x07example\x03org\x00\x04test\x00"))
+
+	l, err := m.lease(2)
+	if err != nil {
+		t.Fatalf("failed to get lease: %s", err)
+	}
+
+	want := Lease{
+		Family:    6,
+		Addr:      "2001:db8::100/128",
+		DNS:       []string{"2001:db8::53"},
+		Search:    []string{"example.org", "test"},
+		ServerID:  "00030001010203040506",
+		IAID:      2,
+		LeaseTime: 2 * time.Hour,
+		T1:        30 * time.Minute,
+		T2:        48 * time.Minute,
+	}
+
+	if !reflect.DeepEqual(l, &want) {
+		t.Fatalf("lease mismatch:\n got: %+v\nwant: %+v", l, &want)
+	}
+
+	if _, err := m.lease(3); err == nil {
+		t.Fatalf("lease with foreign IAID returned without error")
+	}
+
+	// NoAddrsAvail status inside IA_NA
+	ia.Options = []option6{{opt6StatusCode, []byte{0, status6NoAddrsAvail}}}
+
+	m.Options[1] = option6{opt6IANA, ia.marshal()}
+
+	if _, err := m.lease(2); err != ErrNoAddrsAvail {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package interfaces
 
 import (
 	pb_agent "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+	pb_diagnostics "github.com/0xef53/phoenix-guest-agent/api/services/diagnostics/v2"
 	pb_secure_shell "github.com/0xef53/phoenix-guest-agent/api/services/secure_shell/v2"
+	pb_storage "github.com/0xef53/phoenix-guest-agent/api/services/storage/v2"
 	pb_system "github.com/0xef53/phoenix-guest-agent/api/services/system/v2"
 
 	grpc "google.golang.org/grpc"
@@ -16,6 +18,10 @@ type Agent struct {
 	Client_FileSystem pb_agent.AgentFileSystemServiceClient
 
 	Client_SecureShell pb_secure_shell.AgentSecureShellServiceClient
+
+	Client_Storage pb_storage.AgentStorageServiceClient
+
+	Client_Diagnostics pb_diagnostics.AgentDiagnosticsServiceClient
 }
 
 func NewAgentInterface(conn *grpc.ClientConn) *Agent {
@@ -25,6 +31,8 @@ func NewAgentInterface(conn *grpc.ClientConn) *Agent {
 		Client_Network:     pb_agent.NewAgentNetworkServiceClient(conn),
 		Client_FileSystem:  pb_agent.NewAgentFileSystemServiceClient(conn),
 		Client_SecureShell: pb_secure_shell.NewAgentSecureShellServiceClient(conn),
+		Client_Storage:     pb_storage.NewAgentStorageServiceClient(conn),
+		Client_Diagnostics: pb_diagnostics.NewAgentDiagnosticsServiceClient(conn),
 	}
 }
 
@@ -47,3 +55,11 @@ func (k *Agent) FileSystem() pb_agent.AgentFileSystemServiceClient {
 func (k *Agent) SecureShell() pb_secure_shell.AgentSecureShellServiceClient {
 	return k.Client_SecureShell
 }
+
+func (k *Agent) Storage() pb_storage.AgentStorageServiceClient {
+	return k.Client_Storage
+}
+
+func (k *Agent) Diagnostics() pb_diagnostics.AgentDiagnosticsServiceClient {
+	return k.Client_Diagnostics
+}
@@ -0,0 +1,273 @@
+package lvm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+var ErrNotInstalled = errors.New("lvm2 tools are not installed")
+
+// Error describes a failed lvm2 command.
+type Error struct {
+	Command  string
+	ExitCode int
+	Stderr   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s failed (exit status %d): %s", e.Command, e.ExitCode, e.Stderr)
+}
+
+type PhysicalVolume struct {
+	Name                 string
+	VGName               string
+	Format               string
+	SizeBytes            uint64
+	FreeBytes            uint64
+	ExtentCount          uint64
+	AllocatedExtentCount uint64
+}
+
+type VolumeGroup struct {
+	Name            string
+	SizeBytes       uint64
+	FreeBytes       uint64
+	ExtentSize      uint64
+	ExtentCount     uint64
+	FreeExtentCount uint64
+	PVCount         uint32
+	LVCount         uint32
+}
+
+type LogicalVolume struct {
+	Name      string
+	VGName    string
+	Path      string
+	DMPath    string
+	Attr      string
+	SizeBytes uint64
+}
+
+func ListPhysicalVolumes(ctx context.Context) ([]*PhysicalVolume, error) {
+	rows, err := report(ctx, "pvs", "pv", "pv_name,vg_name,pv_fmt,pv_size,pv_free,pv_pe_count,pv_pe_alloc_count")
+	if err != nil {
+		return nil, err
+	}
+
+	pvs := make([]*PhysicalVolume, 0, len(rows))
+
+	for _, row := range rows {
+		pv := PhysicalVolume{
+			Name:   row.str("pv_name"),
+			VGName: row.str("vg_name"),
+			Format: row.str("pv_fmt"),
+		}
+
+		if pv.SizeBytes, err = row.uint("pv_size"); err != nil {
+			return nil, err
+		}
+		if pv.FreeBytes, err = row.uint("pv_free"); err != nil {
+			return nil, err
+		}
+		if pv.ExtentCount, err = row.uint("pv_pe_count"); err != nil {
+			return nil, err
+		}
+		if pv.AllocatedExtentCount, err = row.uint("pv_pe_alloc_count"); err != nil {
+			return nil, err
+		}
+
+		pvs = append(pvs, &pv)
+	}
+
+	return pvs, nil
+}
+
+func ListVolumeGroups(ctx context.Context) ([]*VolumeGroup, error) {
+	rows, err := report(ctx, "vgs", "vg", "vg_name,vg_size,vg_free,vg_extent_size,vg_extent_count,vg_free_count,pv_count,lv_count")
+	if err != nil {
+		return nil, err
+	}
+
+	vgs := make([]*VolumeGroup, 0, len(rows))
+
+	for _, row := range rows {
+		vg := VolumeGroup{
+			Name: row.str("vg_name"),
+		}
+
+		if vg.SizeBytes, err = row.uint("vg_size"); err != nil {
+			return nil, err
+		}
+		if vg.FreeBytes, err = row.uint("vg_free"); err != nil {
+			return nil, err
+		}
+		if vg.ExtentSize, err = row.uint("vg_extent_size"); err != nil {
+			return nil, err
+		}
+		if vg.ExtentCount, err = row.uint("vg_extent_count"); err != nil {
+			return nil, err
+		}
+		if vg.FreeExtentCount, err = row.uint("vg_free_count"); err != nil {
+			return nil, err
+		}
+
+		if v, err := row.uint("pv_count"); err == nil {
+			vg.PVCount = uint32(v)
+		} else {
+			return nil, err
+		}
+
+		if v, err := row.uint("lv_count"); err == nil {
+			vg.LVCount = uint32(v)
+		} else {
+			return nil, err
+		}
+
+		vgs = append(vgs, &vg)
+	}
+
+	return vgs, nil
+}
+
+func ListLogicalVolumes(ctx context.Context) ([]*LogicalVolume, error) {
+	rows, err := report(ctx, "lvs", "lv", "lv_name,vg_name,lv_path,lv_dm_path,lv_attr,lv_size")
+	if err != nil {
+		return nil, err
+	}
+
+	lvs := make([]*LogicalVolume, 0, len(rows))
+
+	for _, row := range rows {
+		lv := LogicalVolume{
+			Name:   row.str("lv_name"),
+			VGName: row.str("vg_name"),
+			Path:   row.str("lv_path"),
+			DMPath: row.str("lv_dm_path"),
+			Attr:   row.str("lv_attr"),
+		}
+
+		if lv.SizeBytes, err = row.uint("lv_size"); err != nil {
+			return nil, err
+		}
+
+		lvs = append(lvs, &lv)
+	}
+
+	return lvs, nil
+}
+
+// ResizePhysicalVolume makes LVM use all the space of the underlying
+// block device (e.g. after the virtual disk has been grown).
+func ResizePhysicalVolume(ctx context.Context, device string) error {
+	_, err := run(ctx, "pvresize", device)
+
+	return err
+}
+
+// ExtendLogicalVolume grows the logical volume vgname/lvname up to sizeBytes.
+// If sizeBytes is zero, all free extents of the volume group are used.
+// With resizeFS the filesystem on the volume is resized too (see fsadm(8)).
+func ExtendLogicalVolume(ctx context.Context, vgname, lvname string, sizeBytes uint64, resizeFS bool) error {
+	args := make([]string, 0, 4)
+
+	if sizeBytes == 0 {
+		args = append(args, "--extents", "+100%FREE")
+	} else {
+		args = append(args, "--size", fmt.Sprintf("%db", sizeBytes))
+	}
+
+	if resizeFS {
+		args = append(args, "--resizefs")
+	}
+
+	args = append(args, vgname+"/"+lvname)
+
+	_, err := run(ctx, "lvextend", args...)
+
+	return err
+}
+
+type reportRow map[string]string
+
+func (r reportRow) str(key string) string {
+	return r[key]
+}
+
+func (r reportRow) uint(key string) (uint64, error) {
+	v := strings.TrimSpace(r[key])
+
+	if len(v) == 0 {
+		return 0, nil
+	}
+
+	n, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of %s: %q", key, v)
+	}
+
+	return n, nil
+}
+
+func report(ctx context.Context, command, section, fields string) ([]reportRow, error) {
+	out, err := run(ctx, command, "--reportformat", "json", "--units", "b", "--nosuffix", "-o", fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseReport(out, section)
+}
+
+func parseReport(data []byte, section string) ([]reportRow, error) {
+	// {"report": [{"pv": [{"pv_name":"/dev/vda2", ...}]}]}
+	var v struct {
+		Report []map[string][]reportRow `json:"report"`
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("unable to parse lvm report: %w", err)
+	}
+
+	rows := make([]reportRow, 0)
+
+	for _, r := range v.Report {
+		rows = append(rows, r[section]...)
+	}
+
+	return rows, nil
+}
+
+func run(ctx context.Context, command string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "lvm", append([]string{command}, args...)...)
+
+	cmd.Env = append(os.Environ(), "LC_ALL=C", "LVM_SUPPRESS_FD_WARNINGS=1")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+
+		switch {
+		case errors.As(err, &exitErr):
+			return nil, &Error{
+				Command:  command,
+				ExitCode: exitErr.ExitCode(),
+				Stderr:   strings.TrimSpace(stderr.String()),
+			}
+		case errors.Is(err, exec.ErrNotFound):
+			return nil, ErrNotInstalled
+		}
+
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
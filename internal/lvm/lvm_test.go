@@ -0,0 +1,55 @@
+package lvm
+
+import (
+	"testing"
+)
+
+func TestParseReport(t *testing.T) {
+	data := []byte(`
+  {
+      "report": [
+          {
+              "pv": [
+                  {"pv_name":"/dev/vda2", "vg_name":"vg0", "pv_fmt":"lvm2", "pv_size":"21470642176", "pv_free":"4294967296", "pv_pe_count":"5119", "pv_pe_alloc_count":"4095"},
+                  {"pv_name":"/dev/vdb", "vg_name":"", "pv_fmt":"lvm2", "pv_size":"10737418240", "pv_free":"10737418240", "pv_pe_count":"0", "pv_pe_alloc_count":"0"}
+              ]
+          }
+      ]
+  }
+`)
+
+	rows, err := parseReport(data, "pv")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("got invalid number of rows:\nwant:\t2\ngot:\t%d", len(rows))
+	}
+
+	if got := rows[0].str("pv_name"); got != "/dev/vda2" {
+		t.Fatalf("got invalid pv_name:\nwant:\t%q\ngot:\t%q", "/dev/vda2", got)
+	}
+
+	size, err := rows[0].uint("pv_size")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if size != 21470642176 {
+		t.Fatalf("got invalid pv_size:\nwant:\t%d\ngot:\t%d", 21470642176, size)
+	}
+
+	if rows, err := parseReport(data, "vg"); err != nil || len(rows) != 0 {
+		t.Fatalf("got unexpected result for missing section: rows = %v, err = %v", rows, err)
+	}
+
+	// bad values tests
+
+	if _, err := (reportRow{"pv_size": "20G"}).uint("pv_size"); err == nil {
+		t.Fatalf("expected an error for non-numeric value, got nil")
+	}
+
+	if _, err := parseReport([]byte(`{"report": `), "pv"); err == nil {
+		t.Fatalf("expected an error for truncated report, got nil")
+	}
+}
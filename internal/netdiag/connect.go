@@ -0,0 +1,176 @@
+package netdiag
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+type ConnectOptions struct {
+	Network  string // tcp or udp
+	Addr     net.IP
+	Port     int
+	Count    int
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// ConnectProbe describes the result of a single connection attempt.
+//
+// A TCP probe succeeds when the connection is established. A UDP probe
+// sends an empty datagram and succeeds when any reply is received.
+// Lost UDP probes are common, because many services do not reply to
+// unexpected datagrams, so only an explicit refusal (ICMP Port Unreachable)
+// means that the port is closed.
+type ConnectProbe struct {
+	Seq       int
+	LocalAddr string
+	RTT       time.Duration
+
+	// Lost is set if there is no response within the timeout
+	Lost bool
+
+	Error string
+}
+
+type ConnectStats struct {
+	Addr      string
+	Sent      int
+	Succeeded int
+	MinRTT    time.Duration
+	AvgRTT    time.Duration
+	MaxRTT    time.Duration
+}
+
+func (s *ConnectStats) add(rtt time.Duration) {
+	if s.Succeeded == 0 || rtt < s.MinRTT {
+		s.MinRTT = rtt
+	}
+
+	if rtt > s.MaxRTT {
+		s.MaxRTT = rtt
+	}
+
+	s.AvgRTT = (s.AvgRTT*time.Duration(s.Succeeded) + rtt) / time.Duration(s.Succeeded+1)
+	s.Succeeded++
+}
+
+// Connect makes the TCP or UDP connection attempts and calls fn for each of them.
+func Connect(ctx context.Context, opts *ConnectOptions, fn func(*ConnectProbe) error) (*ConnectStats, error) {
+	addr := net.JoinHostPort(opts.Addr.String(), strconv.Itoa(opts.Port))
+
+	stats := ConnectStats{Addr: addr}
+
+	for seq := 0; seq < opts.Count; seq++ {
+		if seq > 0 {
+			select {
+			case <-ctx.Done():
+				return &stats, ctx.Err()
+			case <-time.After(opts.Interval):
+			}
+		}
+
+		var probe *ConnectProbe
+
+		switch opts.Network {
+		case "udp":
+			probe = probeUDP(ctx, addr, opts.Timeout)
+		default:
+			probe = probeTCP(ctx, addr, opts.Timeout)
+		}
+
+		probe.Seq = seq
+
+		stats.Sent++
+
+		if !probe.Lost && probe.Error == "" {
+			stats.add(probe.RTT)
+		}
+
+		if err := fn(probe); err != nil {
+			return nil, err
+		}
+	}
+
+	return &stats, nil
+}
+
+func probeTCP(ctx context.Context, addr string, timeout time.Duration) *ConnectProbe {
+	var probe ConnectProbe
+
+	dialer := net.Dialer{Timeout: timeout}
+
+	start := time.Now()
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		if isTimeout(err) {
+			probe.Lost = true
+		} else {
+			probe.RTT = time.Since(start)
+
+			if errors.Is(err, syscall.ECONNREFUSED) {
+				probe.Error = "connection refused"
+			} else {
+				probe.Error = err.Error()
+			}
+		}
+
+		return &probe
+	}
+	defer conn.Close()
+
+	probe.RTT = time.Since(start)
+	probe.LocalAddr = conn.LocalAddr().String()
+
+	return &probe
+}
+
+func probeUDP(ctx context.Context, addr string, timeout time.Duration) *ConnectProbe {
+	var probe ConnectProbe
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "udp", addr)
+	if err != nil {
+		probe.Error = err.Error()
+
+		return &probe
+	}
+	defer conn.Close()
+
+	probe.LocalAddr = conn.LocalAddr().String()
+
+	start := time.Now()
+
+	if _, err := conn.Write(nil); err != nil {
+		probe.Error = err.Error()
+
+		return &probe
+	}
+
+	conn.SetReadDeadline(start.Add(timeout))
+
+	// The connected UDP socket reports the ICMP Port Unreachable
+	// as ECONNREFUSED on the next read
+	if _, err := conn.Read(make([]byte, 1500)); err != nil {
+		switch {
+		case isTimeout(err):
+			probe.Lost = true
+		case errors.Is(err, syscall.ECONNREFUSED):
+			probe.RTT = time.Since(start)
+			probe.Error = "connection refused"
+		default:
+			probe.Error = err.Error()
+		}
+
+		return &probe
+	}
+
+	probe.RTT = time.Since(start)
+
+	return &probe
+}
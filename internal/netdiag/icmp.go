@@ -0,0 +1,248 @@
+package netdiag
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	protoICMP   = 1
+	protoUDP    = 17
+	protoICMPv6 = 58
+)
+
+// icmpConn is a raw ICMP socket of the given address family.
+type icmpConn struct {
+	*icmp.PacketConn
+
+	family int
+}
+
+func listenICMP(family int) (*icmpConn, error) {
+	var c *icmp.PacketConn
+	var err error
+
+	if family == 4 {
+		if c, err = icmp.ListenPacket("ip4:icmp", "0.0.0.0"); err == nil {
+			err = c.IPv4PacketConn().SetControlMessage(ipv4.FlagTTL, true)
+		}
+	} else {
+		if c, err = icmp.ListenPacket("ip6:ipv6-icmp", "::"); err == nil {
+			err = c.IPv6PacketConn().SetControlMessage(ipv6.FlagHopLimit, true)
+		}
+	}
+
+	if err != nil {
+		if c != nil {
+			c.Close()
+		}
+
+		return nil, err
+	}
+
+	return &icmpConn{PacketConn: c, family: family}, nil
+}
+
+func (c *icmpConn) proto() int {
+	if c.family == 4 {
+		return protoICMP
+	}
+
+	return protoICMPv6
+}
+
+func (c *icmpConn) setTTL(ttl int) error {
+	if c.family == 4 {
+		return c.IPv4PacketConn().SetTTL(ttl)
+	}
+
+	return c.IPv6PacketConn().SetHopLimit(ttl)
+}
+
+// readMessage reads the next ICMP message until the deadline.
+// It also returns the size of the message and the TTL (hop limit)
+// of the packet if available.
+func (c *icmpConn) readMessage(b []byte, deadline time.Time) (*icmp.Message, net.IP, int, int, error) {
+	if err := c.SetReadDeadline(deadline); err != nil {
+		return nil, nil, 0, 0, err
+	}
+
+	var n, ttl int
+	var src net.Addr
+	var err error
+
+	if c.family == 4 {
+		var cm *ipv4.ControlMessage
+
+		if n, cm, src, err = c.IPv4PacketConn().ReadFrom(b); cm != nil {
+			ttl = cm.TTL
+		}
+	} else {
+		var cm *ipv6.ControlMessage
+
+		if n, cm, src, err = c.IPv6PacketConn().ReadFrom(b); cm != nil {
+			ttl = cm.HopLimit
+		}
+	}
+
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+
+	m, err := icmp.ParseMessage(c.proto(), b[:n])
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+
+	var from net.IP
+
+	if addr, ok := src.(*net.IPAddr); ok {
+		from = addr.IP
+	}
+
+	return m, from, n, ttl, nil
+}
+
+func echoRequestType(family int) icmp.Type {
+	if family == 4 {
+		return ipv4.ICMPTypeEcho
+	}
+
+	return ipv6.ICMPTypeEchoRequest
+}
+
+func echoReplyType(family int) icmp.Type {
+	if family == 4 {
+		return ipv4.ICMPTypeEchoReply
+	}
+
+	return ipv6.ICMPTypeEchoReply
+}
+
+// isTimeout reports whether the error is a read deadline expiry.
+func isTimeout(err error) bool {
+	netErr, ok := err.(net.Error)
+
+	return ok && netErr.Timeout()
+}
+
+// errorData returns the datagram quoted in the ICMP error message
+// or nil if m is not an error message.
+func errorData(m *icmp.Message) []byte {
+	switch b := m.Body.(type) {
+	case *icmp.DstUnreach:
+		return b.Data
+	case *icmp.TimeExceeded:
+		return b.Data
+	case *icmp.PacketTooBig:
+		return b.Data
+	case *icmp.ParamProb:
+		return b.Data
+	}
+
+	return nil
+}
+
+// quotedDatagram parses the IP header of the datagram quoted in the ICMP
+// error message and returns the upper-layer protocol, the destination
+// address and the beginning of the payload.
+// IPv6 extension headers are not supported.
+func quotedDatagram(family int, data []byte) (int, net.IP, []byte, bool) {
+	if family == 4 {
+		if len(data) < ipv4.HeaderLen {
+			return 0, nil, nil, false
+		}
+
+		hlen := int(data[0]&0x0f) << 2
+
+		if hlen < ipv4.HeaderLen || len(data) < hlen {
+			return 0, nil, nil, false
+		}
+
+		return int(data[9]), net.IP(data[16:20]), data[hlen:], true
+	}
+
+	if len(data) < ipv6.HeaderLen {
+		return 0, nil, nil, false
+	}
+
+	return int(data[6]), net.IP(data[24:40]), data[ipv6.HeaderLen:], true
+}
+
+// matchEcho checks whether m is a reply to the echo request with the given ID
+// or an ICMP error caused by such request. It returns the sequence number
+// of the request.
+func matchEcho(family int, m *icmp.Message, id int) (seq int, isErr bool, ok bool) {
+	if echo, ok := m.Body.(*icmp.Echo); ok {
+		if m.Type == echoReplyType(family) && echo.ID == id {
+			return echo.Seq, false, true
+		}
+
+		return 0, false, false
+	}
+
+	data := errorData(m)
+	if data == nil {
+		return 0, false, false
+	}
+
+	proto, _, payload, ok := quotedDatagram(family, data)
+	if !ok || len(payload) < 8 {
+		return 0, false, false
+	}
+
+	switch {
+	case family == 4 && (proto != protoICMP || payload[0] != byte(ipv4.ICMPTypeEcho)):
+		return 0, false, false
+	case family == 6 && (proto != protoICMPv6 || payload[0] != byte(ipv6.ICMPTypeEchoRequest)):
+		return 0, false, false
+	}
+
+	if int(binary.BigEndian.Uint16(payload[4:6])) != id {
+		return 0, false, false
+	}
+
+	return int(binary.BigEndian.Uint16(payload[6:8])), true, true
+}
+
+// matchProbe checks whether m is an ICMP error caused by the UDP probe
+// sent from srcPort to dst. It returns the destination port of the probe.
+func matchProbe(family int, m *icmp.Message, dst net.IP, srcPort int) (int, bool) {
+	data := errorData(m)
+	if data == nil {
+		return 0, false
+	}
+
+	proto, qdst, payload, ok := quotedDatagram(family, data)
+	if !ok || proto != protoUDP || len(payload) < 4 || !qdst.Equal(dst) {
+		return 0, false
+	}
+
+	if int(binary.BigEndian.Uint16(payload[0:2])) != srcPort {
+		return 0, false
+	}
+
+	return int(binary.BigEndian.Uint16(payload[2:4])), true
+}
+
+// isDstUnreach reports whether m is a Destination Unreachable message.
+func isDstUnreach(m *icmp.Message) bool {
+	return m.Type == ipv4.ICMPTypeDestinationUnreachable || m.Type == ipv6.ICMPTypeDestinationUnreachable
+}
+
+// isPortUnreach reports whether m is a Port Unreachable message,
+// i.e. the probe has reached the destination host.
+func isPortUnreach(m *icmp.Message) bool {
+	return (m.Type == ipv4.ICMPTypeDestinationUnreachable && m.Code == 3) ||
+		(m.Type == ipv6.ICMPTypeDestinationUnreachable && m.Code == 4)
+}
+
+func describeICMP(m *icmp.Message) string {
+	return fmt.Sprintf("%v (code %d)", m.Type, m.Code)
+}
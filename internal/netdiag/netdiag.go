@@ -0,0 +1,177 @@
+package netdiag
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+// resolver always uses the pure Go implementation, so that the lookups
+// go through the guest's /etc/resolv.conf and /etc/hosts.
+var resolver = &net.Resolver{PreferGo: true}
+
+// Resolve returns the first address of the host. family may be 0, 4 or 6.
+func Resolve(ctx context.Context, host string, family int) (net.IP, error) {
+	network := "ip"
+
+	switch family {
+	case 0:
+	case 4:
+		network = "ip4"
+	case 6:
+		network = "ip6"
+	default:
+		return nil, fmt.Errorf("invalid address family: %d", family)
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if (family == 4 && ip.To4() == nil) || (family == 6 && ip.To4() != nil) {
+			return nil, fmt.Errorf("address %s does not belong to IPv%d family", host, family)
+		}
+
+		return ip, nil
+	}
+
+	addrs, err := resolver.LookupIP(ctx, network, host)
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs[0], nil
+}
+
+func familyOf(ip net.IP) int {
+	if ip.To4() != nil {
+		return 4
+	}
+
+	return 6
+}
+
+// LookupResult describes the response of the guest resolver.
+type LookupResult struct {
+	Name        string
+	Type        string
+	Records     []string
+	Nameservers []string
+	Search      []string
+	Duration    time.Duration
+}
+
+// Lookup resolves the name using the guest resolver configuration.
+// qtype may be A, AAAA, CNAME, MX, NS, TXT, SRV or PTR.
+// If empty, all IPv4 and IPv6 addresses of the name are returned.
+func Lookup(ctx context.Context, name, qtype string) (*LookupResult, error) {
+	qtype = strings.ToUpper(qtype)
+
+	res := LookupResult{
+		Name: name,
+		Type: qtype,
+	}
+
+	if f, err := os.Open("/etc/resolv.conf"); err == nil {
+		res.Nameservers, res.Search = parseResolvConf(f)
+		f.Close()
+	}
+
+	start := time.Now()
+
+	var err error
+
+	switch qtype {
+	case "":
+		var addrs []net.IPAddr
+
+		if addrs, err = resolver.LookupIPAddr(ctx, name); err == nil {
+			for _, a := range addrs {
+				res.Records = append(res.Records, a.String())
+			}
+		}
+	case "A", "AAAA":
+		network := "ip4"
+		if qtype == "AAAA" {
+			network = "ip6"
+		}
+
+		var addrs []net.IP
+
+		if addrs, err = resolver.LookupIP(ctx, network, name); err == nil {
+			for _, a := range addrs {
+				res.Records = append(res.Records, a.String())
+			}
+		}
+	case "CNAME":
+		var cname string
+
+		if cname, err = resolver.LookupCNAME(ctx, name); err == nil {
+			res.Records = []string{cname}
+		}
+	case "MX":
+		var mxs []*net.MX
+
+		if mxs, err = resolver.LookupMX(ctx, name); err == nil {
+			for _, mx := range mxs {
+				res.Records = append(res.Records, fmt.Sprintf("%d %s", mx.Pref, mx.Host))
+			}
+		}
+	case "NS":
+		var nss []*net.NS
+
+		if nss, err = resolver.LookupNS(ctx, name); err == nil {
+			for _, ns := range nss {
+				res.Records = append(res.Records, ns.Host)
+			}
+		}
+	case "TXT":
+		res.Records, err = resolver.LookupTXT(ctx, name)
+	case "SRV":
+		var srvs []*net.SRV
+
+		if _, srvs, err = resolver.LookupSRV(ctx, "", "", name); err == nil {
+			for _, srv := range srvs {
+				res.Records = append(res.Records, fmt.Sprintf("%d %d %d %s", srv.Priority, srv.Weight, srv.Port, srv.Target))
+			}
+		}
+	case "PTR":
+		res.Records, err = resolver.LookupAddr(ctx, name)
+	default:
+		return nil, fmt.Errorf("unsupported record type: %s", qtype)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.Duration = time.Since(start)
+
+	return &res, nil
+}
+
+// parseResolvConf returns the nameservers and the search domains
+// from the resolv.conf(5) file.
+func parseResolvConf(r io.Reader) (nameservers, search []string) {
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], ";") {
+			continue
+		}
+
+		switch fields[0] {
+		case "nameserver":
+			nameservers = append(nameservers, fields[1])
+		case "search", "domain":
+			// The last one wins
+			search = fields[1:]
+		}
+	}
+
+	return nameservers, search
+}
@@ -0,0 +1,210 @@
+package netdiag
+
+import (
+	"encoding/binary"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+func TestParseResolvConf(t *testing.T) {
+	s := `# Generated by NetworkManager
+domain example.org
+search example.com example.net
+nameserver 192.0.2.53
+; nameserver 192.0.2.54
+nameserver 2001:db8::53
+options edns0 trust-ad
+`
+
+	nameservers, search := parseResolvConf(strings.NewReader(s))
+
+	if want := []string{"192.0.2.53", "2001:db8::53"}; !reflect.DeepEqual(nameservers, want) {
+		t.Fatalf("got invalid nameservers:\nwant:\t%v\ngot:\t%v", want, nameservers)
+	}
+
+	if want := []string{"example.com", "example.net"}; !reflect.DeepEqual(search, want) {
+		t.Fatalf("got invalid search domains:\nwant:\t%v\ngot:\t%v", want, search)
+	}
+}
+
+// ipv4Datagram returns an IPv4 packet with the given payload as it is
+// quoted in the ICMP error messages.
+func ipv4Datagram(proto int, dst net.IP, payload []byte) []byte {
+	h := ipv4.Header{
+		Version:  ipv4.Version,
+		Len:      ipv4.HeaderLen,
+		TotalLen: ipv4.HeaderLen + len(payload),
+		TTL:      1,
+		Protocol: proto,
+		Src:      net.IPv4(192, 0, 2, 1),
+		Dst:      dst,
+	}
+
+	b, err := h.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return append(b, payload...)
+}
+
+func ipv6Datagram(proto int, dst net.IP, payload []byte) []byte {
+	b := make([]byte, ipv6.HeaderLen)
+
+	b[0] = ipv6.Version << 4
+	binary.BigEndian.PutUint16(b[4:6], uint16(len(payload)))
+	b[6] = byte(proto)
+	b[7] = 1
+	copy(b[8:24], net.ParseIP("2001:db8::1"))
+	copy(b[24:40], dst)
+
+	return append(b, payload...)
+}
+
+func TestMatchEcho(t *testing.T) {
+	echo := func(family int, id, seq int) []byte {
+		m := icmp.Message{Type: echoRequestType(family), Body: &icmp.Echo{ID: id, Seq: seq, Data: make([]byte, 8)}}
+
+		b, _ := m.Marshal(nil)
+
+		return b
+	}
+
+	tests := []struct {
+		family int
+		msg    icmp.Message
+		seq    int
+		isErr  bool
+		ok     bool
+	}{
+		{
+			family: 4,
+			msg:    icmp.Message{Type: ipv4.ICMPTypeEchoReply, Body: &icmp.Echo{ID: 0x1234, Seq: 7}},
+			seq:    7,
+			ok:     true,
+		},
+		{
+			// Another ping process
+			family: 4,
+			msg:    icmp.Message{Type: ipv4.ICMPTypeEchoReply, Body: &icmp.Echo{ID: 0x4321, Seq: 7}},
+		},
+		{
+			// Own request seen on the loopback
+			family: 6,
+			msg:    icmp.Message{Type: ipv6.ICMPTypeEchoRequest, Body: &icmp.Echo{ID: 0x1234, Seq: 7}},
+		},
+		{
+			family: 4,
+			msg: icmp.Message{
+				Type: ipv4.ICMPTypeDestinationUnreachable,
+				Code: 1,
+				Body: &icmp.DstUnreach{Data: ipv4Datagram(protoICMP, net.IPv4(198, 51, 100, 1), echo(4, 0x1234, 3))},
+			},
+			seq:   3,
+			isErr: true,
+			ok:    true,
+		},
+		{
+			family: 6,
+			msg: icmp.Message{
+				Type: ipv6.ICMPTypeTimeExceeded,
+				Body: &icmp.TimeExceeded{Data: ipv6Datagram(protoICMPv6, net.ParseIP("2001:db8::2"), echo(6, 0x1234, 5))},
+			},
+			seq:   5,
+			isErr: true,
+			ok:    true,
+		},
+		{
+			// The quoted datagram is not an echo request
+			family: 4,
+			msg: icmp.Message{
+				Type: ipv4.ICMPTypeTimeExceeded,
+				Body: &icmp.TimeExceeded{Data: ipv4Datagram(protoUDP, net.IPv4(198, 51, 100, 1), make([]byte, 8))},
+			},
+		},
+		{
+			// Truncated datagram
+			family: 4,
+			msg: icmp.Message{
+				Type: ipv4.ICMPTypeDestinationUnreachable,
+				Body: &icmp.DstUnreach{Data: make([]byte, 10)},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		seq, isErr, ok := matchEcho(tt.family, &tt.msg, 0x1234)
+
+		if seq != tt.seq || isErr != tt.isErr || ok != tt.ok {
+			t.Fatalf("test %d: got (%d, %t, %t), want (%d, %t, %t)", i, seq, isErr, ok, tt.seq, tt.isErr, tt.ok)
+		}
+	}
+}
+
+func TestMatchProbe(t *testing.T) {
+	udp := func(srcPort, dstPort int) []byte {
+		b := make([]byte, 8)
+
+		binary.BigEndian.PutUint16(b[0:2], uint16(srcPort))
+		binary.BigEndian.PutUint16(b[2:4], uint16(dstPort))
+
+		return b
+	}
+
+	dst4 := net.IPv4(198, 51, 100, 1)
+	dst6 := net.ParseIP("2001:db8::2")
+
+	tests := []struct {
+		family int
+		msg    icmp.Message
+		dst    net.IP
+		port   int
+		ok     bool
+	}{
+		{
+			family: 4,
+			msg:    icmp.Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &icmp.TimeExceeded{Data: ipv4Datagram(protoUDP, dst4, udp(40000, 33435))}},
+			dst:    dst4,
+			port:   33435,
+			ok:     true,
+		},
+		{
+			family: 6,
+			msg:    icmp.Message{Type: ipv6.ICMPTypeDestinationUnreachable, Code: 4, Body: &icmp.DstUnreach{Data: ipv6Datagram(protoUDP, dst6, udp(40000, 33440))}},
+			dst:    dst6,
+			port:   33440,
+			ok:     true,
+		},
+		{
+			// Another source port
+			family: 4,
+			msg:    icmp.Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &icmp.TimeExceeded{Data: ipv4Datagram(protoUDP, dst4, udp(40001, 33435))}},
+			dst:    dst4,
+		},
+		{
+			// Another destination
+			family: 4,
+			msg:    icmp.Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &icmp.TimeExceeded{Data: ipv4Datagram(protoUDP, net.IPv4(198, 51, 100, 2), udp(40000, 33435))}},
+			dst:    dst4,
+		},
+		{
+			family: 4,
+			msg:    icmp.Message{Type: ipv4.ICMPTypeEchoReply, Body: &icmp.Echo{ID: 1, Seq: 1}},
+			dst:    dst4,
+		},
+	}
+
+	for i, tt := range tests {
+		port, ok := matchProbe(tt.family, &tt.msg, tt.dst, 40000)
+
+		if port != tt.port || ok != tt.ok {
+			t.Fatalf("test %d: got (%d, %t), want (%d, %t)", i, port, ok, tt.port, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package netdiag
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"net"
+	"time"
+
+	"golang.org/x/net/icmp"
+)
+
+type PingOptions struct {
+	Addr     net.IP
+	Count    int
+	Interval time.Duration
+	Timeout  time.Duration // time to wait for each reply
+	Size     int           // payload size
+	TTL      int
+}
+
+// PingReply describes the result of a single echo request.
+type PingReply struct {
+	Seq  int
+	From net.IP
+	Size int
+	TTL  int
+	RTT  time.Duration
+
+	// Lost is set if there is no reply within the timeout
+	Lost bool
+
+	// Error describes the ICMP error received instead of the echo reply
+	Error string
+}
+
+type PingStats struct {
+	Addr     net.IP
+	Sent     int
+	Received int
+	MinRTT   time.Duration
+	AvgRTT   time.Duration
+	MaxRTT   time.Duration
+}
+
+func (s *PingStats) add(rtt time.Duration) {
+	if s.Received == 0 || rtt < s.MinRTT {
+		s.MinRTT = rtt
+	}
+
+	if rtt > s.MaxRTT {
+		s.MaxRTT = rtt
+	}
+
+	s.AvgRTT = (s.AvgRTT*time.Duration(s.Received) + rtt) / time.Duration(s.Received+1)
+	s.Received++
+}
+
+// Ping sends ICMP echo requests using a raw socket and calls fn
+// for each reply or timeout.
+func Ping(ctx context.Context, opts *PingOptions, fn func(*PingReply) error) (*PingStats, error) {
+	family := familyOf(opts.Addr)
+
+	conn, err := listenICMP(family)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if opts.TTL > 0 {
+		if err := conn.setTTL(opts.TTL); err != nil {
+			return nil, err
+		}
+	}
+
+	// Raw sockets receive all ICMP messages, so a random ID
+	// is used to tell apart the replies of concurrent pings
+	var b [2]byte
+
+	rand.Read(b[:])
+
+	id := int(binary.BigEndian.Uint16(b[:]))
+
+	payload := make([]byte, opts.Size)
+
+	buf := make([]byte, 65536)
+
+	stats := PingStats{Addr: opts.Addr}
+
+	for seq := 0; seq < opts.Count; seq++ {
+		if seq > 0 {
+			select {
+			case <-ctx.Done():
+				return &stats, ctx.Err()
+			case <-time.After(opts.Interval):
+			}
+		}
+
+		msg := icmp.Message{
+			Type: echoRequestType(family),
+			Body: &icmp.Echo{ID: id, Seq: seq & 0xffff, Data: payload},
+		}
+
+		// The checksum of ICMPv6 is calculated by the kernel
+		req, err := msg.Marshal(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		sent := time.Now()
+
+		if _, err := conn.WriteTo(req, &net.IPAddr{IP: opts.Addr}); err != nil {
+			return nil, err
+		}
+
+		stats.Sent++
+
+		reply := PingReply{Seq: seq}
+
+		for {
+			m, from, n, ttl, err := conn.readMessage(buf, sent.Add(opts.Timeout))
+			if err != nil {
+				if isTimeout(err) {
+					reply.Lost = true
+
+					break
+				}
+
+				return nil, err
+			}
+
+			rseq, isErr, ok := matchEcho(family, m, id)
+			if !ok || rseq != seq&0xffff {
+				// Unrelated message or a late reply to a previous request
+				continue
+			}
+
+			reply.From = from
+
+			if isErr {
+				reply.Error = describeICMP(m)
+			} else {
+				reply.Size = n
+				reply.TTL = ttl
+				reply.RTT = time.Since(sent)
+
+				stats.add(reply.RTT)
+			}
+
+			break
+		}
+
+		if err := fn(&reply); err != nil {
+			return nil, err
+		}
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,153 @@
+package netdiag
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+type TracerouteOptions struct {
+	Addr    net.IP
+	Port    int // destination port of the first probe
+	MaxHops int
+	Queries int           // number of probes per hop
+	Timeout time.Duration // time to wait for each probe
+}
+
+// TracerouteHop describes the results of the probes sent with the same TTL.
+type TracerouteHop struct {
+	TTL    int
+	Probes []*TracerouteProbe
+}
+
+type TracerouteProbe struct {
+	From net.IP
+	RTT  time.Duration
+
+	// Lost is set if there is no reply within the timeout
+	Lost bool
+
+	// Error describes an ICMP error other than Time Exceeded
+	// and Port Unreachable, e.g. Host Unreachable
+	Error string
+}
+
+type TracerouteStats struct {
+	Addr    net.IP
+	Hops    int
+	Reached bool
+}
+
+// Traceroute sends UDP probes with increasing TTL and calls fn for each hop.
+// The replies are received using a raw ICMP socket. The trace stops when
+// the destination or an unreachable error is received, or when MaxHops
+// is exceeded.
+func Traceroute(ctx context.Context, opts *TracerouteOptions, fn func(*TracerouteHop) error) (*TracerouteStats, error) {
+	family := familyOf(opts.Addr)
+
+	icmpConn, err := listenICMP(family)
+	if err != nil {
+		return nil, err
+	}
+	defer icmpConn.Close()
+
+	network := "udp4"
+	if family == 6 {
+		network = "udp6"
+	}
+
+	udpConn, err := net.ListenPacket(network, "")
+	if err != nil {
+		return nil, err
+	}
+	defer udpConn.Close()
+
+	setTTL := func(ttl int) error {
+		if family == 4 {
+			return ipv4.NewPacketConn(udpConn).SetTTL(ttl)
+		}
+
+		return ipv6.NewPacketConn(udpConn).SetHopLimit(ttl)
+	}
+
+	srcPort := udpConn.LocalAddr().(*net.UDPAddr).Port
+
+	buf := make([]byte, 1500)
+
+	stats := TracerouteStats{Addr: opts.Addr}
+
+	for ttl := 1; ttl <= opts.MaxHops; ttl++ {
+		if err := setTTL(ttl); err != nil {
+			return nil, err
+		}
+
+		hop := TracerouteHop{TTL: ttl}
+
+		var stop bool
+
+		for q := 0; q < opts.Queries; q++ {
+			if err := ctx.Err(); err != nil {
+				return &stats, err
+			}
+
+			// Each probe has its own destination port,
+			// so the replies can be matched to the probes
+			port := opts.Port + (ttl-1)*opts.Queries + q
+
+			sent := time.Now()
+
+			if _, err := udpConn.WriteTo(nil, &net.UDPAddr{IP: opts.Addr, Port: port}); err != nil {
+				return nil, err
+			}
+
+			probe := TracerouteProbe{}
+
+			for {
+				m, from, _, _, err := icmpConn.readMessage(buf, sent.Add(opts.Timeout))
+				if err != nil {
+					if isTimeout(err) {
+						probe.Lost = true
+
+						break
+					}
+
+					return nil, err
+				}
+
+				if p, ok := matchProbe(family, m, opts.Addr, srcPort); !ok || p != port {
+					continue
+				}
+
+				probe.From = from
+				probe.RTT = time.Since(sent)
+
+				if isPortUnreach(m) {
+					stats.Reached = from.Equal(opts.Addr)
+					stop = true
+				} else if isDstUnreach(m) {
+					probe.Error = describeICMP(m)
+					stop = true
+				}
+
+				break
+			}
+
+			hop.Probes = append(hop.Probes, &probe)
+		}
+
+		stats.Hops = ttl
+
+		if err := fn(&hop); err != nil {
+			return nil, err
+		}
+
+		if stop {
+			break
+		}
+	}
+
+	return &stats, nil
+}
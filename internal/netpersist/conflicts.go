@@ -0,0 +1,393 @@
+package netpersist
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// disabledPrefix marks the ifupdown lines commented out by the agent
+const disabledPrefix = "#pga# "
+
+// disableOtherDefinitions makes the backend ignore the definitions of
+// the interface other than the one in fname, so that the written
+// configuration is the only one in effect. It returns the list of
+// the modified files.
+func disableOtherDefinitions(b Backend, ifname, fname string) ([]string, error) {
+	switch b {
+	case Netplan:
+		return disableNetplanDefinitions(ifname, fname)
+	case NetworkManager:
+		return disableKeyfileProfiles(ifname, fname)
+	case Ifupdown:
+		return disableIfupdownStanzas(ifname, fname)
+	}
+
+	// systemd-networkd uses the first matching file in the lexical order,
+	// and the agent file has a low prefix
+	return nil, nil
+}
+
+// disableNetplanDefinitions removes the interface from the other netplan files
+// since netplan merges all of them. A copy of the original file is kept
+// with the .pga-orig suffix.
+func disableNetplanDefinitions(ifname, fname string) ([]string, error) {
+	files, err := filepath.Glob("/etc/netplan/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	var modified []string
+
+	for _, f := range files {
+		if f == fname {
+			continue
+		}
+
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return modified, err
+		}
+
+		newData, ok, err := removeNetplanInterface(data, ifname)
+		if err != nil {
+			return modified, err
+		}
+		if !ok {
+			continue
+		}
+
+		if _, err := os.Stat(f + ".pga-orig"); os.IsNotExist(err) {
+			if err := writeFile(f+".pga-orig", data, 0600); err != nil {
+				return modified, err
+			}
+		}
+
+		if err := writeFile(f, newData, 0600); err != nil {
+			return modified, err
+		}
+
+		modified = append(modified, f)
+	}
+
+	return modified, nil
+}
+
+// removeNetplanInterface removes the ethernet definition of the interface
+// (matched by its ID or set-name) from the netplan config. It reports
+// whether the config was changed. The comments are not preserved.
+func removeNetplanInterface(data []byte, ifname string) ([]byte, bool, error) {
+	var doc yaml.MapSlice
+
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, false, err
+	}
+
+	network, ok := lookupMapSlice(doc, "network")
+	if !ok {
+		return nil, false, nil
+	}
+
+	ethernets, ok := lookupMapSlice(network, "ethernets")
+	if !ok {
+		return nil, false, nil
+	}
+
+	res := make(yaml.MapSlice, 0, len(ethernets))
+
+	for _, item := range ethernets {
+		if item.Key == ifname {
+			continue
+		}
+
+		if v, ok := item.Value.(yaml.MapSlice); ok {
+			if name, ok := lookupValue(v, "set-name"); ok && name == ifname {
+				continue
+			}
+		}
+
+		res = append(res, item)
+	}
+
+	if len(res) == len(ethernets) {
+		return nil, false, nil
+	}
+
+	if len(res) > 0 {
+		setValue(network, "ethernets", res)
+	} else {
+		network = deleteKey(network, "ethernets")
+	}
+
+	setValue(doc, "network", network)
+
+	b, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return b, true, nil
+}
+
+func lookupValue(m yaml.MapSlice, key string) (interface{}, bool) {
+	for _, item := range m {
+		if item.Key == key {
+			return item.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+func lookupMapSlice(m yaml.MapSlice, key string) (yaml.MapSlice, bool) {
+	if v, ok := lookupValue(m, key); ok {
+		ms, ok := v.(yaml.MapSlice)
+
+		return ms, ok
+	}
+
+	return nil, false
+}
+
+func setValue(m yaml.MapSlice, key string, value interface{}) {
+	for i := range m {
+		if m[i].Key == key {
+			m[i].Value = value
+		}
+	}
+}
+
+func deleteKey(m yaml.MapSlice, key string) yaml.MapSlice {
+	res := make(yaml.MapSlice, 0, len(m))
+
+	for _, item := range m {
+		if item.Key != key {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
+
+// disableKeyfileProfiles turns off autoconnect of the other NetworkManager
+// profiles bound to the interface, so that the agent profile is activated
+// at boot. The profile that is currently active remains active until
+// the interface is reactivated.
+func disableKeyfileProfiles(ifname, fname string) ([]string, error) {
+	files, err := filepath.Glob("/etc/NetworkManager/system-connections/*")
+	if err != nil {
+		return nil, err
+	}
+
+	var modified []string
+
+	for _, f := range files {
+		if f == fname || strings.HasSuffix(f, ".tmp") {
+			continue
+		}
+
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return modified, err
+		}
+
+		newData, ok := disableKeyfileAutoconnect(data, ifname)
+		if !ok {
+			continue
+		}
+
+		if err := writeFile(f, newData, 0600); err != nil {
+			return modified, err
+		}
+
+		modified = append(modified, f)
+	}
+
+	// NetworkManager does not watch the profile files by default
+	if _, err := exec.LookPath("nmcli"); err == nil {
+		if err := exec.Command("nmcli", "connection", "reload").Run(); err != nil {
+			return modified, err
+		}
+	}
+
+	return modified, nil
+}
+
+// disableKeyfileAutoconnect sets autoconnect=false in the profile
+// if it is bound to the interface. It reports whether the profile
+// was changed.
+func disableKeyfileAutoconnect(data []byte, ifname string) ([]byte, bool) {
+	lines := strings.Split(string(data), "\n")
+
+	// Returns the key and value of the [connection] section option
+	connectionKey := func(section, line string) (string, string) {
+		if section != "connection" {
+			return "", ""
+		}
+
+		key, value, _ := strings.Cut(line, "=")
+
+		return strings.TrimSpace(key), strings.TrimSpace(value)
+	}
+
+	var section string
+	var bound bool
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = line[1 : len(line)-1]
+			continue
+		}
+
+		switch key, value := connectionKey(section, line); key {
+		case "interface-name":
+			bound = value == ifname
+		case "autoconnect":
+			if value == "false" {
+				return nil, false
+			}
+		}
+	}
+
+	if !bound {
+		return nil, false
+	}
+
+	res := make([]string, 0, len(lines)+1)
+
+	section = ""
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+			section = trimmed[1 : len(trimmed)-1]
+
+			res = append(res, line)
+
+			if section == "connection" {
+				res = append(res, "autoconnect=false")
+			}
+
+			continue
+		}
+
+		if key, _ := connectionKey(section, trimmed); key == "autoconnect" {
+			continue
+		}
+
+		res = append(res, line)
+	}
+
+	return []byte(strings.Join(res, "\n")), true
+}
+
+// disableIfupdownStanzas comments out the stanzas of the interface
+// in the main ifupdown config and in the other files of interfaces.d.
+func disableIfupdownStanzas(ifname, fname string) ([]string, error) {
+	files, err := filepath.Glob("/etc/network/interfaces.d/*")
+	if err != nil {
+		return nil, err
+	}
+
+	var modified []string
+
+	for _, f := range append([]string{"/etc/network/interfaces"}, files...) {
+		if f == fname || strings.HasSuffix(f, ".tmp") {
+			continue
+		}
+
+		fi, err := os.Stat(f)
+		if err != nil {
+			return modified, err
+		}
+
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return modified, err
+		}
+
+		newData, ok := commentOutIfupdownStanzas(data, ifname)
+		if !ok {
+			continue
+		}
+
+		if err := writeFile(f, newData, fi.Mode().Perm()); err != nil {
+			return modified, err
+		}
+
+		modified = append(modified, f)
+	}
+
+	return modified, nil
+}
+
+// commentOutIfupdownStanzas comments out the iface stanzas of the interface
+// and removes it from the auto/allow-* lines. It reports whether
+// the config was changed.
+func commentOutIfupdownStanzas(data []byte, ifname string) ([]byte, bool) {
+	var buf bytes.Buffer
+	var inStanza, changed bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			buf.WriteString(line + "\n")
+			continue
+		}
+
+		switch kw := fields[0]; {
+		case kw == "iface", kw == "mapping", kw == "source", kw == "source-directory", kw == "rename":
+			inStanza = kw == "iface" && len(fields) > 1 && fields[1] == ifname
+		case kw == "auto", kw == "no-auto-down", kw == "no-scripts", strings.HasPrefix(kw, "allow-"):
+			inStanza = false
+
+			others := make([]string, 0, len(fields)-1)
+
+			for _, name := range fields[1:] {
+				if name != ifname {
+					others = append(others, name)
+				}
+			}
+
+			if len(others) != len(fields)-1 {
+				buf.WriteString(disabledPrefix + line + "\n")
+
+				if len(others) > 0 {
+					buf.WriteString(kw + " " + strings.Join(others, " ") + "\n")
+				}
+
+				changed = true
+
+				continue
+			}
+		}
+
+		if inStanza {
+			buf.WriteString(disabledPrefix + line + "\n")
+
+			changed = true
+
+			continue
+		}
+
+		buf.WriteString(line + "\n")
+	}
+
+	return buf.Bytes(), changed
+}
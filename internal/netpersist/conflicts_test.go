@@ -0,0 +1,147 @@
+package netpersist
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRemoveNetplanInterface(t *testing.T) {
+	data := `# This file is generated from information provided by the datasource.
+network:
+  version: 2
+  ethernets:
+    eth0:
+      dhcp4: true
+      match:
+        macaddress: "52:54:00:12:34:56"
+    nic1:
+      set-name: eth1
+      dhcp4: true
+`
+
+	b, ok, err := removeNetplanInterface([]byte(data), "eth0")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("eth0 was not removed")
+	}
+
+	want := `network:
+  version: 2
+  ethernets:
+    nic1:
+      set-name: eth1
+      dhcp4: true
+`
+
+	if string(b) != want {
+		t.Fatalf("got invalid netplan config:\nwant:\n%s\ngot:\n%s", want, b)
+	}
+
+	// Matched by set-name, the ethernets section becomes empty
+	b, ok, err = removeNetplanInterface(b, "eth1")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("eth1 was not removed")
+	}
+
+	if want := "network:\n  version: 2\n"; string(b) != want {
+		t.Fatalf("got invalid netplan config:\nwant:\n%s\ngot:\n%s", want, b)
+	}
+
+	if _, ok, _ := removeNetplanInterface([]byte(data), "eth2"); ok {
+		t.Fatalf("the config was changed unexpectedly")
+	}
+}
+
+func TestDisableKeyfileAutoconnect(t *testing.T) {
+	data := `[connection]
+id=Wired connection 1
+type=ethernet
+autoconnect-priority=-999
+autoconnect=true
+interface-name=eth0
+
+[ipv4]
+method=auto
+`
+
+	b, ok := disableKeyfileAutoconnect([]byte(data), "eth0")
+	if !ok {
+		t.Fatalf("the profile was not changed")
+	}
+
+	want := `[connection]
+autoconnect=false
+id=Wired connection 1
+type=ethernet
+autoconnect-priority=-999
+interface-name=eth0
+
+[ipv4]
+method=auto
+`
+
+	if string(b) != want {
+		t.Fatalf("got invalid keyfile:\nwant:\n%s\ngot:\n%s", want, b)
+	}
+
+	// Already disabled
+	if _, ok := disableKeyfileAutoconnect(b, "eth0"); ok {
+		t.Fatalf("the disabled profile was changed")
+	}
+
+	// Bound to another interface
+	if _, ok := disableKeyfileAutoconnect([]byte(data), "eth1"); ok {
+		t.Fatalf("the profile of another interface was changed")
+	}
+}
+
+func TestCommentOutIfupdownStanzas(t *testing.T) {
+	data := `source /etc/network/interfaces.d/*
+
+auto lo eth0
+iface lo inet loopback
+
+allow-hotplug eth0
+iface eth0 inet dhcp
+    mtu 1500
+
+iface eth0 inet6 auto
+
+# The secondary interface
+iface eth1 inet manual
+`
+
+	b, ok := commentOutIfupdownStanzas([]byte(data), "eth0")
+	if !ok {
+		t.Fatalf("the config was not changed")
+	}
+
+	want := strings.ReplaceAll(`source /etc/network/interfaces.d/*
+
+PFXauto lo eth0
+auto lo
+iface lo inet loopback
+
+PFXallow-hotplug eth0
+PFXiface eth0 inet dhcp
+PFX    mtu 1500
+
+PFXiface eth0 inet6 auto
+
+# The secondary interface
+iface eth1 inet manual
+`, "PFX", disabledPrefix)
+
+	if string(b) != want {
+		t.Fatalf("got invalid ifupdown config:\nwant:\n%s\ngot:\n%s", want, b)
+	}
+
+	if _, ok := commentOutIfupdownStanzas(b, "eth0"); ok {
+		t.Fatalf("the disabled config was changed")
+	}
+}
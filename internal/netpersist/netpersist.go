@@ -0,0 +1,616 @@
+package netpersist
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+var ErrNoBackend = errors.New("unable to detect the network configuration backend")
+
+type Backend string
+
+const (
+	Netplan        Backend = "netplan"
+	NetworkManager Backend = "networkmanager"
+	Networkd       Backend = "systemd-networkd"
+	Ifupdown       Backend = "ifupdown"
+)
+
+const header = "Generated by phoenix-guest-agent. Do not edit, the changes will be overwritten"
+
+// Interface describes the configuration of a network interface.
+// Addrs and Routes contain only the static part of the configuration,
+// the dynamic one is enabled by DHCP4 and DHCP6.
+type Interface struct {
+	Name   string
+	MTU    int
+	Addrs  []string // in CIDR notation
+	Routes []*Route
+
+	// Rules are the policy rules that look up
+	// the non-main tables used by Routes
+	Rules []*Rule
+
+	// DHCP4 enables the DHCPv4 client
+	DHCP4 bool
+
+	// DHCP6 enables the IPv6 autoconfiguration (SLAAC and DHCPv6)
+	DHCP6 bool
+}
+
+type Route struct {
+	Dst    string // in CIDR notation, "0.0.0.0/0" or "::/0" for the default route
+	Gw     string // empty for the link scope routes
+	Metric int
+	Table  int
+	OnLink bool
+}
+
+type Rule struct {
+	Priority int
+	From     string // in CIDR notation, empty means "all"
+	To       string // in CIDR notation, empty means "all"
+	Mark     uint32
+	Table    int
+	IPv6     bool
+}
+
+// ipRuleSelector returns the rule in the "ip rule" syntax.
+func (r *Rule) ipRuleSelector() string {
+	args := make([]string, 0, 10)
+
+	if r.Priority > 0 {
+		args = append(args, "priority", fmt.Sprintf("%d", r.Priority))
+	}
+	if len(r.From) > 0 {
+		args = append(args, "from", r.From)
+	}
+	if len(r.To) > 0 {
+		args = append(args, "to", r.To)
+	}
+	if r.Mark > 0 {
+		args = append(args, "fwmark", fmt.Sprintf("0x%x", r.Mark))
+	}
+
+	args = append(args, "table", fmt.Sprintf("%d", r.Table))
+
+	return strings.Join(args, " ")
+}
+
+func (r *Route) isDefault() bool {
+	return r.Dst == "0.0.0.0/0" || r.Dst == "::/0"
+}
+
+func (r *Route) isIPv6() bool {
+	return strings.Contains(r.Dst, ":")
+}
+
+// DetectBackend returns the backend that manages the network configuration
+// of the guest. Netplan takes precedence since it generates the configuration
+// for networkd and NetworkManager.
+func DetectBackend() (Backend, error) {
+	exists := func(p string) bool {
+		_, err := os.Stat(p)
+		return err == nil
+	}
+
+	if _, err := exec.LookPath("netplan"); err == nil && exists("/etc/netplan") {
+		if files, _ := filepath.Glob("/etc/netplan/*.yaml"); len(files) > 0 {
+			return Netplan, nil
+		}
+	}
+
+	switch {
+	case exists("/run/NetworkManager") && exists("/etc/NetworkManager/system-connections"):
+		return NetworkManager, nil
+	case exists("/run/systemd/netif") && exists("/etc/systemd/network"):
+		return Networkd, nil
+	case exists("/etc/network/interfaces"):
+		return Ifupdown, nil
+	}
+
+	return "", ErrNoBackend
+}
+
+// Write renders the interface configuration for the backend and
+// saves it into the backend configuration directory. The other
+// definitions of the interface are disabled then, see disableOtherDefinitions.
+// It returns the path of the written file and the list of the modified ones.
+func Write(b Backend, iface *Interface) (string, []string, error) {
+	var fname string
+	var data []byte
+	var err error
+
+	switch b {
+	case Netplan:
+		fname = filepath.Join("/etc/netplan", "90-pga-"+iface.Name+".yaml")
+		data, err = renderNetplan(iface)
+	case NetworkManager:
+		fname = filepath.Join("/etc/NetworkManager/system-connections", "pga-"+iface.Name+".nmconnection")
+		data, err = renderKeyfile(iface)
+	case Networkd:
+		fname = filepath.Join("/etc/systemd/network", "10-pga-"+iface.Name+".network")
+		data, err = renderNetworkd(iface)
+	case Ifupdown:
+		if err := checkInterfacesDirSourced("/etc/network/interfaces"); err != nil {
+			return "", nil, err
+		}
+		fname = filepath.Join("/etc/network/interfaces.d", "pga-"+iface.Name)
+		data, err = renderIfupdown(iface)
+	default:
+		return "", nil, fmt.Errorf("unknown backend: %s", b)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Netplan and NetworkManager refuse to use world-readable files
+	if err := writeFile(fname, data, 0600); err != nil {
+		return "", nil, err
+	}
+
+	modified, err := disableOtherDefinitions(b, iface.Name, fname)
+	if err != nil {
+		return "", modified, fmt.Errorf("unable to disable the other definitions of %s: %w", iface.Name, err)
+	}
+
+	return fname, modified, nil
+}
+
+func writeFile(fname string, data []byte, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+		return err
+	}
+
+	tmp := fname + ".tmp"
+
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, fname); err != nil {
+		os.Remove(tmp)
+
+		return err
+	}
+
+	return nil
+}
+
+func splitAddrs(addrs []string) (ip4, ip6 []string) {
+	for _, a := range addrs {
+		if strings.Contains(a, ":") {
+			ip6 = append(ip6, a)
+		} else {
+			ip4 = append(ip4, a)
+		}
+	}
+
+	return ip4, ip6
+}
+
+type netplanRoute struct {
+	To     string `yaml:"to"`
+	Via    string `yaml:"via,omitempty"`
+	Metric int    `yaml:"metric,omitempty"`
+	Table  int    `yaml:"table,omitempty"`
+	OnLink bool   `yaml:"on-link,omitempty"`
+	Scope  string `yaml:"scope,omitempty"`
+}
+
+type netplanRule struct {
+	From     string `yaml:"from,omitempty"`
+	To       string `yaml:"to,omitempty"`
+	Mark     uint32 `yaml:"mark,omitempty"`
+	Table    int    `yaml:"table"`
+	Priority int    `yaml:"priority,omitempty"`
+}
+
+type netplanEthernet struct {
+	DHCP4         bool            `yaml:"dhcp4,omitempty"`
+	DHCP6         bool            `yaml:"dhcp6,omitempty"`
+	MTU           int             `yaml:"mtu,omitempty"`
+	Addresses     []string        `yaml:"addresses,omitempty"`
+	Routes        []*netplanRoute `yaml:"routes,omitempty"`
+	RoutingPolicy []*netplanRule  `yaml:"routing-policy,omitempty"`
+}
+
+func renderNetplan(iface *Interface) ([]byte, error) {
+	eth := netplanEthernet{
+		DHCP4:     iface.DHCP4,
+		DHCP6:     iface.DHCP6,
+		MTU:       iface.MTU,
+		Addresses: iface.Addrs,
+	}
+
+	for _, r := range iface.Routes {
+		nr := netplanRoute{
+			To:     r.Dst,
+			Via:    r.Gw,
+			Metric: r.Metric,
+			Table:  r.Table,
+			OnLink: r.OnLink,
+		}
+
+		if r.isDefault() {
+			nr.To = "default"
+		}
+
+		if len(r.Gw) == 0 {
+			nr.Scope = "link"
+		}
+
+		eth.Routes = append(eth.Routes, &nr)
+	}
+
+	for _, r := range iface.Rules {
+		// Netplan cannot tell the address family of the rule otherwise
+		if len(r.From) == 0 && len(r.To) == 0 {
+			return nil, fmt.Errorf("policy rules without from/to are not supported for netplan: %s", r.ipRuleSelector())
+		}
+
+		eth.RoutingPolicy = append(eth.RoutingPolicy, &netplanRule{
+			From:     r.From,
+			To:       r.To,
+			Mark:     r.Mark,
+			Table:    r.Table,
+			Priority: r.Priority,
+		})
+	}
+
+	var v struct {
+		Network struct {
+			Version   int                         `yaml:"version"`
+			Ethernets map[string]*netplanEthernet `yaml:"ethernets"`
+		} `yaml:"network"`
+	}
+
+	v.Network.Version = 2
+	v.Network.Ethernets = map[string]*netplanEthernet{iface.Name: &eth}
+
+	b, err := yaml.Marshal(&v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("# "+header+"\n"), b...), nil
+}
+
+func renderNetworkd(iface *Interface) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "# %s\n\n", header)
+	fmt.Fprintf(&buf, "[Match]\nName=%s\n", iface.Name)
+
+	if iface.MTU > 0 {
+		fmt.Fprintf(&buf, "\n[Link]\nMTUBytes=%d\n", iface.MTU)
+	}
+
+	// DHCP is set explicitly, otherwise networkd
+	// may start it on the statically configured interface
+	var dhcp string
+
+	switch {
+	case iface.DHCP4 && iface.DHCP6:
+		dhcp = "yes"
+	case iface.DHCP4:
+		dhcp = "ipv4"
+	case iface.DHCP6:
+		dhcp = "ipv6"
+	default:
+		dhcp = "no"
+	}
+
+	fmt.Fprintf(&buf, "\n[Network]\nDHCP=%s\n", dhcp)
+
+	if iface.DHCP6 {
+		fmt.Fprintf(&buf, "IPv6AcceptRA=yes\n")
+	} else if _, ip6 := splitAddrs(iface.Addrs); len(ip6) > 0 {
+		fmt.Fprintf(&buf, "IPv6AcceptRA=no\n")
+	}
+
+	for _, a := range iface.Addrs {
+		fmt.Fprintf(&buf, "Address=%s\n", a)
+	}
+
+	for _, r := range iface.Routes {
+		fmt.Fprintf(&buf, "\n[Route]\nDestination=%s\n", r.Dst)
+
+		if len(r.Gw) > 0 {
+			fmt.Fprintf(&buf, "Gateway=%s\n", r.Gw)
+		} else {
+			fmt.Fprintf(&buf, "Scope=link\n")
+		}
+		if r.OnLink {
+			fmt.Fprintf(&buf, "GatewayOnLink=yes\n")
+		}
+		if r.Metric > 0 {
+			fmt.Fprintf(&buf, "Metric=%d\n", r.Metric)
+		}
+		if r.Table > 0 {
+			fmt.Fprintf(&buf, "Table=%d\n", r.Table)
+		}
+	}
+
+	for _, r := range iface.Rules {
+		fmt.Fprintf(&buf, "\n[RoutingPolicyRule]\n")
+
+		if len(r.From) > 0 {
+			fmt.Fprintf(&buf, "From=%s\n", r.From)
+		}
+		if len(r.To) > 0 {
+			fmt.Fprintf(&buf, "To=%s\n", r.To)
+		}
+		if len(r.From) == 0 && len(r.To) == 0 && r.IPv6 {
+			fmt.Fprintf(&buf, "Family=ipv6\n")
+		}
+		if r.Mark > 0 {
+			fmt.Fprintf(&buf, "FirewallMark=%d\n", r.Mark)
+		}
+		if r.Priority > 0 {
+			fmt.Fprintf(&buf, "Priority=%d\n", r.Priority)
+		}
+
+		fmt.Fprintf(&buf, "Table=%d\n", r.Table)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func renderKeyfile(iface *Interface) ([]byte, error) {
+	var buf bytes.Buffer
+
+	id := "pga-" + iface.Name
+
+	fmt.Fprintf(&buf, "# %s\n\n", header)
+	fmt.Fprintf(&buf, "[connection]\nid=%s\nuuid=%s\ntype=ethernet\ninterface-name=%s\nautoconnect-priority=100\n", id, uuid.NewSHA1(uuid.NameSpaceOID, []byte(id)), iface.Name)
+
+	if iface.MTU > 0 {
+		fmt.Fprintf(&buf, "\n[ethernet]\nmtu=%d\n", iface.MTU)
+	}
+
+	ip4, ip6 := splitAddrs(iface.Addrs)
+
+	for _, family := range []struct {
+		name   string
+		addrs  []string
+		ipv6   bool
+		auto   bool
+		noAddr string
+	}{
+		{"ipv4", ip4, false, iface.DHCP4, "disabled"},
+		{"ipv6", ip6, true, iface.DHCP6, "ignore"},
+	} {
+		routes := make([]*Route, 0, len(iface.Routes))
+
+		for _, r := range iface.Routes {
+			if r.isIPv6() == family.ipv6 {
+				routes = append(routes, r)
+			}
+		}
+
+		fmt.Fprintf(&buf, "\n[%s]\n", family.name)
+
+		switch {
+		case family.auto:
+			// The static addresses and routes are added to the dynamic ones
+			fmt.Fprintf(&buf, "method=auto\n")
+		case len(family.addrs) == 0 && len(routes) == 0:
+			fmt.Fprintf(&buf, "method=%s\n", family.noAddr)
+			continue
+		default:
+			fmt.Fprintf(&buf, "method=manual\n")
+		}
+
+		for i, a := range family.addrs {
+			fmt.Fprintf(&buf, "address%d=%s\n", i+1, a)
+		}
+
+		for i, r := range routes {
+			// route1=DST,GW,METRIC
+			v := r.Dst
+			if len(r.Gw) > 0 || r.Metric > 0 {
+				v += "," + r.Gw
+			}
+			if r.Metric > 0 {
+				v += fmt.Sprintf(",%d", r.Metric)
+			}
+
+			fmt.Fprintf(&buf, "route%d=%s\n", i+1, v)
+
+			opts := make([]string, 0, 2)
+
+			if r.Table > 0 {
+				opts = append(opts, fmt.Sprintf("table=%d", r.Table))
+			}
+			if r.OnLink {
+				opts = append(opts, "onlink=true")
+			}
+
+			if len(opts) > 0 {
+				fmt.Fprintf(&buf, "route%d_options=%s\n", i+1, strings.Join(opts, ","))
+			}
+		}
+
+		var n int
+
+		for _, r := range iface.Rules {
+			if r.IPv6 == family.ipv6 {
+				n++
+
+				// NetworkManager requires the priority to be set explicitly
+				fmt.Fprintf(&buf, "routing-rule%d=%s\n", n, r.ipRuleSelector())
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func renderIfupdown(iface *Interface) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "# %s\n\n", header)
+	fmt.Fprintf(&buf, "auto %s\n", iface.Name)
+
+	ip4, ip6 := splitAddrs(iface.Addrs)
+
+	stanza := func(family string, addrs []string, auto bool) {
+		method := "static"
+
+		switch {
+		case auto && family == "inet":
+			method = "dhcp"
+		case auto:
+			method = "auto"
+		case len(addrs) == 0:
+			method = "manual"
+		}
+
+		fmt.Fprintf(&buf, "iface %s %s %s\n", iface.Name, family, method)
+
+		if method == "static" {
+			fmt.Fprintf(&buf, "    address %s\n", addrs[0])
+			addrs = addrs[1:]
+		}
+
+		if family == "inet6" && auto {
+			// Both SLAAC and stateful DHCPv6 depending on the RA flags
+			fmt.Fprintf(&buf, "    dhcp 1\n")
+		}
+
+		if iface.MTU > 0 && family == "inet" {
+			fmt.Fprintf(&buf, "    mtu %d\n", iface.MTU)
+		}
+
+		// Only one address is allowed per static stanza
+		for _, a := range addrs {
+			fmt.Fprintf(&buf, "    up ip addr add %s dev %s\n", a, iface.Name)
+		}
+	}
+
+	stanza("inet", ip4, iface.DHCP4)
+
+	for _, r := range iface.Routes {
+		if r.isIPv6() {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "    up %s\n", ipRouteCommand(iface.Name, r))
+	}
+
+	for _, r := range iface.Rules {
+		if !r.IPv6 {
+			writeIfupdownRule(&buf, r)
+		}
+	}
+
+	if len(ip6) > 0 || iface.DHCP6 {
+		stanza("inet6", ip6, iface.DHCP6)
+	}
+
+	for _, r := range iface.Routes {
+		if !r.isIPv6() {
+			continue
+		}
+
+		if len(ip6) == 0 && !iface.DHCP6 {
+			// There is no inet6 stanza, so all routes go to the inet one
+			return nil, fmt.Errorf("IPv6 routes without IPv6 addresses are not supported for ifupdown: %s", r.Dst)
+		}
+
+		fmt.Fprintf(&buf, "    up %s\n", ipRouteCommand(iface.Name, r))
+	}
+
+	for _, r := range iface.Rules {
+		if !r.IPv6 {
+			continue
+		}
+
+		if len(ip6) == 0 && !iface.DHCP6 {
+			return nil, fmt.Errorf("IPv6 policy rules without IPv6 addresses are not supported for ifupdown: %s", r.ipRuleSelector())
+		}
+
+		writeIfupdownRule(&buf, r)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeIfupdownRule adds the rule when the interface is brought up
+// and removes it on ifdown: unlike the routes, the rules are not bound
+// to the link and remain in the kernel.
+func writeIfupdownRule(buf *bytes.Buffer, r *Rule) {
+	cmd := "ip"
+	if r.IPv6 {
+		cmd += " -6"
+	}
+
+	fmt.Fprintf(buf, "    up %s rule add %s\n", cmd, r.ipRuleSelector())
+	fmt.Fprintf(buf, "    down %s rule del %s\n", cmd, r.ipRuleSelector())
+}
+
+func ipRouteCommand(ifname string, r *Route) string {
+	args := []string{"ip"}
+
+	if r.isIPv6() {
+		args = append(args, "-6")
+	}
+
+	args = append(args, "route", "replace", r.Dst)
+
+	if len(r.Gw) > 0 {
+		args = append(args, "via", r.Gw)
+	}
+
+	args = append(args, "dev", ifname)
+
+	if r.Metric > 0 {
+		args = append(args, "metric", fmt.Sprintf("%d", r.Metric))
+	}
+	if r.Table > 0 {
+		args = append(args, "table", fmt.Sprintf("%d", r.Table))
+	}
+	if r.OnLink {
+		args = append(args, "onlink")
+	}
+
+	return strings.Join(args, " ")
+}
+
+// checkInterfacesDirSourced makes sure that the files from /etc/network/interfaces.d
+// are included into the main ifupdown configuration.
+func checkInterfacesDirSourced(fname string) error {
+	f, err := os.Open(fname)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 2 && (fields[0] == "source" || fields[0] == "source-directory") {
+			if strings.HasPrefix(fields[1], "/etc/network/interfaces.d") || strings.HasPrefix(fields[1], "interfaces.d") {
+				return nil
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%s does not include /etc/network/interfaces.d", fname)
+}
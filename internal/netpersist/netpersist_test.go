@@ -0,0 +1,283 @@
+package netpersist
+
+import (
+	"strings"
+	"testing"
+)
+
+var testIface = Interface{
+	Name:  "eth0",
+	MTU:   9000,
+	Addrs: []string{"192.168.0.10/24", "2001:db8::10/64"},
+	Routes: []*Route{
+		{Dst: "0.0.0.0/0", Gw: "192.168.0.1"},
+		{Dst: "10.0.0.0/8", Gw: "192.168.0.254", Metric: 100, Table: 200, OnLink: true},
+		{Dst: "::/0", Gw: "2001:db8::1"},
+	},
+}
+
+func TestRenderNetworkd(t *testing.T) {
+	b, err := renderNetworkd(&testIface)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	want := `[Match]
+Name=eth0
+
+[Link]
+MTUBytes=9000
+
+[Network]
+DHCP=no
+IPv6AcceptRA=no
+Address=192.168.0.10/24
+Address=2001:db8::10/64
+
+[Route]
+Destination=0.0.0.0/0
+Gateway=192.168.0.1
+
+[Route]
+Destination=10.0.0.0/8
+Gateway=192.168.0.254
+GatewayOnLink=yes
+Metric=100
+Table=200
+
+[Route]
+Destination=::/0
+Gateway=2001:db8::1
+`
+
+	if got := stripHeader(b); got != want {
+		t.Fatalf("got invalid networkd config:\nwant:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestRenderNetplan(t *testing.T) {
+	b, err := renderNetplan(&testIface)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	want := `network:
+  version: 2
+  ethernets:
+    eth0:
+      mtu: 9000
+      addresses:
+      - 192.168.0.10/24
+      - 2001:db8::10/64
+      routes:
+      - to: default
+        via: 192.168.0.1
+      - to: 10.0.0.0/8
+        via: 192.168.0.254
+        metric: 100
+        table: 200
+        on-link: true
+      - to: default
+        via: 2001:db8::1
+`
+
+	if got := stripHeader(b); got != want {
+		t.Fatalf("got invalid netplan config:\nwant:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestRenderKeyfile(t *testing.T) {
+	b, err := renderKeyfile(&testIface)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	for _, line := range []string{
+		"interface-name=eth0",
+		"mtu=9000",
+		"address1=192.168.0.10/24",
+		"route1=0.0.0.0/0,192.168.0.1",
+		"route2=10.0.0.0/8,192.168.0.254,100",
+		"route2_options=table=200,onlink=true",
+		"address1=2001:db8::10/64",
+		"route1=::/0,2001:db8::1",
+	} {
+		if !strings.Contains(string(b), line+"\n") {
+			t.Fatalf("line %q not found in keyfile:\n%s", line, b)
+		}
+	}
+
+	// The connection UUID must be stable across rewrites
+	if b2, _ := renderKeyfile(&testIface); string(b) != string(b2) {
+		t.Fatalf("got different keyfiles for the same interface")
+	}
+}
+
+func TestRenderIfupdown(t *testing.T) {
+	b, err := renderIfupdown(&testIface)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	want := `auto eth0
+iface eth0 inet static
+    address 192.168.0.10/24
+    mtu 9000
+    up ip route replace 0.0.0.0/0 via 192.168.0.1 dev eth0
+    up ip route replace 10.0.0.0/8 via 192.168.0.254 dev eth0 metric 100 table 200 onlink
+iface eth0 inet6 static
+    address 2001:db8::10/64
+    up ip -6 route replace ::/0 via 2001:db8::1 dev eth0
+`
+
+	if got := stripHeader(b); got != want {
+		t.Fatalf("got invalid ifupdown config:\nwant:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func stripHeader(b []byte) string {
+	s := strings.TrimPrefix(string(b), "# "+header+"\n")
+
+	return strings.TrimPrefix(s, "\n")
+}
+
+var testDHCPIface = Interface{
+	Name:  "eth0",
+	Addrs: []string{"192.168.1.10/24"},
+	Routes: []*Route{
+		{Dst: "10.0.0.0/8", Gw: "192.168.1.254"},
+	},
+	DHCP4: true,
+	DHCP6: true,
+}
+
+func TestRenderDHCP(t *testing.T) {
+	tests := []struct {
+		render func(*Interface) ([]byte, error)
+		want   []string
+		banned []string
+	}{
+		{
+			renderNetworkd,
+			[]string{"DHCP=yes", "IPv6AcceptRA=yes", "Address=192.168.1.10/24"},
+			[]string{"DHCP=no", "IPv6AcceptRA=no"},
+		},
+		{
+			renderNetplan,
+			[]string{"dhcp4: true", "dhcp6: true", "- 192.168.1.10/24"},
+			nil,
+		},
+		{
+			renderKeyfile,
+			[]string{"[ipv4]\nmethod=auto", "[ipv6]\nmethod=auto", "address1=192.168.1.10/24", "route1=10.0.0.0/8,192.168.1.254"},
+			[]string{"method=disabled", "method=ignore", "method=manual"},
+		},
+		{
+			renderIfupdown,
+			[]string{"iface eth0 inet dhcp", "up ip addr add 192.168.1.10/24 dev eth0", "iface eth0 inet6 auto"},
+			[]string{"inet static", "inet manual"},
+		},
+	}
+
+	for _, tt := range tests {
+		b, err := tt.render(&testDHCPIface)
+		if err != nil {
+			t.Fatalf("got unexpected error: %v", err)
+		}
+
+		for _, s := range tt.want {
+			if !strings.Contains(string(b), s+"\n") {
+				t.Fatalf("%q not found in config:\n%s", s, b)
+			}
+		}
+
+		for _, s := range tt.banned {
+			if strings.Contains(string(b), s) {
+				t.Fatalf("unexpected %q found in config:\n%s", s, b)
+			}
+		}
+	}
+
+	// Only IPv4 is dynamic
+	iface := testDHCPIface
+	iface.DHCP6 = false
+
+	b, err := renderNetworkd(&iface)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !strings.Contains(string(b), "DHCP=ipv4\n") || strings.Contains(string(b), "IPv6AcceptRA") {
+		t.Fatalf("got invalid networkd config:\n%s", b)
+	}
+}
+
+var testRulesIface = Interface{
+	Name:  "eth0",
+	Addrs: []string{"192.168.0.10/24", "2001:db8::10/64"},
+	Routes: []*Route{
+		{Dst: "0.0.0.0/0", Gw: "192.168.0.1", Table: 200},
+		{Dst: "::/0", Gw: "2001:db8::1", Table: 200},
+	},
+	Rules: []*Rule{
+		{Priority: 100, From: "192.168.0.0/24", Table: 200},
+		{Priority: 101, To: "2001:db8:1::/48", Mark: 16, Table: 200, IPv6: true},
+	},
+}
+
+func TestRenderRules(t *testing.T) {
+	tests := []struct {
+		render func(*Interface) ([]byte, error)
+		want   []string
+	}{
+		{
+			renderNetworkd,
+			[]string{
+				"[RoutingPolicyRule]\nFrom=192.168.0.0/24\nPriority=100\nTable=200",
+				"[RoutingPolicyRule]\nTo=2001:db8:1::/48\nFirewallMark=16\nPriority=101\nTable=200",
+			},
+		},
+		{
+			renderNetplan,
+			[]string{
+				"routing-policy:\n      - from: 192.168.0.0/24\n        table: 200\n        priority: 100",
+				"      - to: 2001:db8:1::/48\n        mark: 16\n        table: 200\n        priority: 101",
+			},
+		},
+		{
+			renderKeyfile,
+			[]string{
+				"routing-rule1=priority 100 from 192.168.0.0/24 table 200",
+				"routing-rule1=priority 101 to 2001:db8:1::/48 fwmark 0x10 table 200",
+			},
+		},
+		{
+			renderIfupdown,
+			[]string{
+				"    up ip rule add priority 100 from 192.168.0.0/24 table 200\n    down ip rule del priority 100 from 192.168.0.0/24 table 200",
+				"iface eth0 inet6 static\n    address 2001:db8::10/64\n    up ip -6 route replace ::/0 via 2001:db8::1 dev eth0 table 200\n    up ip -6 rule add priority 101",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		b, err := tt.render(&testRulesIface)
+		if err != nil {
+			t.Fatalf("got unexpected error: %v", err)
+		}
+
+		for _, s := range tt.want {
+			if !strings.Contains(string(b), s) {
+				t.Fatalf("%q not found in config:\n%s", s, b)
+			}
+		}
+	}
+
+	// Netplan cannot render the rules without from/to
+	iface := testRulesIface
+	iface.Rules = []*Rule{{Priority: 100, Mark: 1, Table: 200}}
+
+	if _, err := renderNetplan(&iface); err == nil {
+		t.Fatalf("expected an error for the rule without from/to")
+	}
+}
@@ -0,0 +1,248 @@
+package nftables
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrNotInstalled   = errors.New("nft tool is not installed")
+	ErrForeignObject  = errors.New("ruleset refers to an object outside the agent table")
+	ErrInvalidRuleset = errors.New("invalid nftables JSON ruleset")
+)
+
+// Error describes a failed nft command.
+type Error struct {
+	Command  string
+	ExitCode int
+	Stderr   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("nft %s failed (exit status %d): %s", e.Command, e.ExitCode, e.Stderr)
+}
+
+// Table identifies an nftables table, e.g. "inet phoenix".
+type Table struct {
+	Family string
+	Name   string
+}
+
+func (t *Table) String() string {
+	return t.Family + " " + t.Name
+}
+
+var tableNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func (t *Table) Validate() error {
+	switch t.Family {
+	case "ip", "ip6", "inet", "arp", "bridge", "netdev":
+	default:
+		return fmt.Errorf("invalid table family: %q", t.Family)
+	}
+
+	if !tableNameRe.MatchString(t.Name) {
+		return fmt.Errorf("invalid table name: %q", t.Name)
+	}
+
+	return nil
+}
+
+// ListRuleset returns the whole current ruleset in the JSON format (nft -j list ruleset).
+func ListRuleset(ctx context.Context) ([]byte, error) {
+	return run(ctx, nil, "-j", "list", "ruleset")
+}
+
+// SaveTable returns the table definition in the nft syntax
+// or an empty string if the table does not exist.
+func SaveTable(ctx context.Context, t *Table) (string, error) {
+	out, err := run(ctx, nil, "list", "table", t.Family, t.Name)
+	if err != nil {
+		var nftErr *Error
+
+		if errors.As(err, &nftErr) && strings.Contains(nftErr.Stderr, "No such file or directory") {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// ApplyTable atomically replaces the content of the table with
+// the ruleset in the nftables JSON format. The ruleset may only
+// refer to the objects of this table.
+func ApplyTable(ctx context.Context, t *Table, ruleset []byte) error {
+	b, err := buildTableRuleset(t, ruleset)
+	if err != nil {
+		return err
+	}
+
+	_, err = run(ctx, b, "-j", "-f", "/dev/stdin")
+
+	return err
+}
+
+// RestoreTable atomically replaces the table with the definition
+// returned by SaveTable. The empty definition removes the table.
+func RestoreTable(ctx context.Context, t *Table, saved string) error {
+	// Creating the table first makes the deletion safe if it does not exist
+	script := fmt.Sprintf("table %s\ndelete table %s\n%s", t, t, saved)
+
+	_, err := run(ctx, []byte(script), "-f", "/dev/stdin")
+
+	return err
+}
+
+// The command verbs allowed in the ruleset. The object without
+// a verb means "add".
+var allowedVerbs = map[string]struct{}{
+	"add":     {},
+	"create":  {},
+	"insert":  {},
+	"replace": {},
+	"flush":   {},
+	"delete":  {},
+}
+
+// buildTableRuleset checks that every command of the ruleset refers to
+// the table and prepends the commands that recreate the table, so that
+// the whole ruleset is applied as a single transaction.
+func buildTableRuleset(t *Table, ruleset []byte) ([]byte, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	var v struct {
+		Nftables []map[string]json.RawMessage `json:"nftables"`
+	}
+
+	if err := json.Unmarshal(ruleset, &v); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRuleset, err)
+	}
+
+	tableObj := map[string]interface{}{
+		"table": map[string]string{"family": t.Family, "name": t.Name},
+	}
+
+	cmds := []interface{}{
+		map[string]interface{}{"add": tableObj},
+		map[string]interface{}{"delete": tableObj},
+		map[string]interface{}{"add": tableObj},
+	}
+
+	for idx, item := range v.Nftables {
+		if len(item) != 1 {
+			return nil, fmt.Errorf("%w: nftables[%d]: expected a single command", ErrInvalidRuleset, idx)
+		}
+
+		for key, value := range item {
+			if key == "metainfo" {
+				continue
+			}
+
+			obj := value
+
+			if _, ok := allowedVerbs[key]; ok {
+				var inner map[string]json.RawMessage
+
+				if err := json.Unmarshal(value, &inner); err != nil || len(inner) != 1 {
+					return nil, fmt.Errorf("%w: nftables[%d]: expected a single object in %q", ErrInvalidRuleset, idx, key)
+				}
+
+				for k, x := range inner {
+					key, obj = k, x
+				}
+			}
+
+			if err := checkObject(t, key, obj); err != nil {
+				return nil, fmt.Errorf("nftables[%d]: %w", idx, err)
+			}
+
+			cmds = append(cmds, item)
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{"nftables": cmds})
+}
+
+func isObjectType(s string) bool {
+	switch s {
+	case "table", "chain", "rule", "set", "map", "element", "flowtable",
+		"counter", "quota", "limit", "secmark", "synproxy",
+		"ct helper", "ct timeout", "ct expectation":
+		return true
+	}
+
+	return false
+}
+
+// checkObject checks that the object belongs to the table.
+func checkObject(t *Table, objType string, obj json.RawMessage) error {
+	if !isObjectType(objType) {
+		return fmt.Errorf("%w: unsupported object %q", ErrInvalidRuleset, objType)
+	}
+
+	var attrs struct {
+		Family string `json:"family"`
+		Table  string `json:"table"`
+		Name   string `json:"name"`
+	}
+
+	if err := json.Unmarshal(obj, &attrs); err != nil {
+		return fmt.Errorf("%w: %s: %s", ErrInvalidRuleset, objType, err)
+	}
+
+	table := attrs.Table
+
+	if objType == "table" {
+		table = attrs.Name
+	}
+
+	if attrs.Family != t.Family || table != t.Name {
+		return fmt.Errorf("%w: %s in table %s %s", ErrForeignObject, objType, attrs.Family, table)
+	}
+
+	return nil
+}
+
+func run(ctx context.Context, stdin []byte, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "nft", args...)
+
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+
+		switch {
+		case errors.As(err, &exitErr):
+			return nil, &Error{
+				Command:  strings.Join(args, " "),
+				ExitCode: exitErr.ExitCode(),
+				Stderr:   strings.TrimSpace(stderr.String()),
+			}
+		case errors.Is(err, exec.ErrNotFound):
+			return nil, ErrNotInstalled
+		}
+
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,80 @@
+package nftables
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBuildTableRuleset(t *testing.T) {
+	table := Table{Family: "inet", Name: "phoenix"}
+
+	ruleset := []byte(`
+{
+  "nftables": [
+    {"metainfo": {"version": "1.0.6", "json_schema_version": 1}},
+    {"table": {"family": "inet", "name": "phoenix"}},
+    {"chain": {"family": "inet", "table": "phoenix", "name": "input", "type": "filter", "hook": "input", "prio": 0, "policy": "accept"}},
+    {"add": {"rule": {"family": "inet", "table": "phoenix", "chain": "input", "expr": [{"match": {"op": "==", "left": {"payload": {"protocol": "tcp", "field": "dport"}}, "right": 23}}, {"drop": null}]}}},
+    {"add": {"set": {"family": "inet", "table": "phoenix", "name": "blocked", "type": "ipv4_addr"}}}
+  ]
+}`)
+
+	b, err := buildTableRuleset(&table, ruleset)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	var v struct {
+		Nftables []map[string]json.RawMessage `json:"nftables"`
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("got invalid JSON: %v", err)
+	}
+
+	var got []string
+
+	for _, item := range v.Nftables {
+		for k := range item {
+			got = append(got, k)
+		}
+	}
+
+	// The table is recreated, metainfo is dropped
+	want := []string{"add", "delete", "add", "table", "chain", "add", "add"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid commands:\nwant:\t%v\ngot:\t%v", want, got)
+	}
+}
+
+func TestBuildTableRulesetForeign(t *testing.T) {
+	table := Table{Family: "inet", Name: "phoenix"}
+
+	tests := []struct {
+		ruleset string
+		want    error
+	}{
+		{`{"nftables": [{"table": {"family": "inet", "name": "filter"}}]}`, ErrForeignObject},
+		{`{"nftables": [{"table": {"family": "ip", "name": "phoenix"}}]}`, ErrForeignObject},
+		{`{"nftables": [{"add": {"rule": {"family": "inet", "table": "filter", "chain": "input", "expr": []}}}]}`, ErrForeignObject},
+		{`{"nftables": [{"delete": {"chain": {"family": "ip", "table": "nat", "name": "postrouting"}}}]}`, ErrForeignObject},
+		{`{"nftables": [{"flush": {"ruleset": null}}]}`, ErrInvalidRuleset},
+		{`{"nftables": [{"list": {"ruleset": null}}]}`, ErrInvalidRuleset},
+		{`{"nftables": [{"table": {"family": "inet", "name": "phoenix"}, "chain": {}}]}`, ErrInvalidRuleset},
+		{`{"nftables": [{"add": {"table": {"family": "inet", "name": "phoenix"}, "chain": {}}}]}`, ErrInvalidRuleset},
+		{`not a json`, ErrInvalidRuleset},
+	}
+
+	for _, tt := range tests {
+		if _, err := buildTableRuleset(&table, []byte(tt.ruleset)); !errors.Is(err, tt.want) {
+			t.Fatalf("%s: expected %v, got %v", tt.ruleset, tt.want, err)
+		}
+	}
+
+	if _, err := buildTableRuleset(&Table{Family: "inet", Name: "a b"}, []byte(`{"nftables": []}`)); err == nil {
+		t.Fatalf("invalid table name has been accepted")
+	}
+}
@@ -0,0 +1,206 @@
+package pcap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+	"unsafe"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+type CaptureOptions struct {
+	Interface  string
+	Filter     string // pcap-filter(7) expression
+	SnapLen    int
+	MaxPackets int
+	Duration   time.Duration
+}
+
+type CaptureStats struct {
+	// Captured is the number of packets passed to the caller
+	Captured uint64
+
+	// Received and Dropped are the kernel counters of the socket:
+	// the packets passed the filter and the packets dropped because
+	// the socket buffer was full
+	Received uint64
+	Dropped  uint64
+}
+
+// Capture captures the packets on the interface using an AF_PACKET socket
+// and calls fn with the pcapng blocks: the section header together with
+// the interface description, then a block for each packet and finally
+// the interface statistics.
+//
+// The capture stops when MaxPackets is reached, Duration expires
+// or ctx is canceled. At least one of the limits must be set.
+func Capture(ctx context.Context, opts *CaptureOptions, fn func([]byte) error) (*CaptureStats, error) {
+	if opts.MaxPackets <= 0 && opts.Duration <= 0 {
+		return nil, fmt.Errorf("capture must be limited by the number of packets or time")
+	}
+
+	if opts.SnapLen <= 0 {
+		return nil, fmt.Errorf("invalid snapshot length: %d", opts.SnapLen)
+	}
+
+	link, err := netlink.LinkByName(opts.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	var linkType int
+
+	switch link.Attrs().EncapType {
+	case "ether", "loopback":
+		linkType = LinkTypeEthernet
+	case "none":
+		// E.g. tun and wireguard links
+		linkType = LinkTypeRaw
+	default:
+		return nil, fmt.Errorf("unsupported link encapsulation: %s", link.Attrs().EncapType)
+	}
+
+	var prog []unix.SockFilter
+
+	if len(opts.Filter) > 0 {
+		if prog, err = CompileFilter(ctx, opts.Filter, opts.Interface, linkType, opts.SnapLen); err != nil {
+			return nil, err
+		}
+	}
+
+	// The protocol is zero, so nothing is received until the socket is bound,
+	// i.e. after the filter is attached
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, 0)
+	if err != nil {
+		return nil, os.NewSyscallError("socket", err)
+	}
+
+	// Makes the descriptor pollable, so that the read deadline works
+	f := os.NewFile(uintptr(fd), "packet")
+	defer f.Close()
+
+	if len(prog) > 0 {
+		fprog := unix.SockFprog{Len: uint16(len(prog)), Filter: &prog[0]}
+
+		if err := unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &fprog); err != nil {
+			return nil, os.NewSyscallError("setsockopt", err)
+		}
+	}
+
+	if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_TIMESTAMPNS, 1); err != nil {
+		return nil, os.NewSyscallError("setsockopt", err)
+	}
+
+	sa := unix.SockaddrLinklayer{
+		Protocol: htons(unix.ETH_P_ALL),
+		Ifindex:  link.Attrs().Index,
+	}
+
+	if err := unix.Bind(fd, &sa); err != nil {
+		return nil, os.NewSyscallError("bind", err)
+	}
+
+	start := time.Now()
+
+	if opts.Duration > 0 {
+		f.SetReadDeadline(start.Add(opts.Duration))
+	}
+
+	// Interrupts the blocked read when the context is canceled
+	stop := context.AfterFunc(ctx, func() {
+		f.SetReadDeadline(time.Now())
+	})
+	defer stop()
+
+	if err := fn(append(SectionHeader("phoenix-guest-agent"), InterfaceDescription(linkType, opts.SnapLen, opts.Interface)...)); err != nil {
+		return nil, err
+	}
+
+	rc, err := f.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := CaptureStats{}
+
+	buf := make([]byte, opts.SnapLen)
+	oob := make([]byte, unix.CmsgSpace(int(unsafe.Sizeof(unix.Timespec{}))))
+
+	for opts.MaxPackets <= 0 || stats.Captured < uint64(opts.MaxPackets) {
+		var n, oobn int
+		var from unix.Sockaddr
+		var recvErr error
+
+		err := rc.Read(func(fd uintptr) bool {
+			// With MSG_TRUNC the real length of the packet is returned
+			n, oobn, _, from, recvErr = unix.Recvmsg(int(fd), buf, oob, unix.MSG_TRUNC)
+
+			return recvErr != unix.EAGAIN
+		})
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				break
+			}
+
+			return nil, err
+		}
+
+		if recvErr != nil {
+			return nil, os.NewSyscallError("recvmsg", recvErr)
+		}
+
+		var direction uint32 = DirectionInbound
+
+		if ll, ok := from.(*unix.SockaddrLinklayer); ok && ll.Pkttype == unix.PACKET_OUTGOING {
+			direction = DirectionOutbound
+		}
+
+		if err := fn(EnhancedPacket(0, packetTime(oob[:oobn]), buf[:min(n, len(buf))], n, direction)); err != nil {
+			return nil, err
+		}
+
+		stats.Captured++
+	}
+
+	rc.Control(func(fd uintptr) {
+		if ks, err := unix.GetsockoptTpacketStats(int(fd), unix.SOL_PACKET, unix.PACKET_STATISTICS); err == nil {
+			stats.Received = uint64(ks.Packets)
+			stats.Dropped = uint64(ks.Drops)
+		}
+	})
+
+	if err := ctx.Err(); err != nil {
+		return &stats, err
+	}
+
+	if err := fn(InterfaceStatistics(0, start, time.Now(), stats.Received, stats.Dropped)); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// packetTime returns the kernel timestamp of the packet
+// from the SCM_TIMESTAMPNS control message.
+func packetTime(oob []byte) time.Time {
+	msgs, err := unix.ParseSocketControlMessage(oob)
+	if err == nil {
+		for _, m := range msgs {
+			if m.Header.Level == unix.SOL_SOCKET && m.Header.Type == unix.SCM_TIMESTAMPNS && len(m.Data) >= int(unsafe.Sizeof(unix.Timespec{})) {
+				ts := (*unix.Timespec)(unsafe.Pointer(&m.Data[0]))
+
+				return time.Unix(ts.Unix())
+			}
+		}
+	}
+
+	return time.Now()
+}
+
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
@@ -0,0 +1,109 @@
+package pcap
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+var (
+	ErrNotInstalled  = errors.New("tcpdump is not installed (required to compile the filter)")
+	ErrInvalidFilter = errors.New("invalid capture filter")
+)
+
+// CompileFilter compiles the filter expression in the pcap-filter(7) syntax
+// into a classic BPF program. There is no pure Go implementation of the pcap
+// filter compiler, so tcpdump is used for that.
+func CompileFilter(ctx context.Context, expr, ifname string, linkType, snapLen int) ([]unix.SockFilter, error) {
+	var dlt string
+
+	switch linkType {
+	case LinkTypeEthernet:
+		dlt = "EN10MB"
+	case LinkTypeRaw:
+		dlt = "RAW"
+	default:
+		return nil, fmt.Errorf("unsupported link type: %d", linkType)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "tcpdump", "-ddd", "-i", ifname, "-y", dlt, "-s", strconv.Itoa(snapLen), "--", expr)
+
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+
+		switch {
+		case errors.As(err, &exitErr):
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, strings.TrimSpace(stderr.String()))
+		case errors.Is(err, exec.ErrNotFound):
+			return nil, ErrNotInstalled
+		}
+
+		return nil, err
+	}
+
+	return parseBPF(&stdout)
+}
+
+// parseBPF parses the program in the "tcpdump -ddd" format:
+// the number of instructions on the first line followed by
+// the instructions as "code jt jf k" in decimal.
+func parseBPF(r io.Reader) ([]unix.SockFilter, error) {
+	scanner := bufio.NewScanner(r)
+
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("empty BPF program")
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+	if err != nil || count <= 0 || count > unix.BPF_MAXINSNS {
+		return nil, fmt.Errorf("invalid BPF program length: %q", scanner.Text())
+	}
+
+	prog := make([]unix.SockFilter, 0, count)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid BPF instruction: %q", scanner.Text())
+		}
+
+		var v [4]uint64
+
+		for i, bits := range []int{16, 8, 8, 32} {
+			if v[i], err = strconv.ParseUint(fields[i], 10, bits); err != nil {
+				return nil, fmt.Errorf("invalid BPF instruction: %q", scanner.Text())
+			}
+		}
+
+		prog = append(prog, unix.SockFilter{Code: uint16(v[0]), Jt: uint8(v[1]), Jf: uint8(v[2]), K: uint32(v[3])})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(prog) != count {
+		return nil, fmt.Errorf("BPF program length mismatch: expected %d, got %d", count, len(prog))
+	}
+
+	return prog, nil
+}
@@ -0,0 +1,146 @@
+package pcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// checkBlock verifies the framing of the block and returns its body.
+func checkBlock(t *testing.T, b []byte, typ uint32) []byte {
+	t.Helper()
+
+	if len(b)%4 != 0 || len(b) < 12 {
+		t.Fatalf("invalid block length: %d", len(b))
+	}
+
+	if v := binary.LittleEndian.Uint32(b[0:4]); v != typ {
+		t.Fatalf("invalid block type: want %#x, got %#x", typ, v)
+	}
+
+	first := binary.LittleEndian.Uint32(b[4:8])
+	last := binary.LittleEndian.Uint32(b[len(b)-4:])
+
+	if int(first) != len(b) || first != last {
+		t.Fatalf("invalid block total length: %d, %d (real = %d)", first, last, len(b))
+	}
+
+	return b[8 : len(b)-4]
+}
+
+func TestSectionHeader(t *testing.T) {
+	body := checkBlock(t, SectionHeader("test"), blockSectionHeader)
+
+	if v := binary.LittleEndian.Uint32(body[0:4]); v != 0x1A2B3C4D {
+		t.Fatalf("invalid byte-order magic: %#x", v)
+	}
+
+	// shb_userappl padded to 4 bytes + opt_endofopt
+	if want := []byte{4, 0, 4, 0, 't', 'e', 's', 't', 0, 0, 0, 0}; !bytes.Equal(body[16:], want) {
+		t.Fatalf("invalid options: %v", body[16:])
+	}
+}
+
+func TestInterfaceDescription(t *testing.T) {
+	body := checkBlock(t, InterfaceDescription(LinkTypeEthernet, 262144, "eth0"), blockInterfaceDescription)
+
+	if v := binary.LittleEndian.Uint16(body[0:2]); v != LinkTypeEthernet {
+		t.Fatalf("invalid link type: %d", v)
+	}
+
+	if v := binary.LittleEndian.Uint32(body[4:8]); v != 262144 {
+		t.Fatalf("invalid snaplen: %d", v)
+	}
+
+	want := []byte{
+		2, 0, 4, 0, 'e', 't', 'h', '0', // if_name
+		9, 0, 1, 0, 9, 0, 0, 0, // if_tsresol
+		0, 0, 0, 0,
+	}
+
+	if !bytes.Equal(body[8:], want) {
+		t.Fatalf("invalid options: %v", body[8:])
+	}
+}
+
+func TestEnhancedPacket(t *testing.T) {
+	ts := time.Unix(1700000000, 123456789)
+	data := []byte{1, 2, 3, 4, 5}
+
+	body := checkBlock(t, EnhancedPacket(0, ts, data, 1500, DirectionOutbound), blockEnhancedPacket)
+
+	nsec := uint64(binary.LittleEndian.Uint32(body[4:8]))<<32 | uint64(binary.LittleEndian.Uint32(body[8:12]))
+
+	if nsec != uint64(ts.UnixNano()) {
+		t.Fatalf("invalid timestamp: %d", nsec)
+	}
+
+	if caplen, origlen := binary.LittleEndian.Uint32(body[12:16]), binary.LittleEndian.Uint32(body[16:20]); caplen != 5 || origlen != 1500 {
+		t.Fatalf("invalid lengths: %d, %d", caplen, origlen)
+	}
+
+	// The data is padded to 8 bytes
+	if !bytes.Equal(body[20:28], []byte{1, 2, 3, 4, 5, 0, 0, 0}) {
+		t.Fatalf("invalid packet data: %v", body[20:28])
+	}
+
+	if want := []byte{2, 0, 4, 0, DirectionOutbound, 0, 0, 0, 0, 0, 0, 0}; !bytes.Equal(body[28:], want) {
+		t.Fatalf("invalid options: %v", body[28:])
+	}
+}
+
+func TestInterfaceStatistics(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+
+	body := checkBlock(t, InterfaceStatistics(0, start, start.Add(time.Second), 100, 2), blockInterfaceStatistics)
+
+	// interface id + timestamp + 2 timestamps + 2 counters + opt_endofopt
+	if len(body) != 12+2*12+2*12+4 {
+		t.Fatalf("invalid body length: %d", len(body))
+	}
+
+	if v := binary.LittleEndian.Uint64(body[40:48]); v != 100 {
+		t.Fatalf("invalid isb_ifrecv: %d", v)
+	}
+
+	if v := binary.LittleEndian.Uint64(body[52:60]); v != 2 {
+		t.Fatalf("invalid isb_ifdrop: %d", v)
+	}
+}
+
+func TestParseBPF(t *testing.T) {
+	// tcpdump -ddd -y EN10MB udp port 53
+	s := `4
+40 0 0 12
+21 0 1 2048
+6 0 0 262144
+6 0 0 0
+`
+
+	want := []unix.SockFilter{
+		{Code: 40, Jt: 0, Jf: 0, K: 12},
+		{Code: 21, Jt: 0, Jf: 1, K: 2048},
+		{Code: 6, Jt: 0, Jf: 0, K: 262144},
+		{Code: 6, Jt: 0, Jf: 0, K: 0},
+	}
+
+	prog, err := parseBPF(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(prog, want) {
+		t.Fatalf("got invalid program:\nwant:\t%v\ngot:\t%v", want, prog)
+	}
+
+	for _, s := range []string{"", "0\n", "2\n6 0 0 0\n", "1\n6 0 0\n", "1\n6 0 256 0\n"} {
+		if _, err := parseBPF(strings.NewReader(s)); err == nil {
+			t.Fatalf("%q: invalid program has been accepted", s)
+		}
+	}
+}
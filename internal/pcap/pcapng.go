@@ -0,0 +1,165 @@
+package pcap
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// The link types used in the Interface Description Block.
+const (
+	LinkTypeEthernet = 1
+	LinkTypeRaw      = 101
+)
+
+// The pcapng block types, see draft-ietf-opsawg-pcapng.
+const (
+	blockSectionHeader        = 0x0A0D0D0A
+	blockInterfaceDescription = 0x00000001
+	blockInterfaceStatistics  = 0x00000005
+	blockEnhancedPacket       = 0x00000006
+)
+
+// The values of the epb_flags option.
+const (
+	DirectionInbound  = 1
+	DirectionOutbound = 2
+)
+
+var byteOrder = binary.LittleEndian
+
+type options []byte
+
+func (o *options) add(code uint16, value []byte) {
+	var h [4]byte
+
+	byteOrder.PutUint16(h[0:2], code)
+	byteOrder.PutUint16(h[2:4], uint16(len(value)))
+
+	*o = append(*o, h[:]...)
+	*o = append(*o, pad(value)...)
+}
+
+func (o *options) addUint32(code uint16, v uint32) {
+	o.add(code, byteOrder.AppendUint32(nil, v))
+}
+
+func (o *options) addUint64(code uint16, v uint64) {
+	o.add(code, byteOrder.AppendUint64(nil, v))
+}
+
+func (o *options) addTime(code uint16, t time.Time) {
+	o.add(code, appendTimestamp(nil, t))
+}
+
+// bytes returns the options terminated with opt_endofopt.
+func (o options) bytes() []byte {
+	if len(o) == 0 {
+		return nil
+	}
+
+	return append(o, 0, 0, 0, 0)
+}
+
+// pad pads b with zeros to a 32-bit boundary.
+func pad(b []byte) []byte {
+	if n := len(b) % 4; n != 0 {
+		return append(b, make([]byte, 4-n)...)
+	}
+
+	return b
+}
+
+// appendTimestamp appends the timestamp in nanoseconds
+// as the high and low 32-bit words.
+func appendTimestamp(b []byte, t time.Time) []byte {
+	ts := uint64(t.UnixNano())
+
+	b = byteOrder.AppendUint32(b, uint32(ts>>32))
+
+	return byteOrder.AppendUint32(b, uint32(ts))
+}
+
+// block wraps the body into a block with the given type.
+func block(typ uint32, body []byte) []byte {
+	length := uint32(12 + len(body))
+
+	b := make([]byte, 0, length)
+
+	b = byteOrder.AppendUint32(b, typ)
+	b = byteOrder.AppendUint32(b, length)
+	b = append(b, body...)
+
+	return byteOrder.AppendUint32(b, length)
+}
+
+// SectionHeader returns the Section Header Block that starts the pcapng stream.
+func SectionHeader(appName string) []byte {
+	body := make([]byte, 0, 16)
+
+	body = byteOrder.AppendUint32(body, 0x1A2B3C4D)
+	body = byteOrder.AppendUint16(body, 1) // major version
+	body = byteOrder.AppendUint16(body, 0) // minor version
+	body = byteOrder.AppendUint64(body, 0xFFFFFFFFFFFFFFFF)
+
+	var opts options
+
+	if len(appName) > 0 {
+		opts.add(4, []byte(appName)) // shb_userappl
+	}
+
+	return block(blockSectionHeader, append(body, opts.bytes()...))
+}
+
+// InterfaceDescription returns the Interface Description Block.
+// The timestamps of the interface are in nanoseconds.
+func InterfaceDescription(linkType int, snapLen int, ifname string) []byte {
+	body := make([]byte, 0, 8)
+
+	body = byteOrder.AppendUint16(body, uint16(linkType))
+	body = byteOrder.AppendUint16(body, 0)
+	body = byteOrder.AppendUint32(body, uint32(snapLen))
+
+	var opts options
+
+	opts.add(2, []byte(ifname)) // if_name
+	opts.add(9, []byte{9})      // if_tsresol
+
+	return block(blockInterfaceDescription, append(body, opts.bytes()...))
+}
+
+// EnhancedPacket returns the Enhanced Packet Block with the captured data
+// of the packet. origLen is the length of the packet on the wire.
+func EnhancedPacket(ifaceID int, t time.Time, data []byte, origLen int, direction uint32) []byte {
+	body := make([]byte, 0, 20+len(data)+12)
+
+	body = byteOrder.AppendUint32(body, uint32(ifaceID))
+	body = appendTimestamp(body, t)
+	body = byteOrder.AppendUint32(body, uint32(len(data)))
+	body = byteOrder.AppendUint32(body, uint32(origLen))
+	body = pad(append(body, data...))
+
+	var opts options
+
+	if direction != 0 {
+		opts.addUint32(2, direction) // epb_flags
+	}
+
+	return block(blockEnhancedPacket, append(body, opts.bytes()...))
+}
+
+// InterfaceStatistics returns the Interface Statistics Block.
+func InterfaceStatistics(ifaceID int, start, end time.Time, received, dropped uint64) []byte {
+	body := make([]byte, 0, 12)
+
+	body = byteOrder.AppendUint32(body, uint32(ifaceID))
+	body = appendTimestamp(body, end)
+
+	var opts options
+
+	opts.addTime(2, start)      // isb_starttime
+	opts.addTime(3, end)        // isb_endtime
+	opts.addUint64(4, received) // isb_ifrecv
+	opts.addUint64(5, dropped)  // isb_ifdrop
+
+	return block(blockInterfaceStatistics, append(body, opts.bytes()...))
+}
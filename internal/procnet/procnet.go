@@ -0,0 +1,378 @@
+package procnet
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const procDir = "/proc"
+
+// Socket describes an entry of /proc/net/{tcp,tcp6,udp,udp6,unix}.
+type Socket struct {
+	Protocol   string // tcp, tcp6, udp, udp6 or unix
+	Type       string // stream, dgram or seqpacket (unix only)
+	State      string
+	Listening  bool
+	LocalAddr  string // host:port or path for unix sockets
+	RemoteAddr string
+	RecvQ      uint64
+	SendQ      uint64
+	UID        uint32
+	Inode      uint64
+	Processes  []*Process
+}
+
+// Process is a process holding a descriptor of the socket.
+type Process struct {
+	PID  int
+	Name string
+}
+
+type Options struct {
+	TCP  bool
+	UDP  bool
+	Unix bool
+
+	// ListeningOnly skips the sockets that do not accept connections
+	// (for UDP -- the connected sockets)
+	ListeningOnly bool
+}
+
+var tcpStates = map[uint64]string{
+	0x01: "ESTABLISHED",
+	0x02: "SYN-SENT",
+	0x03: "SYN-RECV",
+	0x04: "FIN-WAIT-1",
+	0x05: "FIN-WAIT-2",
+	0x06: "TIME-WAIT",
+	0x07: "CLOSE",
+	0x08: "CLOSE-WAIT",
+	0x09: "LAST-ACK",
+	0x0A: "LISTEN",
+	0x0B: "CLOSING",
+}
+
+const (
+	tcpListen   = 0x0A
+	udpUnconn   = 0x07
+	unixAccept  = 0x10000 // __SO_ACCEPTCON
+	unixUnconn  = 0x01
+	unixConn    = 0x03
+	unixConning = 0x02
+)
+
+var unixTypes = map[uint64]string{
+	1: "stream",
+	2: "dgram",
+	5: "seqpacket",
+}
+
+// List returns the sockets of the current network namespace
+// together with the processes using them, like ss -tuxap does.
+// If none of the protocols is selected, all of them are returned.
+func List(opts Options) ([]*Socket, error) {
+	if !opts.TCP && !opts.UDP && !opts.Unix {
+		opts.TCP, opts.UDP, opts.Unix = true, true, true
+	}
+
+	var sockets []*Socket
+
+	parse := func(name string, fn func(io.Reader) ([]*Socket, error)) error {
+		f, err := os.Open(filepath.Join(procDir, "net", name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// E.g. IPv6 is disabled
+				return nil
+			}
+
+			return err
+		}
+		defer f.Close()
+
+		ss, err := fn(f)
+		if err != nil {
+			return fmt.Errorf("failed to parse /proc/net/%s: %w", name, err)
+		}
+
+		for _, s := range ss {
+			if !opts.ListeningOnly || s.Listening {
+				sockets = append(sockets, s)
+			}
+		}
+
+		return nil
+	}
+
+	var files []string
+
+	if opts.TCP {
+		files = append(files, "tcp", "tcp6")
+	}
+
+	if opts.UDP {
+		files = append(files, "udp", "udp6")
+	}
+
+	for _, name := range files {
+		proto := name
+
+		if err := parse(name, func(r io.Reader) ([]*Socket, error) { return parseInet(r, proto) }); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Unix {
+		if err := parse("unix", parseUnix); err != nil {
+			return nil, err
+		}
+	}
+
+	owners := socketOwners(procDir)
+
+	for _, s := range sockets {
+		s.Processes = owners[s.Inode]
+	}
+
+	return sockets, nil
+}
+
+// parseInet parses the content of /proc/net/{tcp,tcp6,udp,udp6}:
+//
+//	sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ...
+//	 0: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 12345 ...
+func parseInet(r io.Reader, proto string) ([]*Socket, error) {
+	var sockets []*Socket
+
+	scanner := bufio.NewScanner(r)
+
+	// Skip the header
+	scanner.Scan()
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+
+		local, err := decodeAddr(fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		remote, err := decodeAddr(fields[2])
+		if err != nil {
+			return nil, err
+		}
+
+		st, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state: %s", fields[3])
+		}
+
+		s := Socket{
+			Protocol:   proto,
+			LocalAddr:  local,
+			RemoteAddr: remote,
+		}
+
+		if strings.HasPrefix(proto, "udp") {
+			if st == udpUnconn {
+				s.State = "UNCONN"
+				s.Listening = true
+			} else {
+				s.State = "ESTABLISHED"
+			}
+		} else {
+			s.State = tcpStates[st]
+			s.Listening = st == tcpListen
+		}
+
+		if tx, rx, ok := strings.Cut(fields[4], ":"); ok {
+			s.SendQ, _ = strconv.ParseUint(tx, 16, 64)
+			s.RecvQ, _ = strconv.ParseUint(rx, 16, 64)
+		}
+
+		uid, err := strconv.ParseUint(fields[7], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uid: %s", fields[7])
+		}
+
+		s.UID = uint32(uid)
+
+		if s.Inode, err = strconv.ParseUint(fields[9], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid inode: %s", fields[9])
+		}
+
+		sockets = append(sockets, &s)
+	}
+
+	return sockets, scanner.Err()
+}
+
+// decodeAddr converts the address like 0100007F:0CEA into 127.0.0.1:3306.
+// The address is printed by the kernel as a sequence of 32-bit words
+// in the host byte order.
+func decodeAddr(s string) (string, error) {
+	hexIP, hexPort, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", fmt.Errorf("invalid address: %s", s)
+	}
+
+	b, err := hex.DecodeString(hexIP)
+	if err != nil || (len(b) != net.IPv4len && len(b) != net.IPv6len) {
+		return "", fmt.Errorf("invalid address: %s", s)
+	}
+
+	ip := make(net.IP, len(b))
+
+	for i := 0; i < len(b); i += 4 {
+		binary.NativeEndian.PutUint32(ip[i:], binary.BigEndian.Uint32(b[i:]))
+	}
+
+	port, err := strconv.ParseUint(hexPort, 16, 16)
+	if err != nil {
+		return "", fmt.Errorf("invalid port: %s", s)
+	}
+
+	return net.JoinHostPort(ip.String(), strconv.FormatUint(port, 10)), nil
+}
+
+// parseUnix parses the content of /proc/net/unix:
+//
+//	Num       RefCount Protocol Flags    Type St Inode Path
+//	0000000000000000: 00000002 00000000 00010000 0001 01 12345 /run/systemd/notify
+func parseUnix(r io.Reader) ([]*Socket, error) {
+	var sockets []*Socket
+
+	scanner := bufio.NewScanner(r)
+
+	// Skip the header
+	scanner.Scan()
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 7 {
+			continue
+		}
+
+		flags, err := strconv.ParseUint(fields[3], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid flags: %s", fields[3])
+		}
+
+		typ, err := strconv.ParseUint(fields[4], 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type: %s", fields[4])
+		}
+
+		st, err := strconv.ParseUint(fields[5], 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state: %s", fields[5])
+		}
+
+		s := Socket{
+			Protocol:  "unix",
+			Type:      unixTypes[typ],
+			Listening: flags&unixAccept != 0,
+		}
+
+		switch {
+		case s.Listening:
+			s.State = "LISTEN"
+		case st == unixConn:
+			s.State = "ESTABLISHED"
+		case st == unixConning:
+			s.State = "SYN-SENT"
+		case st == unixUnconn:
+			s.State = "UNCONN"
+		}
+
+		if s.Inode, err = strconv.ParseUint(fields[6], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid inode: %s", fields[6])
+		}
+
+		// The path may contain spaces. Abstract sockets start with "@"
+		if len(fields) > 7 {
+			s.LocalAddr = strings.Join(fields[7:], " ")
+		}
+
+		sockets = append(sockets, &s)
+	}
+
+	return sockets, scanner.Err()
+}
+
+// socketOwners returns the processes holding the socket descriptors
+// grouped by the socket inode. The processes that have exited meanwhile
+// or are not accessible are skipped.
+func socketOwners(dir string) map[uint64][]*Process {
+	owners := make(map[uint64][]*Process)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return owners
+	}
+
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+
+		fds, err := os.ReadDir(filepath.Join(dir, e.Name(), "fd"))
+		if err != nil {
+			continue
+		}
+
+		var proc *Process
+
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(dir, e.Name(), "fd", fd.Name()))
+			if err != nil {
+				continue
+			}
+
+			inode, ok := parseSocketLink(link)
+			if !ok {
+				continue
+			}
+
+			if proc == nil {
+				proc = &Process{PID: pid}
+
+				if b, err := os.ReadFile(filepath.Join(dir, e.Name(), "comm")); err == nil {
+					proc.Name = strings.TrimSpace(string(b))
+				}
+			}
+
+			// The same socket can be opened several times in one process
+			if ps := owners[inode]; len(ps) == 0 || ps[len(ps)-1] != proc {
+				owners[inode] = append(ps, proc)
+			}
+		}
+	}
+
+	return owners
+}
+
+// parseSocketLink extracts the inode from the link like socket:[12345].
+func parseSocketLink(s string) (uint64, bool) {
+	if !strings.HasPrefix(s, "socket:[") || !strings.HasSuffix(s, "]") {
+		return 0, false
+	}
+
+	inode, err := strconv.ParseUint(s[len("socket:["):len(s)-1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return inode, true
+}
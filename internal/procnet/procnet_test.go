@@ -0,0 +1,131 @@
+package procnet
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func skipBigEndian(t *testing.T) {
+	if binary.NativeEndian.Uint16([]byte{1, 0}) != 1 {
+		t.Skip("the test data are from a little-endian host")
+	}
+}
+
+func TestParseInet(t *testing.T) {
+	skipBigEndian(t)
+
+	tcp := `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:0016 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 16384 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:0CEA 0100007F:A1B2 01 0000000A:00000002 02:000AFC7F 00000000   112        0 20480 1 0000000000000000 20 4 30 10 -1
+`
+
+	want := []*Socket{
+		{Protocol: "tcp", State: "LISTEN", Listening: true, LocalAddr: "0.0.0.0:22", RemoteAddr: "0.0.0.0:0", Inode: 16384},
+		{Protocol: "tcp", State: "ESTABLISHED", LocalAddr: "127.0.0.1:3306", RemoteAddr: "127.0.0.1:41394", SendQ: 10, RecvQ: 2, UID: 112, Inode: 20480},
+	}
+
+	got, err := parseInet(strings.NewReader(tcp), "tcp")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid sockets:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+
+	udp6 := `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  128: 00000000000000000000000000000000:0222 00000000000000000000000000000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 17000 2 0000000000000000 0
+  129: B80D0120000000000000000001000000:0035 B80D0120000000000000000002000000:D431 01 00000000:00000000 00:00000000 00000000   101        0 17001 2 0000000000000000 0
+`
+
+	want = []*Socket{
+		{Protocol: "udp6", State: "UNCONN", Listening: true, LocalAddr: "[::]:546", RemoteAddr: "[::]:0", Inode: 17000},
+		{Protocol: "udp6", State: "ESTABLISHED", LocalAddr: "[2001:db8::1]:53", RemoteAddr: "[2001:db8::2]:54321", UID: 101, Inode: 17001},
+	}
+
+	got, err = parseInet(strings.NewReader(udp6), "udp6")
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid sockets:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+
+	if _, err := parseInet(strings.NewReader("header\n 0: 0100007F:ZZZZ 00000000:0000 0A 00000000:00000000 00:00000000 00000000 0 0 1\n"), "tcp"); err == nil {
+		t.Fatalf("invalid port has been accepted")
+	}
+}
+
+func TestParseUnix(t *testing.T) {
+	s := `Num       RefCount Protocol Flags    Type St Inode Path
+0000000000000000: 00000002 00000000 00010000 0001 01 12345 /run/systemd/private
+0000000000000000: 00000003 00000000 00000000 0001 03 12346
+0000000000000000: 00000002 00000000 00000000 0002 01 12347 @/org/kernel/udev/udevd
+0000000000000000: 00000002 00000000 00010000 0005 01 12348 /run/my socket
+`
+
+	want := []*Socket{
+		{Protocol: "unix", Type: "stream", State: "LISTEN", Listening: true, LocalAddr: "/run/systemd/private", Inode: 12345},
+		{Protocol: "unix", Type: "stream", State: "ESTABLISHED", Inode: 12346},
+		{Protocol: "unix", Type: "dgram", State: "UNCONN", LocalAddr: "@/org/kernel/udev/udevd", Inode: 12347},
+		{Protocol: "unix", Type: "seqpacket", State: "LISTEN", Listening: true, LocalAddr: "/run/my socket", Inode: 12348},
+	}
+
+	got, err := parseUnix(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid sockets:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+}
+
+func TestSocketOwners(t *testing.T) {
+	dir := t.TempDir()
+
+	procs := []struct {
+		pid   string
+		comm  string
+		links []string
+	}{
+		{"1", "systemd", []string{"socket:[100]", "/dev/null", "socket:[101]"}},
+		{"42", "sshd", []string{"socket:[100]", "socket:[100]", "pipe:[200]"}},
+		{"self", "", []string{"socket:[102]"}},
+	}
+
+	for _, p := range procs {
+		if err := os.MkdirAll(filepath.Join(dir, p.pid, "fd"), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, p.pid, "comm"), []byte(p.comm+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		for i, l := range p.links {
+			if err := os.Symlink(l, filepath.Join(dir, p.pid, "fd", string(rune('0'+i)))); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	owners := socketOwners(dir)
+
+	systemd := &Process{PID: 1, Name: "systemd"}
+	sshd := &Process{PID: 42, Name: "sshd"}
+
+	want := map[uint64][]*Process{
+		100: {systemd, sshd},
+		101: {systemd},
+	}
+
+	if !reflect.DeepEqual(owners, want) {
+		t.Fatalf("got invalid owners:\nwant:\t%+v\ngot:\t%+v", want, owners)
+	}
+}
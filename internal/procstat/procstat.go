@@ -0,0 +1,308 @@
+package procstat
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const procDir = "/proc"
+
+// CPUTimes contains the time spent by a CPU (or by all CPUs
+// if Name is "cpu") in various modes, in USER_HZ units.
+type CPUTimes struct {
+	Name    string
+	User    uint64
+	Nice    uint64
+	System  uint64
+	Idle    uint64
+	Iowait  uint64
+	Irq     uint64
+	Softirq uint64
+	Steal   uint64
+}
+
+// Total returns the sum of all modes. The guest time
+// is already accounted in User and Nice.
+func (t *CPUTimes) Total() uint64 {
+	return t.User + t.Nice + t.System + t.Idle + t.Iowait + t.Irq + t.Softirq + t.Steal
+}
+
+// DiskStats contains the I/O counters of a block device.
+type DiskStats struct {
+	Name            string
+	ReadsCompleted  uint64
+	SectorsRead     uint64
+	WritesCompleted uint64
+	SectorsWritten  uint64
+	InFlight        uint64
+	IOTicks         uint64 // milliseconds spent doing I/O
+}
+
+// NetDevStats contains the counters of a network interface.
+type NetDevStats struct {
+	Name      string
+	RxBytes   uint64
+	RxPackets uint64
+	RxErrors  uint64
+	RxDropped uint64
+	TxBytes   uint64
+	TxPackets uint64
+	TxErrors  uint64
+	TxDropped uint64
+}
+
+// Pressure is the pressure stall information of a resource.
+// The Full line is always zero for the CPU on older kernels.
+type Pressure struct {
+	Some PressureLine
+	Full PressureLine
+}
+
+type PressureLine struct {
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+	Total  uint64 // microseconds
+}
+
+// ReadCPUTimes returns the aggregate CPU times followed by the times of each CPU.
+func ReadCPUTimes() ([]*CPUTimes, error) {
+	return readFile("stat", parseStat)
+}
+
+func ReadDiskStats() ([]*DiskStats, error) {
+	return readFile("diskstats", parseDiskStats)
+}
+
+func ReadNetDevStats() ([]*NetDevStats, error) {
+	return readFile("net/dev", parseNetDev)
+}
+
+// ReadPressure returns the pressure stall information of the resource
+// (cpu, memory or io). It fails with fs.ErrNotExist if the kernel
+// is built without CONFIG_PSI or it is disabled.
+func ReadPressure(resource string) (*Pressure, error) {
+	return readFile(filepath.Join("pressure", resource), parsePressure)
+}
+
+func readFile[T any](name string, parse func(io.Reader) (T, error)) (T, error) {
+	var zero T
+
+	f, err := os.Open(filepath.Join(procDir, name))
+	if err != nil {
+		return zero, err
+	}
+	defer f.Close()
+
+	v, err := parse(f)
+	if err != nil {
+		return zero, fmt.Errorf("failed to parse /proc/%s: %w", name, err)
+	}
+
+	return v, nil
+}
+
+// parseUints parses the fields as decimal numbers.
+func parseUints(fields []string) ([]uint64, error) {
+	values := make([]uint64, len(fields))
+
+	for i, f := range fields {
+		v, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value: %s", f)
+		}
+
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+// parseStat parses the cpu lines of /proc/stat:
+//
+//	cpu  57633 0 9839 384204 360 0 9 1986 0 0
+//	cpu0 57633 0 9839 384204 360 0 9 1986 0 0
+func parseStat(r io.Reader) ([]*CPUTimes, error) {
+	var cpus []*CPUTimes
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "cpu") {
+			continue
+		}
+
+		// The steal time appeared in 2.6.11
+		if len(fields) < 9 {
+			return nil, fmt.Errorf("too few fields: %s", scanner.Text())
+		}
+
+		v, err := parseUints(fields[1:9])
+		if err != nil {
+			return nil, err
+		}
+
+		cpus = append(cpus, &CPUTimes{
+			Name:    fields[0],
+			User:    v[0],
+			Nice:    v[1],
+			System:  v[2],
+			Idle:    v[3],
+			Iowait:  v[4],
+			Irq:     v[5],
+			Softirq: v[6],
+			Steal:   v[7],
+		})
+	}
+
+	return cpus, scanner.Err()
+}
+
+// parseDiskStats parses /proc/diskstats:
+//
+//	major minor name reads merged sectors ms writes merged sectors ms in_flight io_ms weighted_ms ...
+func parseDiskStats(r io.Reader) ([]*DiskStats, error) {
+	var disks []*DiskStats
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) < 14 {
+			return nil, fmt.Errorf("too few fields: %s", scanner.Text())
+		}
+
+		v, err := parseUints(fields[3:14])
+		if err != nil {
+			return nil, err
+		}
+
+		disks = append(disks, &DiskStats{
+			Name:            fields[2],
+			ReadsCompleted:  v[0],
+			SectorsRead:     v[2],
+			WritesCompleted: v[4],
+			SectorsWritten:  v[6],
+			InFlight:        v[8],
+			IOTicks:         v[9],
+		})
+	}
+
+	return disks, scanner.Err()
+}
+
+// parseNetDev parses /proc/net/dev:
+//
+//	Inter-|   Receive                                                |  Transmit
+//	 face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+//	    lo: 107659740   14921    0    0    0     0          0         0 107659740   14921    0    0    0     0       0          0
+func parseNetDev(r io.Reader) ([]*NetDevStats, error) {
+	var devs []*NetDevStats
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		// The counters may follow the colon without a space
+		name, counters, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			// The header
+			continue
+		}
+
+		fields := strings.Fields(counters)
+
+		if len(fields) < 16 {
+			return nil, fmt.Errorf("too few fields: %s", scanner.Text())
+		}
+
+		v, err := parseUints(fields[:16])
+		if err != nil {
+			return nil, err
+		}
+
+		devs = append(devs, &NetDevStats{
+			Name:      strings.TrimSpace(name),
+			RxBytes:   v[0],
+			RxPackets: v[1],
+			RxErrors:  v[2],
+			RxDropped: v[3],
+			TxBytes:   v[8],
+			TxPackets: v[9],
+			TxErrors:  v[10],
+			TxDropped: v[11],
+		})
+	}
+
+	return devs, scanner.Err()
+}
+
+// parsePressure parses the files in /proc/pressure:
+//
+//	some avg10=1.18 avg60=1.73 avg300=1.42 total=70838046
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func parsePressure(r io.Reader) (*Pressure, error) {
+	var p Pressure
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		var line *PressureLine
+
+		switch fields[0] {
+		case "some":
+			line = &p.Some
+		case "full":
+			line = &p.Full
+		default:
+			return nil, fmt.Errorf("unknown line: %s", scanner.Text())
+		}
+
+		for _, f := range fields[1:] {
+			k, v, ok := strings.Cut(f, "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid field: %s", f)
+			}
+
+			var err error
+
+			switch k {
+			case "avg10":
+				line.Avg10, err = strconv.ParseFloat(v, 64)
+			case "avg60":
+				line.Avg60, err = strconv.ParseFloat(v, 64)
+			case "avg300":
+				line.Avg300, err = strconv.ParseFloat(v, 64)
+			case "total":
+				line.Total, err = strconv.ParseUint(v, 10, 64)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid field: %s", f)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,108 @@
+package procstat
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseStat(t *testing.T) {
+	s := `cpu  57633 10 9839 384204 360 1 9 1986 0 0
+cpu0 30000 10 5000 190000 200 1 5 1000 0 0
+cpu1 27633 0 4839 194204 160 0 4 986 0 0
+intr 1234 0 0
+ctxt 5678
+btime 1700000000
+`
+
+	want := []*CPUTimes{
+		{Name: "cpu", User: 57633, Nice: 10, System: 9839, Idle: 384204, Iowait: 360, Irq: 1, Softirq: 9, Steal: 1986},
+		{Name: "cpu0", User: 30000, Nice: 10, System: 5000, Idle: 190000, Iowait: 200, Irq: 1, Softirq: 5, Steal: 1000},
+		{Name: "cpu1", User: 27633, System: 4839, Idle: 194204, Iowait: 160, Softirq: 4, Steal: 986},
+	}
+
+	got, err := parseStat(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid cpu times:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+
+	if v := got[0].Total(); v != 454042 {
+		t.Fatalf("got invalid total: %d", v)
+	}
+
+	if _, err := parseStat(strings.NewReader("cpu 1 2 3 4\n")); err == nil {
+		t.Fatalf("truncated line has been accepted")
+	}
+}
+
+func TestParseDiskStats(t *testing.T) {
+	s := `   7       0 loop0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+ 253       0 vda 15000 300 800000 9000 42000 5000 1200000 60000 2 70000 69000 0 0 0 0 1000 500
+ 253       1 vda1 14000 300 790000 8900 41000 5000 1190000 59000 0 69000 67900
+`
+
+	want := []*DiskStats{
+		{Name: "loop0"},
+		{Name: "vda", ReadsCompleted: 15000, SectorsRead: 800000, WritesCompleted: 42000, SectorsWritten: 1200000, InFlight: 2, IOTicks: 70000},
+		{Name: "vda1", ReadsCompleted: 14000, SectorsRead: 790000, WritesCompleted: 41000, SectorsWritten: 1190000, IOTicks: 69000},
+	}
+
+	got, err := parseDiskStats(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid disk stats:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+}
+
+func TestParseNetDev(t *testing.T) {
+	s := `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo: 107659740   14921    0    0    0     0          0         0 107659740   14921    0    0    0     0       0          0
+  eth0:4294967296 3000000    1    2    0     0          0        10 123456 2000 3 4 0 0 0 0
+`
+
+	want := []*NetDevStats{
+		{Name: "lo", RxBytes: 107659740, RxPackets: 14921, TxBytes: 107659740, TxPackets: 14921},
+		{Name: "eth0", RxBytes: 4294967296, RxPackets: 3000000, RxErrors: 1, RxDropped: 2, TxBytes: 123456, TxPackets: 2000, TxErrors: 3, TxDropped: 4},
+	}
+
+	got, err := parseNetDev(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid netdev stats:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+}
+
+func TestParsePressure(t *testing.T) {
+	s := `some avg10=1.18 avg60=1.73 avg300=1.42 total=70838046
+full avg10=0.50 avg60=0.25 avg300=0.00 total=1234
+`
+
+	want := Pressure{
+		Some: PressureLine{Avg10: 1.18, Avg60: 1.73, Avg300: 1.42, Total: 70838046},
+		Full: PressureLine{Avg10: 0.5, Avg60: 0.25, Total: 1234},
+	}
+
+	got, err := parsePressure(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, &want) {
+		t.Fatalf("got invalid pressure:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+
+	if _, err := parsePressure(strings.NewReader("some avg10=x\n")); err == nil {
+		t.Fatalf("invalid value has been accepted")
+	}
+}
@@ -0,0 +1,330 @@
+package sysctl
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrInvalidKey = errors.New("invalid sysctl key")
+	ErrNotAllowed = errors.New("sysctl key is not in the allowlist")
+)
+
+// PersistFile is the file where the persistent values are saved.
+const PersistFile = "/etc/sysctl.d/99-phoenix-ga.conf"
+
+const procDir = "/proc/sys"
+
+// DefaultAllowlist is the list of keys that can be changed by default.
+var DefaultAllowlist = []string{
+	"net.ipv4.ip_forward",
+	"net.ipv4.conf.*.forwarding",
+	"net.ipv6.conf.*.forwarding",
+	"net.ipv6.conf.*.accept_ra",
+	"net.ipv6.conf.*.disable_ipv6",
+	"vm.swappiness",
+}
+
+type Param struct {
+	Key   string
+	Value string
+}
+
+var mu sync.Mutex
+
+// Get returns the parameters matching the patterns. A pattern is either
+// a key, a glob (e.g. "net.ipv6.conf.*.accept_ra") or a subtree (e.g. "vm").
+// The keys are returned in the dot notation.
+func Get(patterns ...string) ([]*Param, error) {
+	return get(procDir, patterns)
+}
+
+func get(root string, patterns []string) ([]*Param, error) {
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("no sysctl keys specified")
+	}
+
+	params := make(map[string]*Param)
+
+	for _, pattern := range patterns {
+		p, err := keyToPath(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		matches, err := filepath.Glob(filepath.Join(root, p))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidKey, pattern)
+		}
+
+		exact := !hasMeta(pattern)
+
+		if exact && len(matches) == 0 {
+			return nil, fmt.Errorf("unknown sysctl key %s: %w", pattern, fs.ErrNotExist)
+		}
+
+		for _, m := range matches {
+			err := filepath.WalkDir(m, func(fname string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					// Unreadable directories are skipped
+					return nil
+				}
+
+				rel, _ := filepath.Rel(root, fname)
+
+				b, err := os.ReadFile(fname)
+				if err != nil {
+					// Some keys are write-only, e.g. net.ipv4.route.flush.
+					// They are skipped unless requested explicitly.
+					if exact && fname == m {
+						return fmt.Errorf("failed to read %s: %w", pathToKey(rel), err)
+					}
+
+					return nil
+				}
+
+				params[rel] = &Param{
+					Key:   pathToKey(rel),
+					Value: strings.TrimRight(string(b), "\n"),
+				}
+
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res := make([]*Param, 0, len(params))
+
+	for _, p := range params {
+		res = append(res, p)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+
+	return res, nil
+}
+
+// Set writes the values to /proc/sys. Every key must match one of
+// the allowlist patterns, otherwise nothing is changed.
+// If persist is set, the values are also saved to PersistFile.
+func Set(allowlist []string, params []*Param, persist bool) error {
+	return set(procDir, PersistFile, allowlist, params, persist)
+}
+
+func set(root, conffile string, allowlist []string, params []*Param, persist bool) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(params) == 0 {
+		return fmt.Errorf("no sysctl keys specified")
+	}
+
+	paths := make([]string, 0, len(params))
+
+	// Validate everything before changing anything
+	for _, p := range params {
+		if hasMeta(p.Key) {
+			return fmt.Errorf("%w: patterns are not allowed: %q", ErrInvalidKey, p.Key)
+		}
+
+		rel, err := keyToPath(p.Key)
+		if err != nil {
+			return err
+		}
+
+		if !Allowed(allowlist, p.Key) {
+			return fmt.Errorf("%w: %s", ErrNotAllowed, p.Key)
+		}
+
+		if strings.ContainsAny(p.Value, "\r\n") || len(strings.TrimSpace(p.Value)) == 0 {
+			return fmt.Errorf("invalid value for %s: %q", p.Key, p.Value)
+		}
+
+		fi, err := os.Stat(filepath.Join(root, rel))
+		if err != nil {
+			return fmt.Errorf("unknown sysctl key %s: %w", p.Key, fs.ErrNotExist)
+		}
+
+		if fi.IsDir() {
+			return fmt.Errorf("%w: %s is not a leaf key", ErrInvalidKey, p.Key)
+		}
+
+		paths = append(paths, rel)
+	}
+
+	for idx, p := range params {
+		if err := os.WriteFile(filepath.Join(root, paths[idx]), []byte(p.Value), 0644); err != nil {
+			return fmt.Errorf("failed to set %s: %w", p.Key, err)
+		}
+	}
+
+	if persist {
+		return updateConfFile(conffile, params)
+	}
+
+	return nil
+}
+
+// Allowed reports whether the key matches one of the allowlist patterns.
+// The patterns use the key notation, and "*" matches a single component,
+// e.g. "net.ipv6.conf.*.accept_ra".
+func Allowed(allowlist []string, key string) bool {
+	p, err := keyToPath(key)
+	if err != nil {
+		return false
+	}
+
+	for _, pattern := range allowlist {
+		pp, err := keyToPath(pattern)
+		if err != nil {
+			continue
+		}
+
+		if ok, _ := path.Match(pp, p); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keyToPath converts the key to the path relative to /proc/sys.
+// As with sysctl(8), the first separator determines the notation:
+// in "net.ipv4.conf.eth0/100.forwarding" the slash stands for the dot
+// in the interface name, in "net/ipv4/conf/eth0.100/forwarding"
+// the dots are part of the names.
+func keyToPath(key string) (string, error) {
+	p := key
+
+	if idx := strings.IndexAny(key, "./"); idx >= 0 && key[idx] == '.' {
+		p = swapSeparators(key)
+	}
+
+	if len(p) == 0 || path.Clean(p) != p || path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+
+	return p, nil
+}
+
+// pathToKey converts the path relative to /proc/sys to the key in the dot notation.
+func pathToKey(p string) string {
+	return swapSeparators(filepath.ToSlash(p))
+}
+
+func swapSeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.':
+			return '/'
+		case '/':
+			return '.'
+		}
+		return r
+	}, s)
+}
+
+func hasMeta(s string) bool {
+	return strings.ContainsAny(s, `*?[\`)
+}
+
+func updateConfFile(fname string, params []*Param) error {
+	b, err := os.ReadFile(fname)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	newb := mergeConf(b, params)
+
+	if bytes.Equal(b, newb) {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(fname+".tmp", newb, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(fname+".tmp", fname)
+}
+
+// mergeConf replaces the values of the keys in the sysctl.d file data
+// and appends the missing ones.
+func mergeConf(b []byte, params []*Param) []byte {
+	values := make(map[string]string)
+
+	var order []string
+
+	for _, p := range params {
+		rel, err := keyToPath(p.Key)
+		if err != nil {
+			continue
+		}
+
+		key := pathToKey(rel)
+
+		if _, ok := values[key]; !ok {
+			order = append(order, key)
+		}
+
+		values[key] = p.Value
+	}
+
+	var lines []string
+
+	if len(b) == 0 {
+		lines = append(lines, "# Managed by phoenix-guest-agent")
+	}
+
+	written := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if k, _, ok := strings.Cut(line, "="); ok && !strings.HasPrefix(strings.TrimSpace(line), "#") && !strings.HasPrefix(strings.TrimSpace(line), ";") {
+			// A leading "-" means that errors are ignored
+			k = strings.TrimPrefix(strings.TrimSpace(k), "-")
+
+			if rel, err := keyToPath(k); err == nil {
+				key := pathToKey(rel)
+
+				if v, ok := values[key]; ok {
+					if _, ok := written[key]; ok {
+						// Duplicate entry
+						continue
+					}
+
+					line = key + " = " + v
+					written[key] = struct{}{}
+				}
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	for _, key := range order {
+		if _, ok := written[key]; !ok {
+			lines = append(lines, key+" = "+values[key])
+		}
+	}
+
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
@@ -0,0 +1,204 @@
+package sysctl
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestKeyToPath(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"net.ipv4.ip_forward", "net/ipv4/ip_forward"},
+		{"net/ipv4/ip_forward", "net/ipv4/ip_forward"},
+		{"net.ipv6.conf.eth0/100.accept_ra", "net/ipv6/conf/eth0.100/accept_ra"},
+		{"net/ipv6/conf/eth0.100/accept_ra", "net/ipv6/conf/eth0.100/accept_ra"},
+		{"vm", "vm"},
+	}
+
+	for _, tt := range tests {
+		got, err := keyToPath(tt.key)
+		if err != nil {
+			t.Fatalf("%s: got unexpected error: %v", tt.key, err)
+		}
+
+		if got != tt.want {
+			t.Fatalf("%s: got invalid path:\nwant:\t%q\ngot:\t%q", tt.key, tt.want, got)
+		}
+
+		if key := pathToKey(got); key != swapSeparators(tt.want) {
+			t.Fatalf("%s: got invalid key: %q", tt.key, key)
+		}
+	}
+
+	for _, key := range []string{"", "..", "../etc/passwd", "net/../../etc", "/etc/passwd", "net..ipv4", "net.ipv4."} {
+		if _, err := keyToPath(key); !errors.Is(err, ErrInvalidKey) {
+			t.Fatalf("%q: expected ErrInvalidKey, got %v", key, err)
+		}
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"net.ipv4.ip_forward", true},
+		{"net/ipv4/ip_forward", true},
+		{"net.ipv6.conf.eth0.accept_ra", true},
+		{"net.ipv6.conf.eth0/100.disable_ipv6", true},
+		{"net.ipv6.conf.eth0.accept_redirects", false},
+		{"net.ipv6.conf.eth0.accept_ra.x", false},
+		{"kernel.core_pattern", false},
+		{"vm.swappiness", true},
+	}
+
+	for _, tt := range tests {
+		if got := Allowed(DefaultAllowlist, tt.key); got != tt.want {
+			t.Fatalf("%s: got %t, want %t", tt.key, got, tt.want)
+		}
+	}
+}
+
+func makeTree(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+
+	for name, value := range files {
+		fname := filepath.Join(root, name)
+
+		if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(fname, []byte(value), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return root
+}
+
+func TestGet(t *testing.T) {
+	root := makeTree(t, map[string]string{
+		"net/ipv4/ip_forward":                "0\n",
+		"net/ipv6/conf/all/accept_ra":        "1\n",
+		"net/ipv6/conf/eth0/accept_ra":       "2\n",
+		"net/ipv6/conf/eth0.100/accept_ra":   "0\n",
+		"net/ipv6/conf/eth0/disable_ipv6":    "0\n",
+		"vm/swappiness":                      "60\n",
+		"net/ipv4/ip_local_port_range":       "32768\t60999\n",
+		"net/ipv6/conf/eth0.100/forwarding":  "0\n",
+		"net/ipv6/conf/eth0.100/hop_limit":   "64\n",
+		"net/ipv6/conf/default/disable_ipv6": "0\n",
+	})
+
+	got, err := get(root, []string{"net.ipv6.conf.*.accept_ra", "vm", "net.ipv4.ip_forward", "net.ipv4.ip_local_port_range"})
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	want := []*Param{
+		{"net.ipv4.ip_forward", "0"},
+		{"net.ipv4.ip_local_port_range", "32768\t60999"},
+		{"net.ipv6.conf.all.accept_ra", "1"},
+		{"net.ipv6.conf.eth0.accept_ra", "2"},
+		{"net.ipv6.conf.eth0/100.accept_ra", "0"},
+		{"vm.swappiness", "60"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got invalid params:\nwant:\t%+v\ngot:\t%+v", want, got)
+	}
+
+	if _, err := get(root, []string{"kernel.hostname"}); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected fs.ErrNotExist, got %v", err)
+	}
+
+	// An unmatched pattern is not an error
+	if got, err := get(root, []string{"kernel.*"}); err != nil || len(got) != 0 {
+		t.Fatalf("got unexpected result: %v, %v", got, err)
+	}
+}
+
+func TestSet(t *testing.T) {
+	root := makeTree(t, map[string]string{
+		"net/ipv4/ip_forward":           "0\n",
+		"net/ipv6/conf/eth0/accept_ra":  "1\n",
+		"kernel/core_pattern":           "core\n",
+		"net/ipv6/conf/eth0/forwarding": "0\n",
+	})
+
+	conffile := filepath.Join(t.TempDir(), "sysctl.d", "99-phoenix-ga.conf")
+
+	params := []*Param{
+		{"net.ipv4.ip_forward", "1"},
+		{"net/ipv6/conf/eth0/accept_ra", "2"},
+	}
+
+	if err := set(root, conffile, DefaultAllowlist, params, true); err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if b, _ := os.ReadFile(filepath.Join(root, "net/ipv4/ip_forward")); string(b) != "1" {
+		t.Fatalf("got invalid value of ip_forward: %q", b)
+	}
+
+	if b, _ := os.ReadFile(conffile); string(b) != "# Managed by phoenix-guest-agent\nnet.ipv4.ip_forward = 1\nnet.ipv6.conf.eth0.accept_ra = 2\n" {
+		t.Fatalf("got invalid conf file:\n%s", b)
+	}
+
+	// Nothing is changed if one of the keys is not allowed
+	params = []*Param{
+		{"net.ipv6.conf.eth0.forwarding", "1"},
+		{"kernel.core_pattern", "|/bin/sh"},
+	}
+
+	if err := set(root, conffile, DefaultAllowlist, params, false); !errors.Is(err, ErrNotAllowed) {
+		t.Fatalf("expected ErrNotAllowed, got %v", err)
+	}
+
+	if b, _ := os.ReadFile(filepath.Join(root, "net/ipv6/conf/eth0/forwarding")); string(b) != "0\n" {
+		t.Fatalf("the value has been changed: %q", b)
+	}
+
+	if err := set(root, conffile, DefaultAllowlist, []*Param{{"net.ipv6.conf.*.accept_ra", "0"}}, false); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey, got %v", err)
+	}
+
+	if err := set(root, conffile, DefaultAllowlist, []*Param{{"net.ipv4.ip_forward", "1\nkernel.core_pattern = x"}}, false); err == nil {
+		t.Fatalf("multiline value has been accepted")
+	}
+}
+
+func TestMergeConf(t *testing.T) {
+	data := []byte(`# comment
+net.ipv4.ip_forward = 0
+; net.ipv4.ip_forward = 0
+-vm/swappiness=10
+vm.swappiness = 20
+kernel.panic = 10
+`)
+
+	params := []*Param{
+		{"vm.swappiness", "30"},
+		{"net.ipv4.ip_forward", "1"},
+		{"net.ipv6.conf.eth0/100.accept_ra", "2"},
+	}
+
+	want := `# comment
+net.ipv4.ip_forward = 1
+; net.ipv4.ip_forward = 0
+vm.swappiness = 30
+kernel.panic = 10
+net.ipv6.conf.eth0/100.accept_ra = 2
+`
+
+	if got := string(mergeConf(data, params)); got != want {
+		t.Fatalf("got invalid result:\nwant:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -0,0 +1,471 @@
+package wireguard
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// maxPeersLen limits the length of WGDEVICE_A_PEERS in a single message.
+// The attribute length is 16-bit, so a large set of peers is split into
+// several messages, and the allowed IPs of a peer may be split as well.
+const maxPeersLen = 32 * 1024
+
+// buildDeviceAttrs returns the attributes of the WG_CMD_SET_DEVICE messages.
+// The device settings and WGDEVICE_F_REPLACE_PEERS are sent in the first message
+// only, and WGPEER_F_REPLACE_ALLOWEDIPS is sent in the first part of the peer only.
+func buildDeviceAttrs(ifname string, cfg *Config) ([][]*nl.RtAttr, error) {
+	if len(ifname) == 0 || len(ifname) >= unix.IFNAMSIZ {
+		return nil, fmt.Errorf("invalid interface name: %q", ifname)
+	}
+
+	attrs := []*nl.RtAttr{
+		nl.NewRtAttr(unix.WGDEVICE_A_IFNAME, nl.ZeroTerminated(ifname)),
+	}
+
+	if !cfg.PrivateKey.IsZero() {
+		attrs = append(attrs, nl.NewRtAttr(unix.WGDEVICE_A_PRIVATE_KEY, cfg.PrivateKey[:]))
+	}
+
+	if cfg.ListenPort != 0 {
+		if cfg.ListenPort < 0 || cfg.ListenPort > 65535 {
+			return nil, fmt.Errorf("invalid listen port: %d", cfg.ListenPort)
+		}
+
+		attrs = append(attrs, nl.NewRtAttr(unix.WGDEVICE_A_LISTEN_PORT, nl.Uint16Attr(uint16(cfg.ListenPort))))
+	}
+
+	if cfg.FirewallMark != 0 {
+		attrs = append(attrs, nl.NewRtAttr(unix.WGDEVICE_A_FWMARK, nl.Uint32Attr(uint32(cfg.FirewallMark))))
+	}
+
+	if cfg.ReplacePeers {
+		attrs = append(attrs, nl.NewRtAttr(unix.WGDEVICE_A_FLAGS, nl.Uint32Attr(unix.WGDEVICE_F_REPLACE_PEERS)))
+	}
+
+	msgs := [][]*nl.RtAttr{attrs}
+
+	if len(cfg.Peers) == 0 {
+		return msgs, nil
+	}
+
+	// WGDEVICE_A_PEERS of the last message, its length and
+	// the number of peers in it
+	peers := nl.NewRtAttr(unix.WGDEVICE_A_PEERS|int(nl.NLA_F_NESTED), nil)
+	peersLen, npeers := unix.SizeofRtAttr, 0
+
+	msgs[0] = append(msgs[0], peers)
+
+	// The next messages contain only the interface name and the peers
+	nextMessage := func() {
+		peers = nl.NewRtAttr(unix.WGDEVICE_A_PEERS|int(nl.NLA_F_NESTED), nil)
+		peersLen, npeers = unix.SizeofRtAttr, 0
+
+		msgs = append(msgs, []*nl.RtAttr{nl.NewRtAttr(unix.WGDEVICE_A_IFNAME, nl.ZeroTerminated(ifname)), peers})
+	}
+
+	for _, p := range cfg.Peers {
+		peer, ips, err := buildPeerAttrs(p)
+		if err != nil {
+			return nil, fmt.Errorf("peer %s: %w", p.PublicKey, err)
+		}
+
+		// WGPEER_A_ALLOWEDIPS of the last part of the peer
+		// and the number of allowed IPs in it
+		var allowed *nl.RtAttr
+		var nallowed int
+
+		addPeer := func(peer *nl.RtAttr) {
+			peer.Type = uint16(npeers) | nl.NLA_F_NESTED
+			peers.AddChild(peer)
+			peersLen += peer.Len()
+			npeers++
+
+			if len(ips) > 0 {
+				allowed = peer.AddRtAttr(unix.WGPEER_A_ALLOWEDIPS|int(nl.NLA_F_NESTED), nil)
+				peersLen += unix.SizeofRtAttr
+				nallowed = 0
+			}
+		}
+
+		// The peer goes to the next message if even its first allowed IP does not fit
+		need := peer.Len()
+		if len(ips) > 0 {
+			need += unix.SizeofRtAttr + ips[0].Len()
+		}
+
+		if npeers > 0 && peersLen+need > maxPeersLen {
+			nextMessage()
+		}
+
+		addPeer(peer)
+
+		for _, ip := range ips {
+			if nallowed > 0 && peersLen+ip.Len() > maxPeersLen {
+				nextMessage()
+
+				// The rest of the allowed IPs are added to the same peer
+				cont := nl.NewRtAttr(0, nil)
+				cont.AddRtAttr(unix.WGPEER_A_PUBLIC_KEY, p.PublicKey[:])
+
+				addPeer(cont)
+			}
+
+			ip.Type = uint16(nallowed) | nl.NLA_F_NESTED
+			allowed.AddChild(ip)
+			peersLen += ip.Len()
+			nallowed++
+		}
+	}
+
+	return msgs, nil
+}
+
+// buildPeerAttrs returns the peer attribute without the allowed IPs
+// and the allowed IPs attributes separately, so that they could be split.
+func buildPeerAttrs(p *PeerConfig) (*nl.RtAttr, []*nl.RtAttr, error) {
+	if p.PublicKey.IsZero() {
+		return nil, nil, fmt.Errorf("%w: public key is not specified", ErrInvalidKey)
+	}
+
+	attr := nl.NewRtAttr(0, nil)
+
+	attr.AddRtAttr(unix.WGPEER_A_PUBLIC_KEY, p.PublicKey[:])
+
+	var flags uint32
+
+	if p.Remove {
+		flags |= unix.WGPEER_F_REMOVE_ME
+	}
+
+	if p.UpdateOnly {
+		flags |= unix.WGPEER_F_UPDATE_ONLY
+	}
+
+	if p.ReplaceAllowedIPs {
+		flags |= unix.WGPEER_F_REPLACE_ALLOWEDIPS
+	}
+
+	if flags != 0 {
+		attr.AddRtAttr(unix.WGPEER_A_FLAGS, nl.Uint32Attr(flags))
+	}
+
+	if p.Remove {
+		return attr, nil, nil
+	}
+
+	if !p.PresharedKey.IsZero() {
+		attr.AddRtAttr(unix.WGPEER_A_PRESHARED_KEY, p.PresharedKey[:])
+	}
+
+	if p.Endpoint != nil {
+		b, err := encodeSockaddr(p.Endpoint)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		attr.AddRtAttr(unix.WGPEER_A_ENDPOINT, b)
+	}
+
+	if p.PersistentKeepalive != 0 {
+		secs := p.PersistentKeepalive / time.Second
+
+		if secs < 1 || secs > 65535 {
+			return nil, nil, fmt.Errorf("invalid persistent keepalive interval: %s", p.PersistentKeepalive)
+		}
+
+		attr.AddRtAttr(unix.WGPEER_A_PERSISTENT_KEEPALIVE_INTERVAL, nl.Uint16Attr(uint16(secs)))
+	}
+
+	ips := make([]*nl.RtAttr, 0, len(p.AllowedIPs))
+
+	for _, ipnet := range p.AllowedIPs {
+		ones, bits := ipnet.Mask.Size()
+
+		family := unix.AF_INET
+		ip := ipnet.IP.To4()
+
+		if bits == 8*net.IPv6len {
+			family = unix.AF_INET6
+			ip = ipnet.IP.To16()
+		}
+
+		if ip == nil || bits != 8*len(ip) {
+			return nil, nil, fmt.Errorf("invalid allowed IP: %s", ipnet.String())
+		}
+
+		a := nl.NewRtAttr(int(nl.NLA_F_NESTED), nil)
+
+		a.AddRtAttr(unix.WGALLOWEDIP_A_FAMILY, nl.Uint16Attr(uint16(family)))
+		a.AddRtAttr(unix.WGALLOWEDIP_A_IPADDR, []byte(ip))
+		a.AddRtAttr(unix.WGALLOWEDIP_A_CIDR_MASK, nl.Uint8Attr(uint8(ones)))
+
+		ips = append(ips, a)
+	}
+
+	return attr, ips, nil
+}
+
+// parseDevice parses the replies to WG_CMD_GET_DEVICE. The kernel splits
+// a large device into several messages, and a peer can be continued
+// in the next message with the rest of its allowed IPs.
+func parseDevice(msgs [][]byte) (*Device, error) {
+	var dev Device
+
+	native := nl.NativeEndian()
+
+	for _, m := range msgs {
+		if len(m) < nl.SizeofGenlmsg {
+			return nil, fmt.Errorf("genetlink: message too short")
+		}
+
+		attrs, err := parseAttrs(m[nl.SizeofGenlmsg:])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range attrs {
+			switch a.Attr.Type {
+			case unix.WGDEVICE_A_IFINDEX:
+				dev.Index = int(native.Uint32(a.Value))
+			case unix.WGDEVICE_A_IFNAME:
+				dev.Name = nl.BytesToString(a.Value)
+			case unix.WGDEVICE_A_PUBLIC_KEY:
+				copy(dev.PublicKey[:], a.Value)
+			case unix.WGDEVICE_A_LISTEN_PORT:
+				dev.ListenPort = int(native.Uint16(a.Value))
+			case unix.WGDEVICE_A_FWMARK:
+				dev.FirewallMark = int(native.Uint32(a.Value))
+			case unix.WGDEVICE_A_PEERS:
+				peers, err := parseAttrs(a.Value)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, x := range peers {
+					p, err := parsePeer(x.Value)
+					if err != nil {
+						return nil, err
+					}
+
+					if n := len(dev.Peers); n > 0 && dev.Peers[n-1].PublicKey == p.PublicKey {
+						dev.Peers[n-1].AllowedIPs = append(dev.Peers[n-1].AllowedIPs, p.AllowedIPs...)
+						continue
+					}
+
+					dev.Peers = append(dev.Peers, p)
+				}
+			}
+		}
+	}
+
+	return &dev, nil
+}
+
+func parsePeer(b []byte) (*Peer, error) {
+	var p Peer
+
+	native := nl.NativeEndian()
+
+	attrs, err := parseAttrs(b)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range attrs {
+		switch a.Attr.Type {
+		case unix.WGPEER_A_PUBLIC_KEY:
+			copy(p.PublicKey[:], a.Value)
+		case unix.WGPEER_A_PRESHARED_KEY:
+			var k Key
+			copy(k[:], a.Value)
+			p.HasPresharedKey = !k.IsZero()
+		case unix.WGPEER_A_ENDPOINT:
+			addr, err := decodeSockaddr(a.Value)
+			if err != nil {
+				return nil, err
+			}
+			p.Endpoint = addr
+		case unix.WGPEER_A_PERSISTENT_KEEPALIVE_INTERVAL:
+			p.PersistentKeepalive = time.Duration(native.Uint16(a.Value)) * time.Second
+		case unix.WGPEER_A_LAST_HANDSHAKE_TIME:
+			if len(a.Value) < 16 {
+				return nil, fmt.Errorf("genetlink: invalid handshake time")
+			}
+
+			sec, nsec := int64(native.Uint64(a.Value[:8])), int64(native.Uint64(a.Value[8:16]))
+
+			if sec != 0 || nsec != 0 {
+				p.LastHandshake = time.Unix(sec, nsec)
+			}
+		case unix.WGPEER_A_RX_BYTES:
+			p.RxBytes = int64(native.Uint64(a.Value))
+		case unix.WGPEER_A_TX_BYTES:
+			p.TxBytes = int64(native.Uint64(a.Value))
+		case unix.WGPEER_A_PROTOCOL_VERSION:
+			p.ProtocolVersion = int(native.Uint32(a.Value))
+		case unix.WGPEER_A_ALLOWEDIPS:
+			ips, err := parseAttrs(a.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, x := range ips {
+				ipnet, err := parseAllowedIP(x.Value)
+				if err != nil {
+					return nil, err
+				}
+
+				p.AllowedIPs = append(p.AllowedIPs, *ipnet)
+			}
+		}
+	}
+
+	return &p, nil
+}
+
+func parseAllowedIP(b []byte) (*net.IPNet, error) {
+	attrs, err := parseAttrs(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var family uint16
+	var ip net.IP
+	var ones int
+
+	for _, a := range attrs {
+		switch a.Attr.Type {
+		case unix.WGALLOWEDIP_A_FAMILY:
+			family = nl.NativeEndian().Uint16(a.Value)
+		case unix.WGALLOWEDIP_A_IPADDR:
+			ip = append(net.IP(nil), a.Value...)
+		case unix.WGALLOWEDIP_A_CIDR_MASK:
+			ones = int(a.Value[0])
+		}
+	}
+
+	bits := 8 * net.IPv4len
+
+	if family == unix.AF_INET6 {
+		bits = 8 * net.IPv6len
+	}
+
+	if len(ip) != bits/8 || ones > bits {
+		return nil, fmt.Errorf("genetlink: invalid allowed IP")
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(ones, bits)}, nil
+}
+
+// parseAttrs is like nl.ParseRouteAttr, but also clears the flags
+// of the attribute type, e.g. NLA_F_NESTED.
+func parseAttrs(b []byte) ([]syscall.NetlinkRouteAttr, error) {
+	attrs, err := nl.ParseRouteAttr(b)
+	if err != nil {
+		return nil, fmt.Errorf("genetlink: %w", err)
+	}
+
+	for idx := range attrs {
+		attrs[idx].Attr.Type &= nl.NLA_TYPE_MASK
+	}
+
+	return attrs, nil
+}
+
+// encodeSockaddr returns the address in the form of sockaddr_in or sockaddr_in6.
+func encodeSockaddr(addr *net.UDPAddr) ([]byte, error) {
+	if addr.Port < 1 || addr.Port > 65535 {
+		return nil, fmt.Errorf("invalid endpoint port: %d", addr.Port)
+	}
+
+	native := nl.NativeEndian()
+
+	if ip4 := addr.IP.To4(); ip4 != nil {
+		b := make([]byte, unix.SizeofSockaddrInet4)
+
+		native.PutUint16(b[0:2], unix.AF_INET)
+		b[2], b[3] = byte(addr.Port>>8), byte(addr.Port)
+		copy(b[4:8], ip4)
+
+		return b, nil
+	}
+
+	if ip6 := addr.IP.To16(); ip6 != nil {
+		b := make([]byte, unix.SizeofSockaddrInet6)
+
+		native.PutUint16(b[0:2], unix.AF_INET6)
+		b[2], b[3] = byte(addr.Port>>8), byte(addr.Port)
+		copy(b[8:24], ip6)
+
+		if len(addr.Zone) > 0 {
+			idx, err := zoneToIndex(addr.Zone)
+			if err != nil {
+				return nil, err
+			}
+
+			native.PutUint32(b[24:28], uint32(idx))
+		}
+
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("invalid endpoint address: %s", addr.IP)
+}
+
+func decodeSockaddr(b []byte) (*net.UDPAddr, error) {
+	if len(b) < 2 {
+		return nil, fmt.Errorf("genetlink: invalid endpoint")
+	}
+
+	native := nl.NativeEndian()
+
+	switch native.Uint16(b[0:2]) {
+	case unix.AF_INET:
+		if len(b) < unix.SizeofSockaddrInet4 {
+			break
+		}
+
+		return &net.UDPAddr{
+			IP:   net.IPv4(b[4], b[5], b[6], b[7]),
+			Port: int(b[2])<<8 | int(b[3]),
+		}, nil
+	case unix.AF_INET6:
+		if len(b) < unix.SizeofSockaddrInet6 {
+			break
+		}
+
+		addr := net.UDPAddr{
+			IP:   append(net.IP(nil), b[8:24]...),
+			Port: int(b[2])<<8 | int(b[3]),
+		}
+
+		if idx := native.Uint32(b[24:28]); idx != 0 {
+			addr.Zone = strconv.Itoa(int(idx))
+		}
+
+		return &addr, nil
+	case unix.AF_UNSPEC:
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("genetlink: invalid endpoint")
+}
+
+func zoneToIndex(zone string) (int, error) {
+	if idx, err := strconv.Atoi(zone); err == nil {
+		return idx, nil
+	}
+
+	iface, err := net.InterfaceByName(zone)
+	if err != nil {
+		return 0, err
+	}
+
+	return iface.Index, nil
+}
@@ -0,0 +1,176 @@
+package wireguard
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	ErrNotSupported = errors.New("wireguard is not supported by the kernel")
+	ErrInvalidKey   = errors.New("invalid wireguard key")
+)
+
+// KeyLen is the length of the Curve25519 keys used by WireGuard.
+const KeyLen = 32
+
+// Key is a public, private or preshared key.
+type Key [KeyLen]byte
+
+// ParseKey decodes the key in the base64 notation used by wg(8).
+func ParseKey(s string) (Key, error) {
+	var k Key
+
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(b) != KeyLen {
+		return k, fmt.Errorf("%w: %q", ErrInvalidKey, s)
+	}
+
+	copy(k[:], b)
+
+	return k, nil
+}
+
+func (k Key) String() string {
+	return base64.StdEncoding.EncodeToString(k[:])
+}
+
+func (k Key) IsZero() bool {
+	return k == Key{}
+}
+
+// Device describes the state of a WireGuard link.
+// The private key is never returned.
+type Device struct {
+	Name         string
+	Index        int
+	PublicKey    Key
+	ListenPort   int
+	FirewallMark int
+	Peers        []*Peer
+}
+
+type Peer struct {
+	PublicKey           Key
+	HasPresharedKey     bool
+	Endpoint            *net.UDPAddr
+	PersistentKeepalive time.Duration
+	LastHandshake       time.Time
+	RxBytes             int64
+	TxBytes             int64
+	AllowedIPs          []net.IPNet
+	ProtocolVersion     int
+}
+
+// Config describes the changes of a WireGuard link.
+// The zero values leave the corresponding settings unchanged.
+type Config struct {
+	PrivateKey   Key
+	ListenPort   int
+	FirewallMark int
+
+	// ReplacePeers removes the peers that are not listed in Peers.
+	// It is not atomic for a large set of peers, see ConfigureDevice
+	ReplacePeers bool
+	Peers        []*PeerConfig
+}
+
+type PeerConfig struct {
+	PublicKey Key
+
+	// Remove removes the peer, other fields are ignored
+	Remove bool
+
+	// UpdateOnly prevents the peer from being created if it does not exist
+	UpdateOnly bool
+
+	PresharedKey        Key
+	Endpoint            *net.UDPAddr
+	PersistentKeepalive time.Duration
+
+	// ReplaceAllowedIPs removes the allowed IPs that are not listed in AllowedIPs
+	ReplaceAllowedIPs bool
+	AllowedIPs        []net.IPNet
+}
+
+// GetDevice returns the state of the WireGuard link.
+func GetDevice(ifname string) (*Device, error) {
+	familyID, err := familyID()
+	if err != nil {
+		return nil, err
+	}
+
+	req := nl.NewNetlinkRequest(familyID, unix.NLM_F_DUMP)
+
+	req.AddData(&nl.Genlmsg{Command: unix.WG_CMD_GET_DEVICE, Version: unix.WG_GENL_VERSION})
+	req.AddData(nl.NewRtAttr(unix.WGDEVICE_A_IFNAME, nl.ZeroTerminated(ifname)))
+
+	msgs, err := req.Execute(unix.NETLINK_GENERIC, 0)
+	if err != nil {
+		return nil, os.NewSyscallError("genetlink", err)
+	}
+
+	return parseDevice(msgs)
+}
+
+// ConfigureDevice applies the configuration to the WireGuard link.
+//
+// A large set of peers does not fit into one netlink message, so it is
+// sent in several ones. Only the first message replaces the peers,
+// the next ones add the rest. Thus the change is not atomic: if one of
+// the messages fails, the link keeps the peers of the previous ones
+// (and with ReplacePeers, the other peers are already removed).
+// The error tells how many messages have been applied.
+func ConfigureDevice(ifname string, cfg *Config) error {
+	familyID, err := familyID()
+	if err != nil {
+		return err
+	}
+
+	msgs, err := buildDeviceAttrs(ifname, cfg)
+	if err != nil {
+		return err
+	}
+
+	// A large set of peers is split into several messages
+	for i, attrs := range msgs {
+		req := nl.NewNetlinkRequest(familyID, unix.NLM_F_ACK)
+
+		req.AddData(&nl.Genlmsg{Command: unix.WG_CMD_SET_DEVICE, Version: unix.WG_GENL_VERSION})
+
+		for _, a := range attrs {
+			req.AddData(a)
+		}
+
+		if _, err := req.Execute(unix.NETLINK_GENERIC, 0); err != nil {
+			if i > 0 {
+				return fmt.Errorf("configuration is partially applied (%d of %d messages): %w", i, len(msgs), os.NewSyscallError("genetlink", err))
+			}
+
+			return os.NewSyscallError("genetlink", err)
+		}
+	}
+
+	return nil
+}
+
+func familyID() (int, error) {
+	family, err := netlink.GenlFamilyGet(unix.WG_GENL_NAME)
+	if err != nil {
+		if errors.Is(err, syscall.ENOENT) {
+			return 0, ErrNotSupported
+		}
+
+		return 0, os.NewSyscallError("genetlink", err)
+	}
+
+	return int(family.ID), nil
+}
@@ -0,0 +1,321 @@
+package wireguard
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+func TestParseKey(t *testing.T) {
+	s := "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="
+
+	k, err := ParseKey(s)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if k.String() != s {
+		t.Fatalf("got invalid key: %s", k)
+	}
+
+	for _, s := range []string{"", "abc", "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBm=", "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"} {
+		if _, err := ParseKey(s); !errors.Is(err, ErrInvalidKey) {
+			t.Fatalf("%q: expected ErrInvalidKey, got %v", s, err)
+		}
+	}
+}
+
+func TestSockaddr(t *testing.T) {
+	tests := []*net.UDPAddr{
+		{IP: net.IPv4(192, 0, 2, 1), Port: 51820},
+		{IP: net.ParseIP("2001:db8::1"), Port: 443},
+		{IP: net.ParseIP("fe80::1"), Port: 1, Zone: "3"},
+	}
+
+	for _, addr := range tests {
+		b, err := encodeSockaddr(addr)
+		if err != nil {
+			t.Fatalf("%s: got unexpected error: %v", addr, err)
+		}
+
+		// The port is always in the network byte order
+		if int(b[2])<<8|int(b[3]) != addr.Port {
+			t.Fatalf("%s: got invalid port bytes: %v", addr, b[2:4])
+		}
+
+		got, err := decodeSockaddr(b)
+		if err != nil {
+			t.Fatalf("%s: got unexpected error: %v", addr, err)
+		}
+
+		if got.String() != addr.String() {
+			t.Fatalf("got invalid address:\nwant:\t%s\ngot:\t%s", addr, got)
+		}
+	}
+
+	if _, err := encodeSockaddr(&net.UDPAddr{IP: net.IPv4(192, 0, 2, 1)}); err == nil {
+		t.Fatalf("zero port has been accepted")
+	}
+
+	if got, err := decodeSockaddr(make([]byte, unix.SizeofSockaddrInet6)); got != nil || err != nil {
+		t.Fatalf("got unexpected result for AF_UNSPEC: %v, %v", got, err)
+	}
+}
+
+func mustParseCIDR(s string) net.IPNet {
+	_, ipnet, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return *ipnet
+}
+
+// message serializes the attributes into a genetlink message payload.
+func message(attrs []*nl.RtAttr) []byte {
+	b := (&nl.Genlmsg{Command: unix.WG_CMD_GET_DEVICE, Version: unix.WG_GENL_VERSION}).Serialize()
+
+	for _, a := range attrs {
+		b = append(b, a.Serialize()...)
+	}
+
+	return b
+}
+
+func TestBuildAndParseDevice(t *testing.T) {
+	k1, _ := ParseKey("xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg=")
+	k2, _ := ParseKey("TrMvSoP4jYQlY6RIzBgbssQqY3vxI2Pi+y71lOWWXX0=")
+	psk, _ := ParseKey("FpCyhws9cxwWoV4xELtfJvjJN+zQVRPISllRWgeopVE=")
+
+	cfg := Config{
+		ListenPort:   51820,
+		FirewallMark: 0x10,
+		ReplacePeers: true,
+		Peers: []*PeerConfig{
+			{
+				PublicKey:           k1,
+				PresharedKey:        psk,
+				Endpoint:            &net.UDPAddr{IP: net.IPv4(192, 0, 2, 1).To4(), Port: 51820},
+				PersistentKeepalive: 25 * time.Second,
+				ReplaceAllowedIPs:   true,
+				AllowedIPs:          []net.IPNet{mustParseCIDR("10.0.0.0/24"), mustParseCIDR("fd00::/64")},
+			},
+			{
+				PublicKey:  k2,
+				AllowedIPs: []net.IPNet{mustParseCIDR("10.0.1.1/32")},
+			},
+		},
+	}
+
+	msgs, err := buildDeviceAttrs("wg0", &cfg)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if len(msgs) != 1 {
+		t.Fatalf("got %d messages instead of one", len(msgs))
+	}
+
+	dev, err := parseDevice([][]byte{message(msgs[0])})
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	want := Device{
+		Name:         "wg0",
+		ListenPort:   51820,
+		FirewallMark: 0x10,
+		Peers: []*Peer{
+			{
+				PublicKey:           k1,
+				HasPresharedKey:     true,
+				Endpoint:            &net.UDPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 51820},
+				PersistentKeepalive: 25 * time.Second,
+				AllowedIPs:          []net.IPNet{mustParseCIDR("10.0.0.0/24"), mustParseCIDR("fd00::/64")},
+			},
+			{
+				PublicKey:  k2,
+				AllowedIPs: []net.IPNet{mustParseCIDR("10.0.1.1/32")},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(dev, &want) {
+		t.Fatalf("got invalid device:\nwant:\t%+v\ngot:\t%+v", want, dev)
+	}
+
+	if _, err := buildDeviceAttrs("wg0", &Config{Peers: []*PeerConfig{{}}}); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey, got %v", err)
+	}
+
+	if _, err := buildDeviceAttrs("wg0", &Config{Peers: []*PeerConfig{{PublicKey: k1, PersistentKeepalive: time.Millisecond}}}); err == nil {
+		t.Fatalf("invalid keepalive interval has been accepted")
+	}
+}
+
+func TestParseDeviceSplitPeer(t *testing.T) {
+	k1, _ := ParseKey("xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg=")
+
+	handshake := make([]byte, 16)
+	nl.NativeEndian().PutUint64(handshake[:8], 1700000000)
+
+	peers := nl.NewRtAttr(unix.WGDEVICE_A_PEERS|int(nl.NLA_F_NESTED), nil)
+
+	peer := peers.AddRtAttr(0|int(nl.NLA_F_NESTED), nil)
+	peer.AddRtAttr(unix.WGPEER_A_PUBLIC_KEY, k1[:])
+	peer.AddRtAttr(unix.WGPEER_A_LAST_HANDSHAKE_TIME, handshake)
+	peer.AddRtAttr(unix.WGPEER_A_RX_BYTES, nl.Uint64Attr(100))
+
+	ip := peer.AddRtAttr(unix.WGPEER_A_ALLOWEDIPS|int(nl.NLA_F_NESTED), nil).AddRtAttr(0|int(nl.NLA_F_NESTED), nil)
+	ip.AddRtAttr(unix.WGALLOWEDIP_A_FAMILY, nl.Uint16Attr(unix.AF_INET))
+	ip.AddRtAttr(unix.WGALLOWEDIP_A_IPADDR, []byte{10, 0, 1, 0})
+	ip.AddRtAttr(unix.WGALLOWEDIP_A_CIDR_MASK, nl.Uint8Attr(24))
+
+	first := []*nl.RtAttr{
+		nl.NewRtAttr(unix.WGDEVICE_A_IFINDEX, nl.Uint32Attr(7)),
+		peers,
+	}
+
+	// The same peer continued in the second message
+	second, _ := buildDeviceAttrs("wg0", &Config{Peers: []*PeerConfig{{PublicKey: k1, AllowedIPs: []net.IPNet{mustParseCIDR("10.0.0.0/24")}}}})
+
+	dev, err := parseDevice([][]byte{message(first), message(second[0])})
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if dev.Index != 7 || len(dev.Peers) != 1 {
+		t.Fatalf("got invalid device: %+v", dev)
+	}
+
+	if want := []net.IPNet{mustParseCIDR("10.0.1.0/24"), mustParseCIDR("10.0.0.0/24")}; !reflect.DeepEqual(dev.Peers[0].AllowedIPs, want) {
+		t.Fatalf("got invalid allowed IPs: %v", dev.Peers[0].AllowedIPs)
+	}
+
+	if !dev.Peers[0].LastHandshake.Equal(time.Unix(1700000000, 0)) || dev.Peers[0].RxBytes != 100 {
+		t.Fatalf("got invalid peer stats: %+v", dev.Peers[0])
+	}
+}
+
+// flags returns the device flags and the flags of every peer part
+// found in the serialized WG_CMD_SET_DEVICE message.
+func flags(t *testing.T, m []byte) (uint32, []uint32) {
+	attrs, err := parseAttrs(m[nl.SizeofGenlmsg:])
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	var devFlags uint32
+	var peerFlags []uint32
+
+	for _, a := range attrs {
+		switch a.Attr.Type {
+		case unix.WGDEVICE_A_FLAGS:
+			devFlags = nl.NativeEndian().Uint32(a.Value)
+		case unix.WGDEVICE_A_PEERS:
+			if len(a.Value) > 0xffff {
+				t.Fatalf("peers attribute is too long: %d", len(a.Value))
+			}
+
+			peers, _ := parseAttrs(a.Value)
+
+			for _, p := range peers {
+				var f uint32
+
+				pattrs, _ := parseAttrs(p.Value)
+
+				for _, pa := range pattrs {
+					if pa.Attr.Type == unix.WGPEER_A_FLAGS {
+						f = nl.NativeEndian().Uint32(pa.Value)
+					}
+				}
+
+				peerFlags = append(peerFlags, f)
+			}
+		}
+	}
+
+	return devFlags, peerFlags
+}
+
+func TestBuildDeviceLargePeerSet(t *testing.T) {
+	cfg := Config{ReplacePeers: true}
+
+	// About 200 KiB of peers, the last one alone needs several messages
+	for i := 0; i < 500; i++ {
+		p := PeerConfig{ReplaceAllowedIPs: true}
+
+		p.PublicKey[0], p.PublicKey[1], p.PublicKey[2] = byte(i>>8), byte(i), 1
+
+		n := 8
+		if i == 499 {
+			n = 4000
+		}
+
+		for j := 0; j < n; j++ {
+			p.AllowedIPs = append(p.AllowedIPs, mustParseCIDR(fmt.Sprintf("fd00:%x:%x::/64", i, j)))
+		}
+
+		cfg.Peers = append(cfg.Peers, &p)
+	}
+
+	msgs, err := buildDeviceAttrs("wg0", &cfg)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if len(msgs) < 2 {
+		t.Fatalf("peers have not been split: got %d message(s)", len(msgs))
+	}
+
+	raw := make([][]byte, 0, len(msgs))
+	seen := make(map[Key]struct{})
+
+	for i, attrs := range msgs {
+		m := message(attrs)
+
+		devFlags, peerFlags := flags(t, m)
+
+		if (i == 0) != (devFlags&unix.WGDEVICE_F_REPLACE_PEERS != 0) {
+			t.Fatalf("message %d: got unexpected device flags: %#x", i, devFlags)
+		}
+
+		dev, err := parseDevice([][]byte{m})
+		if err != nil {
+			t.Fatalf("message %d: got unexpected error: %v", i, err)
+		}
+
+		for j, p := range dev.Peers {
+			_, cont := seen[p.PublicKey]
+
+			if cont != (peerFlags[j]&unix.WGPEER_F_REPLACE_ALLOWEDIPS == 0) {
+				t.Fatalf("message %d: peer %s: got unexpected flags: %#x", i, p.PublicKey, peerFlags[j])
+			}
+
+			seen[p.PublicKey] = struct{}{}
+		}
+
+		raw = append(raw, m)
+	}
+
+	dev, err := parseDevice(raw)
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+
+	if len(dev.Peers) != len(cfg.Peers) {
+		t.Fatalf("got %d peers instead of %d", len(dev.Peers), len(cfg.Peers))
+	}
+
+	for i, p := range dev.Peers {
+		if p.PublicKey != cfg.Peers[i].PublicKey || !reflect.DeepEqual(p.AllowedIPs, cfg.Peers[i].AllowedIPs) {
+			t.Fatalf("peer %d: got invalid peer: %s with %d allowed IPs", i, p.PublicKey, len(p.AllowedIPs))
+		}
+	}
+}
@@ -39,6 +39,14 @@ func guestInfoToProto(info *core.GuestInfo) *pb_types.GuestInfo {
 			Buffers:   info.Memory.Buffers,
 			Cached:    info.Memory.Cached,
 			FreeTotal: info.Memory.FreeTotal,
+
+			Available:      info.Memory.Available,
+			Dirty:          info.Memory.Dirty,
+			Writeback:      info.Memory.Writeback,
+			Slab:           info.Memory.Slab,
+			HugePagesTotal: info.Memory.HugePagesTotal,
+			HugePagesFree:  info.Memory.HugePagesFree,
+			HugePageSize:   info.Memory.HugePageSize,
 		}
 	}
 
@@ -72,5 +80,75 @@ func guestInfoToProto(info *core.GuestInfo) *pb_types.GuestInfo {
 		})
 	}
 
+	if info.CPU != nil {
+		proto.Cpu = cpuStatToProto(info.CPU)
+	}
+
+	for _, c := range info.CPUs {
+		proto.Cpus = append(proto.Cpus, cpuStatToProto(c))
+	}
+
+	for _, d := range info.Disks {
+		proto.Disks = append(proto.Disks, &pb_types.GuestInfo_DiskStat{
+			Name:             d.Name,
+			ReadsPerSec:      d.ReadsPerSec,
+			WritesPerSec:     d.WritesPerSec,
+			ReadBytesPerSec:  d.ReadBytesPerSec,
+			WriteBytesPerSec: d.WriteBytesPerSec,
+			Utilization:      d.Utilization,
+			InFlight:         d.InFlight,
+			Reads:            d.Reads,
+			Writes:           d.Writes,
+			ReadBytes:        d.ReadBytes,
+			WriteBytes:       d.WriteBytes,
+		})
+	}
+
+	for _, n := range info.NetDevs {
+		proto.Netdevs = append(proto.Netdevs, &pb_types.GuestInfo_NetDevStat{
+			Name:            n.Name,
+			RxBytesPerSec:   n.RxBytesPerSec,
+			TxBytesPerSec:   n.TxBytesPerSec,
+			RxPacketsPerSec: n.RxPacketsPerSec,
+			TxPacketsPerSec: n.TxPacketsPerSec,
+			RxBytes:         n.RxBytes,
+			TxBytes:         n.TxBytes,
+			RxPackets:       n.RxPackets,
+			TxPackets:       n.TxPackets,
+			RxErrors:        n.RxErrors,
+			TxErrors:        n.TxErrors,
+			RxDropped:       n.RxDropped,
+			TxDropped:       n.TxDropped,
+		})
+	}
+
+	for _, p := range info.Pressure {
+		proto.Pressure = append(proto.Pressure, &pb_types.GuestInfo_PressureStat{
+			Resource:   p.Resource,
+			SomeAvg10:  p.SomeAvg10,
+			SomeAvg60:  p.SomeAvg60,
+			SomeAvg300: p.SomeAvg300,
+			SomeTotal:  p.SomeTotal,
+			FullAvg10:  p.FullAvg10,
+			FullAvg60:  p.FullAvg60,
+			FullAvg300: p.FullAvg300,
+			FullTotal:  p.FullTotal,
+		})
+	}
+
 	return &proto
 }
+
+func cpuStatToProto(c *core.GuestInfo_CPUStat) *pb_types.GuestInfo_CPUStat {
+	return &pb_types.GuestInfo_CPUStat{
+		Name:    c.Name,
+		User:    c.User,
+		Nice:    c.Nice,
+		System:  c.System,
+		Idle:    c.Idle,
+		Iowait:  c.Iowait,
+		Irq:     c.Irq,
+		Softirq: c.Softirq,
+		Steal:   c.Steal,
+	}
+}
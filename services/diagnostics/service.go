@@ -0,0 +1,147 @@
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xef53/phoenix-guest-agent/core"
+	"github.com/0xef53/phoenix-guest-agent/internal/pcap"
+	"github.com/0xef53/phoenix-guest-agent/services"
+
+	pb "github.com/0xef53/phoenix-guest-agent/api/services/diagnostics/v2"
+
+	grpcserver "github.com/0xef53/go-grpc/server"
+
+	grpc_runtime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	grpc "google.golang.org/grpc"
+	grpc_codes "google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+)
+
+var _ = pb.AgentDiagnosticsServiceServer(new(Service))
+
+func init() {
+	grpcserver.Register(new(Service), grpcserver.WithServiceBucket("pga"))
+}
+
+type Service struct {
+	*services.ServiceServer
+}
+
+func (s *Service) Init(inner *services.ServiceServer) {
+	s.ServiceServer = inner
+}
+
+func (s *Service) Name() string {
+	return fmt.Sprintf("%T", s)
+}
+
+func (s *Service) RegisterGRPC(server *grpc.Server) {
+	pb.RegisterAgentDiagnosticsServiceServer(server, s)
+}
+
+func (s *Service) RegisterGW(_ *grpc_runtime.ServeMux, _ string, _ []grpc.DialOption) {}
+
+func (s *Service) Ping(req *pb.PingRequest, stream pb.AgentDiagnosticsService_PingServer) error {
+	opts := core.PingOptions{
+		Host:     req.Host,
+		Family:   int(req.Family),
+		Count:    int(req.Count),
+		Interval: time.Duration(req.IntervalMs) * time.Millisecond,
+		Timeout:  time.Duration(req.TimeoutMs) * time.Millisecond,
+		Size:     int(req.Size),
+		TTL:      int(req.Ttl),
+	}
+
+	stats, err := s.ServiceServer.Ping(stream.Context(), &opts, func(r *core.PingReply) error {
+		return stream.Send(&pb.PingResponse{Result: &pb.PingResponse_Reply{Reply: pingReplyToProto(r)}})
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.PingResponse{Result: &pb.PingResponse_Stats{Stats: pingStatsToProto(stats)}})
+}
+
+func (s *Service) LookupDNS(ctx context.Context, req *pb.LookupDNSRequest) (*pb.LookupDNSResponse, error) {
+	res, err := s.ServiceServer.LookupDNS(ctx, req.Name, req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LookupDNSResponse{
+		Name:        res.Name,
+		Type:        res.Type,
+		Records:     res.Records,
+		Nameservers: res.Nameservers,
+		Search:      res.Search,
+		DurationUs:  uint64(res.Duration.Microseconds()),
+	}, nil
+}
+
+func (s *Service) ProbeConnect(req *pb.ProbeConnectRequest, stream pb.AgentDiagnosticsService_ProbeConnectServer) error {
+	opts := core.ConnectOptions{
+		Network:  req.Network,
+		Host:     req.Host,
+		Port:     int(req.Port),
+		Family:   int(req.Family),
+		Count:    int(req.Count),
+		Interval: time.Duration(req.IntervalMs) * time.Millisecond,
+		Timeout:  time.Duration(req.TimeoutMs) * time.Millisecond,
+	}
+
+	stats, err := s.ServiceServer.ProbeConnect(stream.Context(), &opts, func(p *core.ConnectProbe) error {
+		return stream.Send(&pb.ProbeConnectResponse{Result: &pb.ProbeConnectResponse_Probe{Probe: connectProbeToProto(p)}})
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.ProbeConnectResponse{Result: &pb.ProbeConnectResponse_Stats{Stats: connectStatsToProto(stats)}})
+}
+
+func (s *Service) Traceroute(req *pb.TracerouteRequest, stream pb.AgentDiagnosticsService_TracerouteServer) error {
+	opts := core.TracerouteOptions{
+		Host:    req.Host,
+		Family:  int(req.Family),
+		Port:    int(req.Port),
+		MaxHops: int(req.MaxHops),
+		Queries: int(req.Queries),
+		Timeout: time.Duration(req.TimeoutMs) * time.Millisecond,
+	}
+
+	stats, err := s.ServiceServer.Traceroute(stream.Context(), &opts, func(h *core.TracerouteHop) error {
+		return stream.Send(&pb.TracerouteResponse{Result: &pb.TracerouteResponse_Hop{Hop: tracerouteHopToProto(h)}})
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.TracerouteResponse{Result: &pb.TracerouteResponse_Stats{Stats: tracerouteStatsToProto(stats)}})
+}
+
+func (s *Service) CapturePackets(req *pb.CapturePacketsRequest, stream pb.AgentDiagnosticsService_CapturePacketsServer) error {
+	opts := core.CaptureOptions{
+		Interface:  req.LinkName,
+		Filter:     req.BpfFilter,
+		SnapLen:    int(req.Snaplen),
+		MaxPackets: int(req.MaxPackets),
+		Duration:   time.Duration(req.Duration) * time.Second,
+	}
+
+	_, err := s.ServiceServer.CapturePackets(stream.Context(), &opts, func(b []byte) error {
+		return stream.Send(&pb.CapturePacketsResponse{Data: b})
+	})
+
+	// The error mapping interceptor handles only unary calls
+	switch {
+	case errors.Is(err, pcap.ErrNotInstalled):
+		return grpc_status.Error(grpc_codes.Unimplemented, err.Error())
+	case errors.Is(err, pcap.ErrInvalidFilter):
+		return grpc_status.Error(grpc_codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,87 @@
+package diagnostics
+
+import (
+	"net"
+
+	"github.com/0xef53/phoenix-guest-agent/core"
+
+	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
+)
+
+func ipToString(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+
+	return ip.String()
+}
+
+func pingReplyToProto(r *core.PingReply) *pb_types.PingReply {
+	return &pb_types.PingReply{
+		Seq:   uint32(r.Seq),
+		From:  ipToString(r.From),
+		Size:  uint32(r.Size),
+		Ttl:   uint32(r.TTL),
+		RttUs: uint64(r.RTT.Microseconds()),
+		Lost:  r.Lost,
+		Error: r.Error,
+	}
+}
+
+func pingStatsToProto(s *core.PingStats) *pb_types.PingStats {
+	return &pb_types.PingStats{
+		Addr:     ipToString(s.Addr),
+		Sent:     uint32(s.Sent),
+		Received: uint32(s.Received),
+		MinRttUs: uint64(s.MinRTT.Microseconds()),
+		AvgRttUs: uint64(s.AvgRTT.Microseconds()),
+		MaxRttUs: uint64(s.MaxRTT.Microseconds()),
+	}
+}
+
+func connectProbeToProto(p *core.ConnectProbe) *pb_types.ConnectProbe {
+	return &pb_types.ConnectProbe{
+		Seq:       uint32(p.Seq),
+		LocalAddr: p.LocalAddr,
+		RttUs:     uint64(p.RTT.Microseconds()),
+		Lost:      p.Lost,
+		Error:     p.Error,
+	}
+}
+
+func connectStatsToProto(s *core.ConnectStats) *pb_types.ConnectStats {
+	return &pb_types.ConnectStats{
+		Addr:      s.Addr,
+		Sent:      uint32(s.Sent),
+		Succeeded: uint32(s.Succeeded),
+		MinRttUs:  uint64(s.MinRTT.Microseconds()),
+		AvgRttUs:  uint64(s.AvgRTT.Microseconds()),
+		MaxRttUs:  uint64(s.MaxRTT.Microseconds()),
+	}
+}
+
+func tracerouteHopToProto(h *core.TracerouteHop) *pb_types.TracerouteHop {
+	hop := pb_types.TracerouteHop{
+		Ttl:    uint32(h.TTL),
+		Probes: make([]*pb_types.TracerouteHop_Probe, 0, len(h.Probes)),
+	}
+
+	for _, p := range h.Probes {
+		hop.Probes = append(hop.Probes, &pb_types.TracerouteHop_Probe{
+			From:  ipToString(p.From),
+			RttUs: uint64(p.RTT.Microseconds()),
+			Lost:  p.Lost,
+			Error: p.Error,
+		})
+	}
+
+	return &hop
+}
+
+func tracerouteStatsToProto(s *core.TracerouteStats) *pb_types.TracerouteStats {
+	return &pb_types.TracerouteStats{
+		Addr:    ipToString(s.Addr),
+		Hops:    uint32(s.Hops),
+		Reached: s.Reached,
+	}
+}
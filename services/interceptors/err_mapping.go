@@ -4,8 +4,15 @@ import (
 	"context"
 	"errors"
 	"io/fs"
+	"net"
 
 	"github.com/0xef53/phoenix-guest-agent/core"
+	"github.com/0xef53/phoenix-guest-agent/internal/cryptsetup"
+	"github.com/0xef53/phoenix-guest-agent/internal/lvm"
+	"github.com/0xef53/phoenix-guest-agent/internal/netpersist"
+	"github.com/0xef53/phoenix-guest-agent/internal/nftables"
+	"github.com/0xef53/phoenix-guest-agent/internal/sysctl"
+	"github.com/0xef53/phoenix-guest-agent/internal/wireguard"
 
 	grpc "google.golang.org/grpc"
 	grpc_codes "google.golang.org/grpc/codes"
@@ -19,18 +26,69 @@ func MapErrorsUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			return resp, nil
 		}
 
-		switch grpc_status.Code(err) {
-		case grpc_codes.NotFound:
+		// Already mapped by the service
+		if _, ok := grpc_status.FromError(err); ok {
 			return nil, err
 		}
 
 		var code grpc_codes.Code
 
+		var lvmErr *lvm.Error
+		var cryptsetupErr *cryptsetup.Error
+		var nftErr *nftables.Error
+		var dnsErr *net.DNSError
+
 		switch {
 		case errors.Is(err, fs.ErrNotExist):
 			code = grpc_codes.NotFound
 		case errors.Is(err, core.ErrNotReadyNow):
 			code = grpc_codes.NotFound
+		case errors.Is(err, core.ErrLinkIsUp):
+			code = grpc_codes.FailedPrecondition
+		case errors.Is(err, core.ErrUnsupportedLinkType):
+			code = grpc_codes.InvalidArgument
+		case errors.Is(err, core.ErrQdiscNotSupported):
+			code = grpc_codes.Unimplemented
+		case errors.Is(err, core.ErrNetworkConfigPending):
+			code = grpc_codes.FailedPrecondition
+		case errors.Is(err, core.ErrNetworkConfigNotFound):
+			code = grpc_codes.NotFound
+		case errors.Is(err, netpersist.ErrNoBackend):
+			code = grpc_codes.FailedPrecondition
+		case errors.Is(err, core.ErrFirewallRulesetPending):
+			code = grpc_codes.FailedPrecondition
+		case errors.Is(err, core.ErrFirewallRulesetNotFound):
+			code = grpc_codes.NotFound
+		case errors.Is(err, nftables.ErrInvalidRuleset), errors.Is(err, nftables.ErrForeignObject):
+			code = grpc_codes.InvalidArgument
+		case errors.Is(err, sysctl.ErrInvalidKey):
+			code = grpc_codes.InvalidArgument
+		case errors.Is(err, sysctl.ErrNotAllowed):
+			code = grpc_codes.PermissionDenied
+		case errors.Is(err, wireguard.ErrInvalidKey):
+			code = grpc_codes.InvalidArgument
+		case errors.Is(err, wireguard.ErrNotSupported):
+			code = grpc_codes.Unimplemented
+		case errors.Is(err, lvm.ErrNotInstalled):
+			code = grpc_codes.Unimplemented
+		case errors.As(err, &lvmErr):
+			code = grpc_codes.FailedPrecondition
+		case errors.Is(err, core.ErrInvalidDevicePath), errors.Is(err, core.ErrInvalidMappingName), errors.Is(err, core.ErrEmptyKey):
+			code = grpc_codes.InvalidArgument
+		case errors.Is(err, cryptsetup.ErrNotInstalled):
+			code = grpc_codes.Unimplemented
+		case errors.As(err, &cryptsetupErr):
+			code = grpc_codes.FailedPrecondition
+		case errors.Is(err, nftables.ErrNotInstalled):
+			code = grpc_codes.Unimplemented
+		case errors.As(err, &nftErr):
+			code = grpc_codes.FailedPrecondition
+		case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+			code = grpc_codes.NotFound
+		case errors.As(err, &dnsErr) && dnsErr.IsTimeout:
+			code = grpc_codes.DeadlineExceeded
+		case errors.As(err, &dnsErr):
+			code = grpc_codes.Unavailable
 		default:
 			code = grpc_codes.Internal
 		}
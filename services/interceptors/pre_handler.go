@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/0xef53/phoenix-guest-agent/services/filesystem"
+	"github.com/0xef53/phoenix-guest-agent/services/storage"
 	"github.com/0xef53/phoenix-guest-agent/services/system"
 
 	grpc "google.golang.org/grpc"
@@ -19,6 +20,8 @@ func PreHandlerUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			locked = s.IsLocked()
 		case *filesystem.Service:
 			locked = s.IsLocked()
+		case *storage.Service:
+			locked = s.IsLocked()
 		}
 
 		if locked {
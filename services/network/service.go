@@ -3,23 +3,28 @@ package network
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/0xef53/phoenix-guest-agent/core"
 	"github.com/0xef53/phoenix-guest-agent/services"
 
 	pb "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
+	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
 
 	grpcserver "github.com/0xef53/go-grpc/server"
 
 	grpc_runtime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	grpc "google.golang.org/grpc"
+	grpc_codes "google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
 
 	empty "github.com/golang/protobuf/ptypes/empty"
-	"github.com/vishvananda/netlink"
 )
 
 var _ = pb.AgentNetworkServiceServer(new(Service))
 
+var errPersistWithoutLink = grpc_status.Error(grpc_codes.InvalidArgument, "only routes with an output device can be persisted")
+
 func init() {
 	grpcserver.Register(new(Service), grpcserver.WithServiceBucket("pga"))
 }
@@ -43,7 +48,7 @@ func (s *Service) RegisterGRPC(server *grpc.Server) {
 func (s *Service) RegisterGW(_ *grpc_runtime.ServeMux, _ string, _ []grpc.DialOption) {}
 
 func (s *Service) GetRouteList(ctx context.Context, req *pb.GetRouteListRequest) (*pb.GetRouteListResponse, error) {
-	routes, err := s.ServiceServer.GetRouteList(ctx, core.InetFamily(req.Family))
+	routes, err := s.ServiceServer.GetRouteList(ctx, core.InetFamily(req.Family), int(req.Table))
 	if err != nil {
 		return nil, err
 	}
@@ -52,41 +57,121 @@ func (s *Service) GetRouteList(ctx context.Context, req *pb.GetRouteListRequest)
 }
 
 func (s *Service) AddRoute(ctx context.Context, req *pb.RouteRequest) (*pb.AddRouteResponse, error) {
-	attrs := core.RouteAttrs{
-		LinkName: req.LinkName,
-		Scope:    netlink.Scope(req.Scope),
-		Dst:      req.Dst,
-		Src:      req.Src,
-		Gw:       req.Gw,
-		Table:    int(req.Table),
+	if req.Persist && len(req.LinkName) == 0 {
+		return nil, errPersistWithoutLink
 	}
 
-	r, err := s.ServiceServer.AddRoute(ctx, &attrs)
+	r, err := s.ServiceServer.AddRoute(ctx, routeAttrsFromProto(req))
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Persist {
+		if err := s.ServiceServer.PersistInterfaceConfig(ctx, req.LinkName); err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb.AddRouteResponse{Route: routeToProto(r)}, nil
 }
 
 func (s *Service) DelRoute(ctx context.Context, req *pb.RouteRequest) (*pb.DelRouteResponse, error) {
-	attrs := core.RouteAttrs{
-		LinkName: req.LinkName,
-		Scope:    netlink.Scope(req.Scope),
-		Dst:      req.Dst,
-		Src:      req.Src,
-		Gw:       req.Gw,
-		Table:    int(req.Table),
+	if req.Persist && len(req.LinkName) == 0 {
+		return nil, errPersistWithoutLink
 	}
 
-	r, err := s.ServiceServer.DelRoute(ctx, &attrs)
+	r, err := s.ServiceServer.DelRoute(ctx, routeAttrsFromProto(req))
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Persist {
+		if err := s.ServiceServer.PersistInterfaceConfig(ctx, req.LinkName); err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb.DelRouteResponse{Route: routeToProto(r)}, nil
 }
 
+func (s *Service) ReplaceRoute(ctx context.Context, req *pb.RouteRequest) (*pb.ReplaceRouteResponse, error) {
+	if req.Persist && len(req.LinkName) == 0 {
+		return nil, errPersistWithoutLink
+	}
+
+	r, err := s.ServiceServer.ReplaceRoute(ctx, routeAttrsFromProto(req))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Persist {
+		if err := s.ServiceServer.PersistInterfaceConfig(ctx, req.LinkName); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ReplaceRouteResponse{Route: routeToProto(r)}, nil
+}
+
+func (s *Service) ListRules(ctx context.Context, req *pb.ListRulesRequest) (*pb.ListRulesResponse, error) {
+	rules, err := s.ServiceServer.GetRuleList(ctx, core.InetFamily(req.Family))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListRulesResponse{Rules: rulesToProto(rules)}, nil
+}
+
+func (s *Service) AddRule(ctx context.Context, req *pb.RuleRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.AddRule(ctx, ruleAttrsFromProto(req)); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) DelRule(ctx context.Context, req *pb.RuleRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.DelRule(ctx, ruleAttrsFromProto(req)); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) ListNeighbors(ctx context.Context, req *pb.ListNeighborsRequest) (*pb.ListNeighborsResponse, error) {
+	neighs, err := s.ServiceServer.GetNeighborList(ctx, core.InetFamily(req.Family), req.LinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListNeighborsResponse{Neighbors: neighborsToProto(neighs)}, nil
+}
+
+func (s *Service) AddNeighbor(ctx context.Context, req *pb.NeighborRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.AddNeighbor(ctx, req.LinkName, req.Ip, req.HwAddr); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) DelNeighbor(ctx context.Context, req *pb.NeighborRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.DelNeighbor(ctx, req.LinkName, req.Ip); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) FlushNeighbors(ctx context.Context, req *pb.FlushNeighborsRequest) (*pb.FlushNeighborsResponse, error) {
+	count, err := s.ServiceServer.FlushNeighbors(ctx, core.InetFamily(req.Family), req.LinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FlushNeighborsResponse{Count: int32(count)}, nil
+}
+
 func (s *Service) GetInterfaces(ctx context.Context, _ *empty.Empty) (*pb.GetInterfacesResponse, error) {
 	ifaces, err := s.ServiceServer.GetInterfaces(ctx)
 	if err != nil {
@@ -114,12 +199,94 @@ func (s *Service) SetInterfaceLinkDown(ctx context.Context, req *pb.SetInterface
 	return new(empty.Empty), nil
 }
 
+func (s *Service) SetLinkAttributes(ctx context.Context, req *pb.SetLinkAttributesRequest) (*pb.SetLinkAttributesResponse, error) {
+	attrs := core.LinkAttrs{
+		MTU:        int(req.MTU),
+		HwAddr:     req.HwAddr,
+		Name:       req.NewName,
+		TxQLen:     int(req.Txqueuelen),
+		Alias:      req.Alias,
+		ClearAlias: req.ClearAlias,
+	}
+
+	iface, err := s.ServiceServer.SetLinkAttributes(ctx, req.LinkName, &attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetLinkAttributesResponse{Interface: ifaceToProto(iface)}, nil
+}
+
+func (s *Service) CreateLink(ctx context.Context, req *pb.CreateLinkRequest) (*pb.CreateLinkResponse, error) {
+	attrs, err := virtualLinkAttrsFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	iface, err := s.ServiceServer.CreateLink(ctx, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateLinkResponse{Interface: ifaceToProto(iface)}, nil
+}
+
+func (s *Service) DeleteLink(ctx context.Context, req *pb.DeleteLinkRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.DeleteLink(ctx, req.LinkName); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) WatchNetwork(req *pb.WatchNetworkRequest, stream pb.AgentNetworkService_WatchNetworkServer) error {
+	opts := core.NetworkWatchOptions{
+		Links:     req.Links,
+		Addrs:     req.Addrs,
+		Routes:    req.Routes,
+		Neighbors: req.Neighbors,
+	}
+
+	return s.ServiceServer.WatchNetwork(stream.Context(), &opts, func(ev *core.NetworkEvent) error {
+		return stream.Send(networkEventToProto(ev))
+	})
+}
+
+func (s *Service) ApplyNetworkConfig(ctx context.Context, req *pb.ApplyNetworkConfigRequest) (*pb.ApplyNetworkConfigResponse, error) {
+	timeout := time.Duration(req.Timeout) * time.Second
+
+	if timeout == 0 {
+		timeout = core.DefaultNetworkConfigTimeout
+	}
+
+	id, err := s.ServiceServer.ApplyNetworkConfig(ctx, networkConfigFromProto(req), timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApplyNetworkConfigResponse{Id: id, Timeout: uint32(timeout.Seconds())}, nil
+}
+
+func (s *Service) ConfirmNetworkConfig(ctx context.Context, req *pb.ConfirmNetworkConfigRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.ConfirmNetworkConfig(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
 func (s *Service) AddIPAddr(ctx context.Context, req *pb.IPAddrRequest) (*empty.Empty, error) {
 	err := s.ServiceServer.AddIPAddr(ctx, req.LinkName, req.Addr)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Persist {
+		if err := s.ServiceServer.PersistInterfaceConfig(ctx, req.LinkName); err != nil {
+			return nil, err
+		}
+	}
+
 	return new(empty.Empty), nil
 }
 
@@ -129,5 +296,170 @@ func (s *Service) DelIPAddr(ctx context.Context, req *pb.IPAddrRequest) (*empty.
 		return nil, err
 	}
 
+	if req.Persist {
+		if err := s.ServiceServer.PersistInterfaceConfig(ctx, req.LinkName); err != nil {
+			return nil, err
+		}
+	}
+
 	return new(empty.Empty), nil
 }
+
+func (s *Service) GetFirewallRuleset(ctx context.Context, _ *empty.Empty) (*pb.GetFirewallRulesetResponse, error) {
+	ruleset, err := s.ServiceServer.GetFirewallRuleset(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetFirewallRulesetResponse{Ruleset: string(ruleset)}, nil
+}
+
+func (s *Service) ApplyFirewallRuleset(ctx context.Context, req *pb.ApplyFirewallRulesetRequest) (*pb.ApplyFirewallRulesetResponse, error) {
+	timeout := time.Duration(req.Timeout) * time.Second
+
+	if timeout == 0 {
+		timeout = core.DefaultFirewallRulesetTimeout
+	}
+
+	id, err := s.ServiceServer.ApplyFirewallRuleset(ctx, []byte(req.Ruleset), timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApplyFirewallRulesetResponse{Id: id, Timeout: uint32(timeout.Seconds())}, nil
+}
+
+func (s *Service) ConfirmFirewallRuleset(ctx context.Context, req *pb.ConfirmFirewallRulesetRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.ConfirmFirewallRuleset(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) GetWireguardDevice(ctx context.Context, req *pb.GetWireguardDeviceRequest) (*pb.GetWireguardDeviceResponse, error) {
+	dev, err := s.ServiceServer.GetWireguardDevice(ctx, req.LinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetWireguardDeviceResponse{Device: wireguardDeviceToProto(dev)}, nil
+}
+
+func (s *Service) ConfigureWireguard(ctx context.Context, req *pb.ConfigureWireguardRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.ConfigureWireguard(ctx, req.LinkName, wireguardConfigFromProto(req)); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) ListQdiscs(ctx context.Context, req *pb.TrafficControlRequest) (*pb.ListQdiscsResponse, error) {
+	qdiscs, err := s.ServiceServer.ListQdiscs(ctx, req.LinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.ListQdiscsResponse{
+		Qdiscs: make([]*pb_types.QdiscInfo, 0, len(qdiscs)),
+	}
+
+	for _, q := range qdiscs {
+		resp.Qdiscs = append(resp.Qdiscs, qdiscToProto(q))
+	}
+
+	return &resp, nil
+}
+
+func (s *Service) ListTrafficClasses(ctx context.Context, req *pb.TrafficControlRequest) (*pb.ListTrafficClassesResponse, error) {
+	classes, err := s.ServiceServer.ListTrafficClasses(ctx, req.LinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.ListTrafficClassesResponse{
+		Classes: make([]*pb_types.TrafficClassInfo, 0, len(classes)),
+	}
+
+	for _, c := range classes {
+		resp.Classes = append(resp.Classes, &pb_types.TrafficClassInfo{
+			Kind:   c.Kind,
+			Handle: c.Handle,
+			Parent: c.Parent,
+			Stats:  tcStatsToProto(c.Stats),
+			Rate:   c.Rate,
+			Ceil:   c.Ceil,
+		})
+	}
+
+	return &resp, nil
+}
+
+func (s *Service) ListTrafficFilters(ctx context.Context, req *pb.TrafficControlRequest) (*pb.ListTrafficFiltersResponse, error) {
+	filters, err := s.ServiceServer.ListTrafficFilters(ctx, req.LinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.ListTrafficFiltersResponse{
+		Filters: make([]*pb_types.TrafficFilterInfo, 0, len(filters)),
+	}
+
+	for _, f := range filters {
+		resp.Filters = append(resp.Filters, &pb_types.TrafficFilterInfo{
+			Kind:     f.Kind,
+			Handle:   f.Handle,
+			Parent:   f.Parent,
+			Priority: int32(f.Priority),
+			Protocol: f.Protocol,
+			ClassId:  f.ClassID,
+		})
+	}
+
+	return &resp, nil
+}
+
+func (s *Service) SetRootQdisc(ctx context.Context, req *pb.SetRootQdiscRequest) (*empty.Empty, error) {
+	attrs, err := qdiscAttrsFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ServiceServer.SetRootQdisc(ctx, req.LinkName, attrs); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) DeleteRootQdisc(ctx context.Context, req *pb.TrafficControlRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.DeleteRootQdisc(ctx, req.LinkName); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) ListSockets(ctx context.Context, req *pb.ListSocketsRequest) (*pb.ListSocketsResponse, error) {
+	opts := core.SocketListOptions{
+		TCP:           req.Tcp,
+		UDP:           req.Udp,
+		Unix:          req.Unix,
+		ListeningOnly: req.ListeningOnly,
+	}
+
+	sockets, err := s.ServiceServer.ListSockets(ctx, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.ListSocketsResponse{
+		Sockets: make([]*pb_types.Socket, 0, len(sockets)),
+	}
+
+	for _, sock := range sockets {
+		resp.Sockets = append(resp.Sockets, socketToProto(sock))
+	}
+
+	return &resp, nil
+}
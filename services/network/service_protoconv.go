@@ -1,11 +1,46 @@
 package network
 
 import (
+	"time"
+
 	"github.com/0xef53/phoenix-guest-agent/core"
 
+	pb "github.com/0xef53/phoenix-guest-agent/api/services/agent/v2"
 	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
+
+	"github.com/vishvananda/netlink"
+	grpc_codes "google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
 )
 
+func routeAttrsFromProto(req *pb.RouteRequest) *core.RouteAttrs {
+	attrs := core.RouteAttrs{
+		LinkName:  req.LinkName,
+		Scope:     netlink.Scope(req.Scope),
+		Dst:       req.Dst,
+		Src:       req.Src,
+		Gw:        req.Gw,
+		Table:     int(req.Table),
+		Priority:  int(req.Priority),
+		Protocol:  int(req.Protocol),
+		Type:      int(req.Type),
+		MTU:       int(req.MTU),
+		OnLink:    req.Onlink,
+		MultiPath: make([]*core.RouteNextHop, 0, len(req.Multipath)),
+	}
+
+	for _, nh := range req.Multipath {
+		attrs.MultiPath = append(attrs.MultiPath, &core.RouteNextHop{
+			LinkName: nh.LinkName,
+			Gw:       nh.Gw,
+			Weight:   int(nh.Weight),
+			OnLink:   nh.Onlink,
+		})
+	}
+
+	return &attrs
+}
+
 func routeToProto(route *core.RouteInfo) *pb_types.RouteInfo {
 	proto := pb_types.RouteInfo{
 		LinkIndex: int32(route.LinkIndex),
@@ -15,6 +50,22 @@ func routeToProto(route *core.RouteInfo) *pb_types.RouteInfo {
 		Src:       route.Src,
 		Gw:        route.Gw,
 		Table:     int32(route.Table),
+		Priority:  int32(route.Priority),
+		Protocol:  uint32(route.Protocol),
+		Type:      pb_types.RouteType(route.Type),
+		MTU:       int32(route.MTU),
+		Onlink:    route.OnLink,
+		Multipath: make([]*pb_types.RouteNextHop, 0, len(route.MultiPath)),
+	}
+
+	for _, nh := range route.MultiPath {
+		proto.Multipath = append(proto.Multipath, &pb_types.RouteNextHop{
+			LinkIndex: int32(nh.LinkIndex),
+			LinkName:  nh.LinkName,
+			Gw:        nh.Gw,
+			Weight:    int32(nh.Weight),
+			Onlink:    nh.OnLink,
+		})
 	}
 
 	return &proto
@@ -30,14 +81,192 @@ func routesToProto(routes []*core.RouteInfo) []*pb_types.RouteInfo {
 	return protos
 }
 
+func networkEventToProto(ev *core.NetworkEvent) *pb.NetworkEvent {
+	proto := pb.NetworkEvent{
+		Action: pb.NetworkEvent_NEW,
+	}
+
+	if ev.Deleted {
+		proto.Action = pb.NetworkEvent_DEL
+	}
+
+	switch {
+	case ev.Link != nil:
+		proto.Event = &pb.NetworkEvent_Link{Link: ifaceToProto(ev.Link)}
+	case ev.Addr != nil:
+		proto.Event = &pb.NetworkEvent_Addr_{
+			Addr: &pb.NetworkEvent_Addr{
+				LinkIndex: int32(ev.Addr.LinkIndex),
+				LinkName:  ev.Addr.LinkName,
+				Addr:      ev.Addr.Addr,
+				Scope:     int32(ev.Addr.Scope),
+				Flags:     uint32(ev.Addr.Flags),
+			},
+		}
+	case ev.Route != nil:
+		proto.Event = &pb.NetworkEvent_Route{Route: routeToProto(ev.Route)}
+	case ev.Neighbor != nil:
+		proto.Event = &pb.NetworkEvent_Neighbor{Neighbor: neighborToProto(ev.Neighbor)}
+	}
+
+	return &proto
+}
+
+func networkConfigFromProto(req *pb.ApplyNetworkConfigRequest) *core.NetworkConfig {
+	cfg := core.NetworkConfig{
+		Links:  make([]*core.NetworkLinkConfig, 0, len(req.Links)),
+		Routes: make([]*core.RouteAttrs, 0, len(req.Routes)),
+		Rules:  make([]*core.RuleAttrs, 0, len(req.Rules)),
+	}
+
+	for _, l := range req.Links {
+		cfg.Links = append(cfg.Links, &core.NetworkLinkConfig{
+			Name:  l.LinkName,
+			MTU:   int(l.MTU),
+			State: l.State,
+			Addrs: l.Addrs,
+		})
+	}
+
+	for _, r := range req.Routes {
+		cfg.Routes = append(cfg.Routes, routeAttrsFromProto(r))
+	}
+
+	for _, r := range req.Rules {
+		cfg.Rules = append(cfg.Rules, ruleAttrsFromProto(r))
+	}
+
+	return &cfg
+}
+
+func virtualLinkAttrsFromProto(req *pb.CreateLinkRequest) (*core.VirtualLinkAttrs, error) {
+	attrs := core.VirtualLinkAttrs{
+		Name:   req.LinkName,
+		MTU:    int(req.MTU),
+		HwAddr: req.HwAddr,
+		Up:     req.Up,
+	}
+
+	switch x := req.Options.(type) {
+	case *pb.CreateLinkRequest_Vlan_:
+		attrs.Type = "vlan"
+		attrs.Parent = x.Vlan.Parent
+		attrs.VlanID = int(x.Vlan.VlanId)
+		attrs.VlanProtocol = x.Vlan.Protocol
+	case *pb.CreateLinkRequest_Bridge_:
+		attrs.Type = "bridge"
+		attrs.Ports = x.Bridge.Ports
+	case *pb.CreateLinkRequest_Bond_:
+		attrs.Type = "bond"
+		attrs.BondMode = x.Bond.Mode
+		attrs.MiiMon = int(x.Bond.Miimon)
+		attrs.Ports = x.Bond.Members
+	case *pb.CreateLinkRequest_Macvlan_:
+		attrs.Type = "macvlan"
+		attrs.Parent = x.Macvlan.Parent
+		attrs.MacvlanMode = x.Macvlan.Mode
+	case *pb.CreateLinkRequest_Dummy_:
+		attrs.Type = "dummy"
+	case *pb.CreateLinkRequest_Wireguard_:
+		attrs.Type = "wireguard"
+	default:
+		return nil, grpc_status.Errorf(grpc_codes.InvalidArgument, "link type is not specified")
+	}
+
+	return &attrs, nil
+}
+
+func ruleAttrsFromProto(req *pb.RuleRequest) *core.RuleAttrs {
+	return &core.RuleAttrs{
+		Priority: int(req.Priority),
+		Family:   core.InetFamily(req.Family),
+		Src:      req.Src,
+		Dst:      req.Dst,
+		IifName:  req.Iif,
+		OifName:  req.Oif,
+		Mark:     req.Fwmark,
+		Mask:     req.Fwmask,
+		Table:    int(req.Table),
+		Invert:   req.Invert,
+	}
+}
+
+func ruleToProto(rule *core.RuleInfo) *pb_types.RuleInfo {
+	return &pb_types.RuleInfo{
+		Priority: int32(rule.Priority),
+		Family:   pb_types.InetFamily(rule.Family),
+		Src:      rule.Src,
+		Dst:      rule.Dst,
+		Iif:      rule.IifName,
+		Oif:      rule.OifName,
+		Fwmark:   rule.Mark,
+		Fwmask:   rule.Mask,
+		Table:    int32(rule.Table),
+		Invert:   rule.Invert,
+	}
+}
+
+func rulesToProto(rules []*core.RuleInfo) []*pb_types.RuleInfo {
+	protos := make([]*pb_types.RuleInfo, 0, len(rules))
+
+	for _, r := range rules {
+		protos = append(protos, ruleToProto(r))
+	}
+
+	return protos
+}
+
+func neighborToProto(neigh *core.NeighborInfo) *pb_types.NeighborInfo {
+	return &pb_types.NeighborInfo{
+		LinkIndex: int32(neigh.LinkIndex),
+		LinkName:  neigh.LinkName,
+		Family:    pb_types.InetFamily(neigh.Family),
+		Ip:        neigh.IP,
+		HwAddr:    neigh.HwAddr,
+		State:     neigh.State,
+		Router:    neigh.Router,
+	}
+}
+
+func neighborsToProto(neighs []*core.NeighborInfo) []*pb_types.NeighborInfo {
+	protos := make([]*pb_types.NeighborInfo, 0, len(neighs))
+
+	for _, n := range neighs {
+		protos = append(protos, neighborToProto(n))
+	}
+
+	return protos
+}
+
 func ifaceToProto(iface *core.InterfaceInfo) *pb_types.InterfaceInfo {
 	proto := pb_types.InterfaceInfo{
-		Index:  int32(iface.Index),
-		Name:   iface.Name,
-		HwAddr: iface.HwAddr,
-		Flags:  uint32(iface.Flags),
-		MTU:    int32(iface.MTU),
-		Addrs:  iface.Addrs,
+		Index:      int32(iface.Index),
+		Name:       iface.Name,
+		HwAddr:     iface.HwAddr,
+		Flags:      uint32(iface.Flags),
+		MTU:        int32(iface.MTU),
+		Addrs:      iface.Addrs,
+		OperState:  iface.OperState,
+		LinkType:   iface.LinkType,
+		Master:     iface.MasterName,
+		Carrier:    iface.Carrier,
+		Speed:      int32(iface.Speed),
+		Duplex:     iface.Duplex,
+		Txqueuelen: int32(iface.TxQLen),
+		Alias:      iface.Alias,
+	}
+
+	if st := iface.Stats; st != nil {
+		proto.Stats = &pb_types.InterfaceInfo_Statistics{
+			RxBytes:   st.RxBytes,
+			RxPackets: st.RxPackets,
+			RxErrors:  st.RxErrors,
+			RxDropped: st.RxDropped,
+			TxBytes:   st.TxBytes,
+			TxPackets: st.TxPackets,
+			TxErrors:  st.TxErrors,
+			TxDropped: st.TxDropped,
+		}
 	}
 
 	return &proto
@@ -52,3 +281,194 @@ func ifaceListToProto(ifaces []*core.InterfaceInfo) []*pb_types.InterfaceInfo {
 
 	return protos
 }
+
+func wireguardDeviceToProto(dev *core.WireguardDevice) *pb.WireguardDevice {
+	d := pb.WireguardDevice{
+		LinkName:   dev.Name,
+		ListenPort: uint32(dev.ListenPort),
+		Fwmark:     uint32(dev.FirewallMark),
+	}
+
+	if !dev.PublicKey.IsZero() {
+		d.PublicKey = dev.PublicKey.String()
+	}
+
+	for _, p := range dev.Peers {
+		peer := pb.WireguardPeer{
+			PublicKey:           p.PublicKey.String(),
+			HasPresharedKey:     p.HasPresharedKey,
+			PersistentKeepalive: uint32(p.PersistentKeepalive.Seconds()),
+			RxBytes:             uint64(p.RxBytes),
+			TxBytes:             uint64(p.TxBytes),
+			ProtocolVersion:     int32(p.ProtocolVersion),
+		}
+
+		if p.Endpoint != nil {
+			peer.Endpoint = p.Endpoint.String()
+		}
+
+		if !p.LastHandshake.IsZero() {
+			peer.LastHandshake = p.LastHandshake.Unix()
+		}
+
+		for _, ipnet := range p.AllowedIPs {
+			peer.AllowedIps = append(peer.AllowedIps, ipnet.String())
+		}
+
+		d.Peers = append(d.Peers, &peer)
+	}
+
+	return &d
+}
+
+func wireguardConfigFromProto(req *pb.ConfigureWireguardRequest) *core.WireguardConfig {
+	cfg := core.WireguardConfig{
+		PrivateKey:   req.PrivateKey,
+		ListenPort:   int(req.ListenPort),
+		FirewallMark: int(req.Fwmark),
+		ReplacePeers: req.ReplacePeers,
+	}
+
+	for _, p := range req.Peers {
+		cfg.Peers = append(cfg.Peers, &core.WireguardPeerConfig{
+			PublicKey:           p.PublicKey,
+			Remove:              p.Remove,
+			UpdateOnly:          p.UpdateOnly,
+			PresharedKey:        p.PresharedKey,
+			Endpoint:            p.Endpoint,
+			PersistentKeepalive: int(p.PersistentKeepalive),
+			ReplaceAllowedIPs:   p.ReplaceAllowedIps,
+			AllowedIPs:          p.AllowedIps,
+		})
+	}
+
+	return &cfg
+}
+
+func qdiscToProto(q *core.QdiscInfo) *pb_types.QdiscInfo {
+	proto := pb_types.QdiscInfo{
+		Kind:   q.Kind,
+		Handle: q.Handle,
+		Parent: q.Parent,
+		Stats:  tcStatsToProto(q.Stats),
+	}
+
+	if o := q.Tbf; o != nil {
+		proto.Tbf = &pb_types.TbfOptions{
+			Rate:      o.Rate,
+			Burst:     o.Burst,
+			Limit:     o.Limit,
+			LatencyUs: uint32(o.Latency.Microseconds()),
+		}
+	}
+
+	if o := q.FqCodel; o != nil {
+		proto.FqCodel = &pb_types.FqCodelOptions{
+			Limit:      o.Limit,
+			Flows:      o.Flows,
+			IntervalUs: uint32(o.Interval.Microseconds()),
+			NoEcn:      o.NoECN,
+		}
+	}
+
+	if o := q.Netem; o != nil {
+		proto.Netem = &pb_types.NetemOptions{
+			DelayUs:   uint32(o.Delay.Microseconds()),
+			JitterUs:  uint32(o.Jitter.Microseconds()),
+			Loss:      o.Loss,
+			Duplicate: o.Duplicate,
+			Corrupt:   o.Corrupt,
+			Reorder:   o.Reorder,
+			Limit:     o.Limit,
+			Rate:      o.Rate,
+		}
+	}
+
+	return &proto
+}
+
+func tcStatsToProto(stats *core.TrafficControlStats) *pb_types.TrafficControlStats {
+	if stats == nil {
+		return nil
+	}
+
+	return &pb_types.TrafficControlStats{
+		Bytes:      stats.Bytes,
+		Packets:    stats.Packets,
+		Drops:      stats.Drops,
+		Overlimits: stats.Overlimits,
+		Requeues:   stats.Requeues,
+		Backlog:    stats.Backlog,
+		Qlen:       stats.Qlen,
+	}
+}
+
+func qdiscAttrsFromProto(req *pb.SetRootQdiscRequest) (*core.QdiscAttrs, error) {
+	var attrs core.QdiscAttrs
+
+	us := func(v uint32) time.Duration {
+		return time.Duration(v) * time.Microsecond
+	}
+
+	switch x := req.Qdisc.(type) {
+	case *pb.SetRootQdiscRequest_Tbf:
+		attrs.Tbf = &core.TbfOptions{
+			Rate:    x.Tbf.Rate,
+			Burst:   x.Tbf.Burst,
+			Limit:   x.Tbf.Limit,
+			Latency: us(x.Tbf.LatencyUs),
+		}
+	case *pb.SetRootQdiscRequest_Htb:
+		attrs.Htb = &core.HtbOptions{
+			Rate: x.Htb.Rate,
+			Ceil: x.Htb.Ceil,
+		}
+	case *pb.SetRootQdiscRequest_FqCodel:
+		attrs.FqCodel = &core.FqCodelOptions{
+			Limit:    x.FqCodel.Limit,
+			Flows:    x.FqCodel.Flows,
+			Interval: us(x.FqCodel.IntervalUs),
+			NoECN:    x.FqCodel.NoEcn,
+		}
+	case *pb.SetRootQdiscRequest_Netem:
+		attrs.Netem = &core.NetemOptions{
+			Delay:     us(x.Netem.DelayUs),
+			Jitter:    us(x.Netem.JitterUs),
+			Loss:      x.Netem.Loss,
+			Duplicate: x.Netem.Duplicate,
+			Corrupt:   x.Netem.Corrupt,
+			Reorder:   x.Netem.Reorder,
+			Limit:     x.Netem.Limit,
+			Rate:      x.Netem.Rate,
+		}
+	default:
+		return nil, grpc_status.Errorf(grpc_codes.InvalidArgument, "qdisc kind is not specified")
+	}
+
+	return &attrs, nil
+}
+
+func socketToProto(s *core.Socket) *pb_types.Socket {
+	sock := pb_types.Socket{
+		Protocol:   s.Protocol,
+		Type:       s.Type,
+		State:      s.State,
+		Listening:  s.Listening,
+		LocalAddr:  s.LocalAddr,
+		RemoteAddr: s.RemoteAddr,
+		RecvQ:      s.RecvQ,
+		SendQ:      s.SendQ,
+		UID:        s.UID,
+		Inode:      s.Inode,
+		Processes:  make([]*pb_types.Socket_Process, 0, len(s.Processes)),
+	}
+
+	for _, p := range s.Processes {
+		sock.Processes = append(sock.Processes, &pb_types.Socket_Process{
+			Pid:  uint32(p.PID),
+			Name: p.Name,
+		})
+	}
+
+	return &sock
+}
@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0xef53/phoenix-guest-agent/core"
+	"github.com/0xef53/phoenix-guest-agent/internal/cryptsetup"
+	"github.com/0xef53/phoenix-guest-agent/services"
+
+	pb "github.com/0xef53/phoenix-guest-agent/api/services/storage/v2"
+
+	grpcserver "github.com/0xef53/go-grpc/server"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc_runtime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	grpc "google.golang.org/grpc"
+	grpc_codes "google.golang.org/grpc/codes"
+	grpc_credentials "google.golang.org/grpc/credentials"
+	grpc_peer "google.golang.org/grpc/peer"
+	grpc_status "google.golang.org/grpc/status"
+)
+
+var _ = pb.AgentStorageServiceServer(new(Service))
+
+// maxKeySize is the upper limit of the LUKS key length
+const maxKeySize = 8192
+
+func init() {
+	grpcserver.Register(new(Service), grpcserver.WithServiceBucket("pga"))
+}
+
+type Service struct {
+	*services.ServiceServer
+}
+
+func (s *Service) Init(inner *services.ServiceServer) {
+	s.ServiceServer = inner
+}
+
+func (s *Service) Name() string {
+	return fmt.Sprintf("%T", s)
+}
+
+func (s *Service) RegisterGRPC(server *grpc.Server) {
+	pb.RegisterAgentStorageServiceServer(server, s)
+}
+
+func (s *Service) RegisterGW(_ *grpc_runtime.ServeMux, _ string, _ []grpc.DialOption) {}
+
+func (s *Service) GetPhysicalVolumes(ctx context.Context, _ *empty.Empty) (*pb.GetPhysicalVolumesResponse, error) {
+	pvs, err := s.ServiceServer.GetPhysicalVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPhysicalVolumesResponse{Volumes: physicalVolumesToProto(pvs)}, nil
+}
+
+func (s *Service) GetVolumeGroups(ctx context.Context, _ *empty.Empty) (*pb.GetVolumeGroupsResponse, error) {
+	vgs, err := s.ServiceServer.GetVolumeGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetVolumeGroupsResponse{Groups: volumeGroupsToProto(vgs)}, nil
+}
+
+func (s *Service) GetLogicalVolumes(ctx context.Context, _ *empty.Empty) (*pb.GetLogicalVolumesResponse, error) {
+	lvs, err := s.ServiceServer.GetLogicalVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetLogicalVolumesResponse{Volumes: logicalVolumesToProto(lvs)}, nil
+}
+
+func (s *Service) ResizePhysicalVolume(ctx context.Context, req *pb.ResizePhysicalVolumeRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.ResizePhysicalVolume(ctx, req.Device)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) ExtendLogicalVolume(ctx context.Context, req *pb.ExtendLogicalVolumeRequest) (*pb.ExtendLogicalVolumeResponse, error) {
+	lv, err := s.ServiceServer.ExtendLogicalVolume(ctx, req.VgName, req.LvName, req.SizeBytes, req.ResizeFs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExtendLogicalVolumeResponse{Volume: logicalVolumeToProto(lv)}, nil
+}
+
+func (s *Service) UnlockEncryptedVolume(stream pb.AgentStorageService_UnlockEncryptedVolumeServer) error {
+	// The key must never travel through an unencrypted transport
+	// such as the legacy virtio serial port
+	if !isSecureTransport(stream.Context()) {
+		return grpc_status.Errorf(grpc_codes.FailedPrecondition, "an encrypted transport is required to pass the key")
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return grpc_status.Errorf(grpc_codes.Internal, "cannot create a new stream: %s", err)
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		return grpc_status.Errorf(grpc_codes.InvalidArgument, "volume info is undefined")
+	}
+
+	// The buffer is never reallocated, so no copies of the key remain in memory
+	key := make([]byte, 0, maxKeySize)
+
+	// The key lives only in memory and is wiped once the volume is unlocked
+	defer func() {
+		key = key[:cap(key)]
+
+		for i := range key {
+			key[i] = 0
+		}
+	}()
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				// no more data
+				break
+			}
+
+			return grpc_status.Errorf(grpc_codes.Internal, "chunk recv failed: %s", err)
+		}
+
+		chunk := req.GetKeyData()
+		if chunk == nil {
+			return grpc_status.Errorf(grpc_codes.Internal, "unexpected: chunk is nil")
+		}
+
+		if len(key)+len(chunk) > maxKeySize {
+			return grpc_status.Errorf(grpc_codes.InvalidArgument, "key is too large: > %d", maxKeySize)
+		}
+
+		key = append(key, chunk...)
+
+		for i := range chunk {
+			chunk[i] = 0
+		}
+	}
+
+	st, err := s.ServiceServer.UnlockEncryptedVolume(stream.Context(), info.Device, info.Name, key, info.ReadOnly)
+	if err != nil {
+		var csErr *cryptsetup.Error
+
+		// The error mapping interceptor handles only unary calls
+		switch {
+		case errors.Is(err, core.ErrInvalidDevicePath), errors.Is(err, core.ErrInvalidMappingName), errors.Is(err, core.ErrEmptyKey):
+			return grpc_status.Error(grpc_codes.InvalidArgument, err.Error())
+		case errors.Is(err, cryptsetup.ErrNotInstalled):
+			return grpc_status.Error(grpc_codes.Unimplemented, err.Error())
+		case errors.As(err, &csErr):
+			return grpc_status.Error(grpc_codes.FailedPrecondition, err.Error())
+		}
+
+		return err
+	}
+
+	return stream.SendAndClose(&pb.EncryptedVolumeResponse{Volume: encryptedVolumeToProto(st)})
+}
+
+func (s *Service) LockEncryptedVolume(ctx context.Context, req *pb.LockEncryptedVolumeRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.LockEncryptedVolume(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *Service) GetEncryptedVolumeStatus(ctx context.Context, req *pb.GetEncryptedVolumeStatusRequest) (*pb.EncryptedVolumeResponse, error) {
+	st, err := s.ServiceServer.GetEncryptedVolumeStatus(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EncryptedVolumeResponse{Volume: encryptedVolumeToProto(st)}, nil
+}
+
+func isSecureTransport(ctx context.Context) bool {
+	if p, ok := grpc_peer.FromContext(ctx); ok {
+		if _, ok := p.AuthInfo.(grpc_credentials.TLSInfo); ok {
+			return true
+		}
+	}
+
+	return false
+}
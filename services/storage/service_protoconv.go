@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"github.com/0xef53/phoenix-guest-agent/core"
+
+	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
+)
+
+func physicalVolumesToProto(pvs []*core.PhysicalVolume) []*pb_types.PhysicalVolume {
+	protos := make([]*pb_types.PhysicalVolume, 0, len(pvs))
+
+	for _, pv := range pvs {
+		protos = append(protos, &pb_types.PhysicalVolume{
+			Name:                 pv.Name,
+			VgName:               pv.VGName,
+			Format:               pv.Format,
+			SizeBytes:            pv.SizeBytes,
+			FreeBytes:            pv.FreeBytes,
+			ExtentCount:          pv.ExtentCount,
+			AllocatedExtentCount: pv.AllocatedExtentCount,
+		})
+	}
+
+	return protos
+}
+
+func volumeGroupsToProto(vgs []*core.VolumeGroup) []*pb_types.VolumeGroup {
+	protos := make([]*pb_types.VolumeGroup, 0, len(vgs))
+
+	for _, vg := range vgs {
+		protos = append(protos, &pb_types.VolumeGroup{
+			Name:            vg.Name,
+			SizeBytes:       vg.SizeBytes,
+			FreeBytes:       vg.FreeBytes,
+			ExtentSize:      vg.ExtentSize,
+			ExtentCount:     vg.ExtentCount,
+			FreeExtentCount: vg.FreeExtentCount,
+			PvCount:         vg.PVCount,
+			LvCount:         vg.LVCount,
+		})
+	}
+
+	return protos
+}
+
+func logicalVolumeToProto(lv *core.LogicalVolume) *pb_types.LogicalVolume {
+	proto := pb_types.LogicalVolume{
+		Name:      lv.Name,
+		VgName:    lv.VGName,
+		Path:      lv.Path,
+		DmPath:    lv.DMPath,
+		Attr:      lv.Attr,
+		SizeBytes: lv.SizeBytes,
+	}
+
+	return &proto
+}
+
+func logicalVolumesToProto(lvs []*core.LogicalVolume) []*pb_types.LogicalVolume {
+	protos := make([]*pb_types.LogicalVolume, 0, len(lvs))
+
+	for _, lv := range lvs {
+		protos = append(protos, logicalVolumeToProto(lv))
+	}
+
+	return protos
+}
+
+func encryptedVolumeToProto(st *core.EncryptedVolumeStatus) *pb_types.EncryptedVolume {
+	proto := pb_types.EncryptedVolume{
+		Name:     st.Name,
+		IsActive: st.IsActive,
+		Type:     st.Type,
+		Cipher:   st.Cipher,
+		KeySize:  st.KeySize,
+		Device:   st.Device,
+		ReadOnly: st.ReadOnly,
+	}
+
+	return &proto
+}
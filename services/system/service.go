@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/0xef53/phoenix-guest-agent/core"
 	"github.com/0xef53/phoenix-guest-agent/services"
 
 	pb "github.com/0xef53/phoenix-guest-agent/api/services/system/v2"
@@ -53,3 +54,34 @@ func (s *Service) ShutdownAgent(ctx context.Context, _ *empty.Empty) (*empty.Emp
 
 	return new(empty.Empty), nil
 }
+
+func (s *Service) GetSysctl(ctx context.Context, req *pb.GetSysctlRequest) (*pb.GetSysctlResponse, error) {
+	params, err := s.ServiceServer.GetSysctl(ctx, req.Patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.GetSysctlResponse{
+		Params: make([]*pb.SysctlParam, 0, len(params)),
+	}
+
+	for _, p := range params {
+		resp.Params = append(resp.Params, sysctlParamToProto(p))
+	}
+
+	return &resp, nil
+}
+
+func (s *Service) SetSysctl(ctx context.Context, req *pb.SetSysctlRequest) (*empty.Empty, error) {
+	params := make([]*core.SysctlParam, 0, len(req.Params))
+
+	for _, p := range req.Params {
+		params = append(params, &core.SysctlParam{Key: p.Key, Value: p.Value})
+	}
+
+	if err := s.ServiceServer.SetSysctl(ctx, params, req.Persist); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
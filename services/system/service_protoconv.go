@@ -3,6 +3,7 @@ package system
 import (
 	"github.com/0xef53/phoenix-guest-agent/core"
 
+	pb "github.com/0xef53/phoenix-guest-agent/api/services/system/v2"
 	pb_types "github.com/0xef53/phoenix-guest-agent/api/types/v2"
 )
 
@@ -18,8 +19,17 @@ func agentInfoToProto(info *core.AgentInfo) *pb_types.AgentInfo {
 			SerialPort: info.Features.SerialPort,
 			WithoutSSH: info.Features.WithoutSSH,
 			WithoutTCP: info.Features.WithoutTCP,
+
+			SysctlAllowlist: info.Features.SysctlAllowlist,
 		}
 	}
 
 	return &proto
 }
+
+func sysctlParamToProto(p *core.SysctlParam) *pb.SysctlParam {
+	return &pb.SysctlParam{
+		Key:   p.Key,
+		Value: p.Value,
+	}
+}